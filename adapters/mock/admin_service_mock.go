@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: AdminService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockAdminService is a mock of AdminService interface.
+type MockAdminService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceMockRecorder
+}
+
+// MockAdminServiceMockRecorder is the mock recorder for MockAdminService.
+type MockAdminServiceMockRecorder struct {
+	mock *MockAdminService
+}
+
+// NewMockAdminService creates a new mock instance.
+func NewMockAdminService(ctrl *gomock.Controller) *MockAdminService {
+	mock := &MockAdminService{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminService) EXPECT() *MockAdminServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelMessage mocks base method.
+func (m *MockAdminService) CancelMessage(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMessage", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMessage indicates an expected call of CancelMessage.
+func (mr *MockAdminServiceMockRecorder) CancelMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMessage", reflect.TypeOf((*MockAdminService)(nil).CancelMessage), arg0, arg1)
+}
+
+// ListMessages mocks base method.
+func (m *MockAdminService) ListMessages(arg0, arg1 string) ([]*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessages", arg0, arg1)
+	ret0, _ := ret[0].([]*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessages indicates an expected call of ListMessages.
+func (mr *MockAdminServiceMockRecorder) ListMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockAdminService)(nil).ListMessages), arg0, arg1)
+}
+
+// PauseDelivery mocks base method.
+func (m *MockAdminService) PauseDelivery(arg0 string, arg1 *time.Time, arg2 types.ResumeMode) (*types.PauseState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseDelivery", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.PauseState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseDelivery indicates an expected call of PauseDelivery.
+func (mr *MockAdminServiceMockRecorder) PauseDelivery(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseDelivery", reflect.TypeOf((*MockAdminService)(nil).PauseDelivery), arg0, arg1, arg2)
+}
+
+// ResumeDelivery mocks base method.
+func (m *MockAdminService) ResumeDelivery(arg0 string, arg1 types.ResumeMode) (*types.ResumeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeDelivery", arg0, arg1)
+	ret0, _ := ret[0].(*types.ResumeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeDelivery indicates an expected call of ResumeDelivery.
+func (mr *MockAdminServiceMockRecorder) ResumeDelivery(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDelivery", reflect.TypeOf((*MockAdminService)(nil).ResumeDelivery), arg0, arg1)
+}
+
+// Status mocks base method.
+func (m *MockAdminService) Status() (*types.SchedulerStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status")
+	ret0, _ := ret[0].(*types.SchedulerStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockAdminServiceMockRecorder) Status() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockAdminService)(nil).Status))
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: AuditStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockAuditStore is a mock of AuditStore interface.
+type MockAuditStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditStoreMockRecorder
+}
+
+// MockAuditStoreMockRecorder is the mock recorder for MockAuditStore.
+type MockAuditStoreMockRecorder struct {
+	mock *MockAuditStore
+}
+
+// NewMockAuditStore creates a new mock instance.
+func NewMockAuditStore(ctrl *gomock.Controller) *MockAuditStore {
+	mock := &MockAuditStore{ctrl: ctrl}
+	mock.recorder = &MockAuditStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditStore) EXPECT() *MockAuditStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteAuditEntriesBefore mocks base method.
+func (m *MockAuditStore) DeleteAuditEntriesBefore(arg0 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAuditEntriesBefore", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAuditEntriesBefore indicates an expected call of DeleteAuditEntriesBefore.
+func (mr *MockAuditStoreMockRecorder) DeleteAuditEntriesBefore(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAuditEntriesBefore", reflect.TypeOf((*MockAuditStore)(nil).DeleteAuditEntriesBefore), arg0)
+}
+
+// ListAuditEntries mocks base method.
+func (m *MockAuditStore) ListAuditEntries(arg0 *types.AuditQuery) ([]*types.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditEntries", arg0)
+	ret0, _ := ret[0].([]*types.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditEntries indicates an expected call of ListAuditEntries.
+func (mr *MockAuditStoreMockRecorder) ListAuditEntries(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditEntries", reflect.TypeOf((*MockAuditStore)(nil).ListAuditEntries), arg0)
+}
+
+// SaveAuditEntry mocks base method.
+func (m *MockAuditStore) SaveAuditEntry(arg0 *types.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAuditEntry", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAuditEntry indicates an expected call of SaveAuditEntry.
+func (mr *MockAuditStoreMockRecorder) SaveAuditEntry(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAuditEntry", reflect.TypeOf((*MockAuditStore)(nil).SaveAuditEntry), arg0)
+}
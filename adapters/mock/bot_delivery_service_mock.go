@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: BotDeliveryService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost/server/public/model"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockBotDeliveryService is a mock of BotDeliveryService interface.
+type MockBotDeliveryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBotDeliveryServiceMockRecorder
+}
+
+// MockBotDeliveryServiceMockRecorder is the mock recorder for MockBotDeliveryService.
+type MockBotDeliveryServiceMockRecorder struct {
+	mock *MockBotDeliveryService
+}
+
+// NewMockBotDeliveryService creates a new mock instance.
+func NewMockBotDeliveryService(ctrl *gomock.Controller) *MockBotDeliveryService {
+	mock := &MockBotDeliveryService{ctrl: ctrl}
+	mock.recorder = &MockBotDeliveryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBotDeliveryService) EXPECT() *MockBotDeliveryServiceMockRecorder {
+	return m.recorder
+}
+
+// Prepare mocks base method.
+func (m *MockBotDeliveryService) Prepare(arg0 *types.ScheduledMessage, arg1 *model.Post) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Prepare", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prepare indicates an expected call of Prepare.
+func (mr *MockBotDeliveryServiceMockRecorder) Prepare(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prepare", reflect.TypeOf((*MockBotDeliveryService)(nil).Prepare), arg0, arg1)
+}
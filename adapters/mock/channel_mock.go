@@ -34,6 +34,20 @@ func (m *MockChannelService) EXPECT() *MockChannelServiceMockRecorder {
 	return m.recorder
 }
 
+// EnsureMember mocks base method.
+func (m *MockChannelService) EnsureMember(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureMember", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureMember indicates an expected call of EnsureMember.
+func (mr *MockChannelServiceMockRecorder) EnsureMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureMember", reflect.TypeOf((*MockChannelService)(nil).EnsureMember), arg0, arg1)
+}
+
 // GetInfoOrUnknown mocks base method.
 func (m *MockChannelService) GetInfoOrUnknown(arg0 string) *ports.ChannelInfo {
 	m.ctrl.T.Helper()
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: DialogService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost/server/public/model"
+)
+
+// MockDialogService is a mock of DialogService interface.
+type MockDialogService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDialogServiceMockRecorder
+}
+
+// MockDialogServiceMockRecorder is the mock recorder for MockDialogService.
+type MockDialogServiceMockRecorder struct {
+	mock *MockDialogService
+}
+
+// NewMockDialogService creates a new mock instance.
+func NewMockDialogService(ctrl *gomock.Controller) *MockDialogService {
+	mock := &MockDialogService{ctrl: ctrl}
+	mock.recorder = &MockDialogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDialogService) EXPECT() *MockDialogServiceMockRecorder {
+	return m.recorder
+}
+
+// OpenInteractiveDialog mocks base method.
+func (m *MockDialogService) OpenInteractiveDialog(arg0 model.OpenDialogRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenInteractiveDialog", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OpenInteractiveDialog indicates an expected call of OpenInteractiveDialog.
+func (mr *MockDialogServiceMockRecorder) OpenInteractiveDialog(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenInteractiveDialog", reflect.TypeOf((*MockDialogService)(nil).OpenInteractiveDialog), arg0)
+}
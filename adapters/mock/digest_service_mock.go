@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: DigestService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDigestService is a mock of DigestService interface.
+type MockDigestService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestServiceMockRecorder
+}
+
+// MockDigestServiceMockRecorder is the mock recorder for MockDigestService.
+type MockDigestServiceMockRecorder struct {
+	mock *MockDigestService
+}
+
+// NewMockDigestService creates a new mock instance.
+func NewMockDigestService(ctrl *gomock.Controller) *MockDigestService {
+	mock := &MockDigestService{ctrl: ctrl}
+	mock.recorder = &MockDigestServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestService) EXPECT() *MockDigestServiceMockRecorder {
+	return m.recorder
+}
+
+// SendDue mocks base method.
+func (m *MockDigestService) SendDue(arg0 []string, arg1 time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendDue", arg0, arg1)
+}
+
+// SendDue indicates an expected call of SendDue.
+func (mr *MockDigestServiceMockRecorder) SendDue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDue", reflect.TypeOf((*MockDigestService)(nil).SendDue), arg0, arg1)
+}
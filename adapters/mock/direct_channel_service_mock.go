@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: DirectChannelService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost/server/public/model"
+)
+
+// MockDirectChannelService is a mock of DirectChannelService interface.
+type MockDirectChannelService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDirectChannelServiceMockRecorder
+}
+
+// MockDirectChannelServiceMockRecorder is the mock recorder for MockDirectChannelService.
+type MockDirectChannelServiceMockRecorder struct {
+	mock *MockDirectChannelService
+}
+
+// NewMockDirectChannelService creates a new mock instance.
+func NewMockDirectChannelService(ctrl *gomock.Controller) *MockDirectChannelService {
+	mock := &MockDirectChannelService{ctrl: ctrl}
+	mock.recorder = &MockDirectChannelServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDirectChannelService) EXPECT() *MockDirectChannelServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDirect mocks base method.
+func (m *MockDirectChannelService) GetDirect(arg0, arg1 string) (*model.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDirect", arg0, arg1)
+	ret0, _ := ret[0].(*model.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDirect indicates an expected call of GetDirect.
+func (mr *MockDirectChannelServiceMockRecorder) GetDirect(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDirect", reflect.TypeOf((*MockDirectChannelService)(nil).GetDirect), arg0, arg1)
+}
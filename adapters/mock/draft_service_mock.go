@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: DraftService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockDraftService is a mock of DraftService interface.
+type MockDraftService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDraftServiceMockRecorder
+}
+
+// MockDraftServiceMockRecorder is the mock recorder for MockDraftService.
+type MockDraftServiceMockRecorder struct {
+	mock *MockDraftService
+}
+
+// NewMockDraftService creates a new mock instance.
+func NewMockDraftService(ctrl *gomock.Controller) *MockDraftService {
+	mock := &MockDraftService{ctrl: ctrl}
+	mock.recorder = &MockDraftServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDraftService) EXPECT() *MockDraftServiceMockRecorder {
+	return m.recorder
+}
+
+// MoveNextToDraft mocks base method.
+func (m *MockDraftService) MoveNextToDraft(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveNextToDraft", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveNextToDraft indicates an expected call of MoveNextToDraft.
+func (mr *MockDraftServiceMockRecorder) MoveNextToDraft(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNextToDraft", reflect.TypeOf((*MockDraftService)(nil).MoveNextToDraft), arg0, arg1)
+}
+
+// MoveToDraft mocks base method.
+func (m *MockDraftService) MoveToDraft(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveToDraft", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveToDraft indicates an expected call of MoveToDraft.
+func (mr *MockDraftServiceMockRecorder) MoveToDraft(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveToDraft", reflect.TypeOf((*MockDraftService)(nil).MoveToDraft), arg0, arg1)
+}
+
+// SendToDrafts mocks base method.
+func (m *MockDraftService) SendToDrafts(arg0 *types.ScheduledMessage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SendToDrafts", arg0)
+}
+
+// SendToDrafts indicates an expected call of SendToDrafts.
+func (mr *MockDraftServiceMockRecorder) SendToDrafts(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendToDrafts", reflect.TypeOf((*MockDraftService)(nil).SendToDrafts), arg0)
+}
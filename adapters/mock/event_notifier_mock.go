@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: EventNotifier)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockEventNotifier is a mock of EventNotifier interface.
+type MockEventNotifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventNotifierMockRecorder
+}
+
+// MockEventNotifierMockRecorder is the mock recorder for MockEventNotifier.
+type MockEventNotifierMockRecorder struct {
+	mock *MockEventNotifier
+}
+
+// NewMockEventNotifier creates a new mock instance.
+func NewMockEventNotifier(ctrl *gomock.Controller) *MockEventNotifier {
+	mock := &MockEventNotifier{ctrl: ctrl}
+	mock.recorder = &MockEventNotifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventNotifier) EXPECT() *MockEventNotifierMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockEventNotifier) Notify(arg0 *types.LifecycleEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Notify", arg0)
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockEventNotifierMockRecorder) Notify(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockEventNotifier)(nil).Notify), arg0)
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: FailedMessageStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockFailedMessageStore is a mock of FailedMessageStore interface.
+type MockFailedMessageStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockFailedMessageStoreMockRecorder
+}
+
+// MockFailedMessageStoreMockRecorder is the mock recorder for MockFailedMessageStore.
+type MockFailedMessageStoreMockRecorder struct {
+	mock *MockFailedMessageStore
+}
+
+// NewMockFailedMessageStore creates a new mock instance.
+func NewMockFailedMessageStore(ctrl *gomock.Controller) *MockFailedMessageStore {
+	mock := &MockFailedMessageStore{ctrl: ctrl}
+	mock.recorder = &MockFailedMessageStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFailedMessageStore) EXPECT() *MockFailedMessageStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteFailedMessage mocks base method.
+func (m *MockFailedMessageStore) DeleteFailedMessage(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailedMessage", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFailedMessage indicates an expected call of DeleteFailedMessage.
+func (mr *MockFailedMessageStoreMockRecorder) DeleteFailedMessage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailedMessage", reflect.TypeOf((*MockFailedMessageStore)(nil).DeleteFailedMessage), arg0)
+}
+
+// GetFailedMessage mocks base method.
+func (m *MockFailedMessageStore) GetFailedMessage(arg0 string) (*types.FailedMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailedMessage", arg0)
+	ret0, _ := ret[0].(*types.FailedMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailedMessage indicates an expected call of GetFailedMessage.
+func (mr *MockFailedMessageStoreMockRecorder) GetFailedMessage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailedMessage", reflect.TypeOf((*MockFailedMessageStore)(nil).GetFailedMessage), arg0)
+}
+
+// SaveFailedMessage mocks base method.
+func (m *MockFailedMessageStore) SaveFailedMessage(arg0 *types.FailedMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFailedMessage", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFailedMessage indicates an expected call of SaveFailedMessage.
+func (mr *MockFailedMessageStoreMockRecorder) SaveFailedMessage(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFailedMessage", reflect.TypeOf((*MockFailedMessageStore)(nil).SaveFailedMessage), arg0)
+}
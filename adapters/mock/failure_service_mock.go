@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: FailureService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockFailureService is a mock of FailureService interface.
+type MockFailureService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFailureServiceMockRecorder
+}
+
+// MockFailureServiceMockRecorder is the mock recorder for MockFailureService.
+type MockFailureServiceMockRecorder struct {
+	mock *MockFailureService
+}
+
+// NewMockFailureService creates a new mock instance.
+func NewMockFailureService(ctrl *gomock.Controller) *MockFailureService {
+	mock := &MockFailureService{ctrl: ctrl}
+	mock.recorder = &MockFailureServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFailureService) EXPECT() *MockFailureServiceMockRecorder {
+	return m.recorder
+}
+
+// Draft mocks base method.
+func (m *MockFailureService) Draft(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Draft", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Draft indicates an expected call of Draft.
+func (mr *MockFailureServiceMockRecorder) Draft(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draft", reflect.TypeOf((*MockFailureService)(nil).Draft), arg0, arg1)
+}
+
+// Reschedule mocks base method.
+func (m *MockFailureService) Reschedule(arg0, arg1 string, arg2 time.Duration) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockFailureServiceMockRecorder) Reschedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockFailureService)(nil).Reschedule), arg0, arg1, arg2)
+}
+
+// Retarget mocks base method.
+func (m *MockFailureService) Retarget(arg0, arg1, arg2 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retarget", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retarget indicates an expected call of Retarget.
+func (mr *MockFailureServiceMockRecorder) Retarget(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retarget", reflect.TypeOf((*MockFailureService)(nil).Retarget), arg0, arg1, arg2)
+}
+
+// Retry mocks base method.
+func (m *MockFailureService) Retry(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockFailureServiceMockRecorder) Retry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockFailureService)(nil).Retry), arg0, arg1)
+}
@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: FeedTokenStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFeedTokenStore is a mock of FeedTokenStore interface.
+type MockFeedTokenStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedTokenStoreMockRecorder
+}
+
+// MockFeedTokenStoreMockRecorder is the mock recorder for MockFeedTokenStore.
+type MockFeedTokenStoreMockRecorder struct {
+	mock *MockFeedTokenStore
+}
+
+// NewMockFeedTokenStore creates a new mock instance.
+func NewMockFeedTokenStore(ctrl *gomock.Controller) *MockFeedTokenStore {
+	mock := &MockFeedTokenStore{ctrl: ctrl}
+	mock.recorder = &MockFeedTokenStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedTokenStore) EXPECT() *MockFeedTokenStoreMockRecorder {
+	return m.recorder
+}
+
+// GetFeedToken mocks base method.
+func (m *MockFeedTokenStore) GetFeedToken(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedToken", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedToken indicates an expected call of GetFeedToken.
+func (mr *MockFeedTokenStoreMockRecorder) GetFeedToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedToken", reflect.TypeOf((*MockFeedTokenStore)(nil).GetFeedToken), arg0)
+}
+
+// GetFeedTokenOwner mocks base method.
+func (m *MockFeedTokenStore) GetFeedTokenOwner(arg0 string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedTokenOwner", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedTokenOwner indicates an expected call of GetFeedTokenOwner.
+func (mr *MockFeedTokenStoreMockRecorder) GetFeedTokenOwner(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedTokenOwner", reflect.TypeOf((*MockFeedTokenStore)(nil).GetFeedTokenOwner), arg0)
+}
+
+// SaveFeedToken mocks base method.
+func (m *MockFeedTokenStore) SaveFeedToken(arg0, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFeedToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFeedToken indicates an expected call of SaveFeedToken.
+func (mr *MockFeedTokenStoreMockRecorder) SaveFeedToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFeedToken", reflect.TypeOf((*MockFeedTokenStore)(nil).SaveFeedToken), arg0, arg1)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: FileService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockFileService is a mock of FileService interface.
+type MockFileService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFileServiceMockRecorder
+}
+
+// MockFileServiceMockRecorder is the mock recorder for MockFileService.
+type MockFileServiceMockRecorder struct {
+	mock *MockFileService
+}
+
+// NewMockFileService creates a new mock instance.
+func NewMockFileService(ctrl *gomock.Controller) *MockFileService {
+	mock := &MockFileService{ctrl: ctrl}
+	mock.recorder = &MockFileServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFileService) EXPECT() *MockFileServiceMockRecorder {
+	return m.recorder
+}
+
+// CopyInfos mocks base method.
+func (m *MockFileService) CopyInfos(arg0 []string, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyInfos", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyInfos indicates an expected call of CopyInfos.
+func (mr *MockFileServiceMockRecorder) CopyInfos(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyInfos", reflect.TypeOf((*MockFileService)(nil).CopyInfos), arg0, arg1)
+}
@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: HeldMessageStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockHeldMessageStore is a mock of HeldMessageStore interface.
+type MockHeldMessageStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockHeldMessageStoreMockRecorder
+}
+
+// MockHeldMessageStoreMockRecorder is the mock recorder for MockHeldMessageStore.
+type MockHeldMessageStoreMockRecorder struct {
+	mock *MockHeldMessageStore
+}
+
+// NewMockHeldMessageStore creates a new mock instance.
+func NewMockHeldMessageStore(ctrl *gomock.Controller) *MockHeldMessageStore {
+	mock := &MockHeldMessageStore{ctrl: ctrl}
+	mock.recorder = &MockHeldMessageStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHeldMessageStore) EXPECT() *MockHeldMessageStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteHeldMessages mocks base method.
+func (m *MockHeldMessageStore) DeleteHeldMessages(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHeldMessages", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHeldMessages indicates an expected call of DeleteHeldMessages.
+func (mr *MockHeldMessageStoreMockRecorder) DeleteHeldMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHeldMessages", reflect.TypeOf((*MockHeldMessageStore)(nil).DeleteHeldMessages), arg0)
+}
+
+// GetHeldMessages mocks base method.
+func (m *MockHeldMessageStore) GetHeldMessages(arg0 string) ([]*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldMessages", arg0)
+	ret0, _ := ret[0].([]*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldMessages indicates an expected call of GetHeldMessages.
+func (mr *MockHeldMessageStoreMockRecorder) GetHeldMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldMessages", reflect.TypeOf((*MockHeldMessageStore)(nil).GetHeldMessages), arg0)
+}
+
+// ListHeldUserIDs mocks base method.
+func (m *MockHeldMessageStore) ListHeldUserIDs() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHeldUserIDs")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHeldUserIDs indicates an expected call of ListHeldUserIDs.
+func (mr *MockHeldMessageStoreMockRecorder) ListHeldUserIDs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHeldUserIDs", reflect.TypeOf((*MockHeldMessageStore)(nil).ListHeldUserIDs))
+}
+
+// SaveHeldMessages mocks base method.
+func (m *MockHeldMessageStore) SaveHeldMessages(arg0 string, arg1 []*types.ScheduledMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveHeldMessages", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveHeldMessages indicates an expected call of SaveHeldMessages.
+func (mr *MockHeldMessageStoreMockRecorder) SaveHeldMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveHeldMessages", reflect.TypeOf((*MockHeldMessageStore)(nil).SaveHeldMessages), arg0, arg1)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: HistoryStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockHistoryStore is a mock of HistoryStore interface.
+type MockHistoryStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryStoreMockRecorder
+}
+
+// MockHistoryStoreMockRecorder is the mock recorder for MockHistoryStore.
+type MockHistoryStoreMockRecorder struct {
+	mock *MockHistoryStore
+}
+
+// NewMockHistoryStore creates a new mock instance.
+func NewMockHistoryStore(ctrl *gomock.Controller) *MockHistoryStore {
+	mock := &MockHistoryStore{ctrl: ctrl}
+	mock.recorder = &MockHistoryStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryStore) EXPECT() *MockHistoryStoreMockRecorder {
+	return m.recorder
+}
+
+// ListHistory mocks base method.
+func (m *MockHistoryStore) ListHistory(arg0 string) ([]*types.HistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHistory", arg0)
+	ret0, _ := ret[0].([]*types.HistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHistory indicates an expected call of ListHistory.
+func (mr *MockHistoryStoreMockRecorder) ListHistory(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHistory", reflect.TypeOf((*MockHistoryStore)(nil).ListHistory), arg0)
+}
+
+// SaveHistoryEntry mocks base method.
+func (m *MockHistoryStore) SaveHistoryEntry(arg0 string, arg1 *types.HistoryEntry, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveHistoryEntry", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveHistoryEntry indicates an expected call of SaveHistoryEntry.
+func (mr *MockHistoryStoreMockRecorder) SaveHistoryEntry(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveHistoryEntry", reflect.TypeOf((*MockHistoryStore)(nil).SaveHistoryEntry), arg0, arg1, arg2)
+}
@@ -0,0 +1,126 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: IntegrationService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockIntegrationService is a mock of IntegrationService interface.
+type MockIntegrationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrationServiceMockRecorder
+}
+
+// MockIntegrationServiceMockRecorder is the mock recorder for MockIntegrationService.
+type MockIntegrationServiceMockRecorder struct {
+	mock *MockIntegrationService
+}
+
+// NewMockIntegrationService creates a new mock instance.
+func NewMockIntegrationService(ctrl *gomock.Controller) *MockIntegrationService {
+	mock := &MockIntegrationService{ctrl: ctrl}
+	mock.recorder = &MockIntegrationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrationService) EXPECT() *MockIntegrationServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockIntegrationService) Authenticate(arg0 string) (*types.IntegrationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", arg0)
+	ret0, _ := ret[0].(*types.IntegrationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockIntegrationServiceMockRecorder) Authenticate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockIntegrationService)(nil).Authenticate), arg0)
+}
+
+// GetMessage mocks base method.
+func (m *MockIntegrationService) GetMessage(arg0 *types.IntegrationToken, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockIntegrationServiceMockRecorder) GetMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockIntegrationService)(nil).GetMessage), arg0, arg1)
+}
+
+// Issue mocks base method.
+func (m *MockIntegrationService) Issue(arg0 string, arg1 []string, arg2, arg3 string) (*types.IntegrationToken, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*types.IntegrationToken)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockIntegrationServiceMockRecorder) Issue(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockIntegrationService)(nil).Issue), arg0, arg1, arg2, arg3)
+}
+
+// List mocks base method.
+func (m *MockIntegrationService) List() ([]*types.IntegrationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]*types.IntegrationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIntegrationServiceMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIntegrationService)(nil).List))
+}
+
+// ListMessages mocks base method.
+func (m *MockIntegrationService) ListMessages(arg0 *types.IntegrationToken, arg1 *types.MessageQuery) (*types.MessagePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessages", arg0, arg1)
+	ret0, _ := ret[0].(*types.MessagePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessages indicates an expected call of ListMessages.
+func (mr *MockIntegrationServiceMockRecorder) ListMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockIntegrationService)(nil).ListMessages), arg0, arg1)
+}
+
+// Revoke mocks base method.
+func (m *MockIntegrationService) Revoke(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockIntegrationServiceMockRecorder) Revoke(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockIntegrationService)(nil).Revoke), arg0)
+}
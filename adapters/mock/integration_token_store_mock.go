@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: IntegrationTokenStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockIntegrationTokenStore is a mock of IntegrationTokenStore interface.
+type MockIntegrationTokenStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIntegrationTokenStoreMockRecorder
+}
+
+// MockIntegrationTokenStoreMockRecorder is the mock recorder for MockIntegrationTokenStore.
+type MockIntegrationTokenStoreMockRecorder struct {
+	mock *MockIntegrationTokenStore
+}
+
+// NewMockIntegrationTokenStore creates a new mock instance.
+func NewMockIntegrationTokenStore(ctrl *gomock.Controller) *MockIntegrationTokenStore {
+	mock := &MockIntegrationTokenStore{ctrl: ctrl}
+	mock.recorder = &MockIntegrationTokenStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntegrationTokenStore) EXPECT() *MockIntegrationTokenStoreMockRecorder {
+	return m.recorder
+}
+
+// DeleteToken mocks base method.
+func (m *MockIntegrationTokenStore) DeleteToken(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteToken", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteToken indicates an expected call of DeleteToken.
+func (mr *MockIntegrationTokenStoreMockRecorder) DeleteToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteToken", reflect.TypeOf((*MockIntegrationTokenStore)(nil).DeleteToken), arg0)
+}
+
+// GetTokenByHash mocks base method.
+func (m *MockIntegrationTokenStore) GetTokenByHash(arg0 string) (*types.IntegrationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenByHash", arg0)
+	ret0, _ := ret[0].(*types.IntegrationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTokenByHash indicates an expected call of GetTokenByHash.
+func (mr *MockIntegrationTokenStoreMockRecorder) GetTokenByHash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenByHash", reflect.TypeOf((*MockIntegrationTokenStore)(nil).GetTokenByHash), arg0)
+}
+
+// ListTokens mocks base method.
+func (m *MockIntegrationTokenStore) ListTokens() ([]*types.IntegrationToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTokens")
+	ret0, _ := ret[0].([]*types.IntegrationToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTokens indicates an expected call of ListTokens.
+func (mr *MockIntegrationTokenStoreMockRecorder) ListTokens() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockIntegrationTokenStore)(nil).ListTokens))
+}
+
+// SaveToken mocks base method.
+func (m *MockIntegrationTokenStore) SaveToken(arg0 *types.IntegrationToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveToken", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveToken indicates an expected call of SaveToken.
+func (mr *MockIntegrationTokenStoreMockRecorder) SaveToken(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveToken", reflect.TypeOf((*MockIntegrationTokenStore)(nil).SaveToken), arg0)
+}
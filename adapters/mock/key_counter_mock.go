@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: KeyCounter)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockKeyCounter is a mock of KeyCounter interface.
+type MockKeyCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyCounterMockRecorder
+}
+
+// MockKeyCounterMockRecorder is the mock recorder for MockKeyCounter.
+type MockKeyCounterMockRecorder struct {
+	mock *MockKeyCounter
+}
+
+// NewMockKeyCounter creates a new mock instance.
+func NewMockKeyCounter(ctrl *gomock.Controller) *MockKeyCounter {
+	mock := &MockKeyCounter{ctrl: ctrl}
+	mock.recorder = &MockKeyCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyCounter) EXPECT() *MockKeyCounterMockRecorder {
+	return m.recorder
+}
+
+// CountKeys mocks base method.
+func (m *MockKeyCounter) CountKeys(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountKeys", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountKeys indicates an expected call of CountKeys.
+func (mr *MockKeyCounterMockRecorder) CountKeys(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountKeys", reflect.TypeOf((*MockKeyCounter)(nil).CountKeys), arg0)
+}
@@ -9,6 +9,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/mattermost/mattermost/server/public/model"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 // MockListService is a mock of ListService interface.
@@ -35,7 +36,7 @@ func (m *MockListService) EXPECT() *MockListServiceMockRecorder {
 }
 
 // Build mocks base method.
-func (m *MockListService) Build(arg0 string) *model.CommandResponse {
+func (m *MockListService) Build(arg0 *types.MessageQuery) *model.CommandResponse {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Build", arg0)
 	ret0, _ := ret[0].(*model.CommandResponse)
@@ -48,8 +49,23 @@ func (mr *MockListServiceMockRecorder) Build(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockListService)(nil).Build), arg0)
 }
 
-// Build mocks base method.
-func (m *MockListService) BuildPost(arg0 string, arg1 string) (*model.Post, error) {
+// BuildDigest mocks base method.
+func (m *MockListService) BuildDigest(arg0 *types.MessageQuery, arg1 string) (*model.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildDigest", arg0, arg1)
+	ret0, _ := ret[0].(*model.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildDigest indicates an expected call of BuildDigest.
+func (mr *MockListServiceMockRecorder) BuildDigest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildDigest", reflect.TypeOf((*MockListService)(nil).BuildDigest), arg0, arg1)
+}
+
+// BuildPost mocks base method.
+func (m *MockListService) BuildPost(arg0, arg1 string) (*model.Post, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BuildPost", arg0, arg1)
 	ret0, _ := ret[0].(*model.Post)
@@ -57,8 +73,23 @@ func (m *MockListService) BuildPost(arg0 string, arg1 string) (*model.Post, erro
 	return ret0, ret1
 }
 
-// Build indicates an expected call of Build.
-func (mr *MockListServiceMockRecorder) BuildPost(arg0 string, arg1 string) *gomock.Call {
+// BuildPost indicates an expected call of BuildPost.
+func (mr *MockListServiceMockRecorder) BuildPost(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildPost", reflect.TypeOf((*MockListService)(nil).BuildPost), arg0, arg1)
 }
+
+// Query mocks base method.
+func (m *MockListService) Query(arg0 *types.MessageQuery) (*types.MessagePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", arg0)
+	ret0, _ := ret[0].(*types.MessagePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockListServiceMockRecorder) Query(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockListService)(nil).Query), arg0)
+}
@@ -0,0 +1,92 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: MembershipService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockMembershipService is a mock of MembershipService interface.
+type MockMembershipService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMembershipServiceMockRecorder
+}
+
+// MockMembershipServiceMockRecorder is the mock recorder for MockMembershipService.
+type MockMembershipServiceMockRecorder struct {
+	mock *MockMembershipService
+}
+
+// NewMockMembershipService creates a new mock instance.
+func NewMockMembershipService(ctrl *gomock.Controller) *MockMembershipService {
+	mock := &MockMembershipService{ctrl: ctrl}
+	mock.recorder = &MockMembershipServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipService) EXPECT() *MockMembershipServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelMessage mocks base method.
+func (m *MockMembershipService) CancelMessage(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMessage", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMessage indicates an expected call of CancelMessage.
+func (mr *MockMembershipServiceMockRecorder) CancelMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMessage", reflect.TypeOf((*MockMembershipService)(nil).CancelMessage), arg0, arg1)
+}
+
+// MoveMessage mocks base method.
+func (m *MockMembershipService) MoveMessage(arg0, arg1, arg2 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMessage indicates an expected call of MoveMessage.
+func (mr *MockMembershipServiceMockRecorder) MoveMessage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMessage", reflect.TypeOf((*MockMembershipService)(nil).MoveMessage), arg0, arg1, arg2)
+}
+
+// MoveMessageToSelf mocks base method.
+func (m *MockMembershipService) MoveMessageToSelf(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveMessageToSelf", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveMessageToSelf indicates an expected call of MoveMessageToSelf.
+func (mr *MockMembershipServiceMockRecorder) MoveMessageToSelf(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveMessageToSelf", reflect.TypeOf((*MockMembershipService)(nil).MoveMessageToSelf), arg0, arg1)
+}
+
+// UserLeftChannel mocks base method.
+func (m *MockMembershipService) UserLeftChannel(arg0, arg1 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UserLeftChannel", arg0, arg1)
+}
+
+// UserLeftChannel indicates an expected call of UserLeftChannel.
+func (mr *MockMembershipServiceMockRecorder) UserLeftChannel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserLeftChannel", reflect.TypeOf((*MockMembershipService)(nil).UserLeftChannel), arg0, arg1)
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: MessageSender)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockMessageSender is a mock of MessageSender interface.
+type MockMessageSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageSenderMockRecorder
+}
+
+// MockMessageSenderMockRecorder is the mock recorder for MockMessageSender.
+type MockMessageSenderMockRecorder struct {
+	mock *MockMessageSender
+}
+
+// NewMockMessageSender creates a new mock instance.
+func NewMockMessageSender(ctrl *gomock.Controller) *MockMessageSender {
+	mock := &MockMessageSender{ctrl: ctrl}
+	mock.recorder = &MockMessageSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageSender) EXPECT() *MockMessageSenderMockRecorder {
+	return m.recorder
+}
+
+// SendNow mocks base method.
+func (m *MockMessageSender) SendNow(arg0 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNow", arg0)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendNow indicates an expected call of SendNow.
+func (mr *MockMessageSenderMockRecorder) SendNow(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNow", reflect.TypeOf((*MockMessageSender)(nil).SendNow), arg0)
+}
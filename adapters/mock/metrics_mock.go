@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: Metrics)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockMetrics is a mock of Metrics interface.
+type MockMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetricsMockRecorder
+}
+
+// MockMetricsMockRecorder is the mock recorder for MockMetrics.
+type MockMetricsMockRecorder struct {
+	mock *MockMetrics
+}
+
+// NewMockMetrics creates a new mock instance.
+func NewMockMetrics(ctrl *gomock.Controller) *MockMetrics {
+	mock := &MockMetrics{ctrl: ctrl}
+	mock.recorder = &MockMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetrics) EXPECT() *MockMetricsMockRecorder {
+	return m.recorder
+}
+
+// ObserveRequest mocks base method.
+func (m *MockMetrics) ObserveRequest(arg0 string, arg1 int, arg2 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveRequest", arg0, arg1, arg2)
+}
+
+// ObserveRequest indicates an expected call of ObserveRequest.
+func (mr *MockMetricsMockRecorder) ObserveRequest(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveRequest", reflect.TypeOf((*MockMetrics)(nil).ObserveRequest), arg0, arg1, arg2)
+}
+
+// ObserveStoreOp mocks base method.
+func (m *MockMetrics) ObserveStoreOp(arg0 string, arg1 time.Duration, arg2 error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveStoreOp", arg0, arg1, arg2)
+}
+
+// ObserveStoreOp indicates an expected call of ObserveStoreOp.
+func (mr *MockMetricsMockRecorder) ObserveStoreOp(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveStoreOp", reflect.TypeOf((*MockMetrics)(nil).ObserveStoreOp), arg0, arg1, arg2)
+}
+
+// ObserveTick mocks base method.
+func (m *MockMetrics) ObserveTick(arg0 time.Duration, arg1 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveTick", arg0, arg1)
+}
+
+// ObserveTick indicates an expected call of ObserveTick.
+func (mr *MockMetricsMockRecorder) ObserveTick(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveTick", reflect.TypeOf((*MockMetrics)(nil).ObserveTick), arg0, arg1)
+}
+
+// WriteMetrics mocks base method.
+func (m *MockMetrics) WriteMetrics(arg0 io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteMetrics", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WriteMetrics indicates an expected call of WriteMetrics.
+func (mr *MockMetricsMockRecorder) WriteMetrics(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteMetrics", reflect.TypeOf((*MockMetrics)(nil).WriteMetrics), arg0)
+}
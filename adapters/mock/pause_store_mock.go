@@ -0,0 +1,78 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: PauseStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockPauseStore is a mock of PauseStore interface.
+type MockPauseStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockPauseStoreMockRecorder
+}
+
+// MockPauseStoreMockRecorder is the mock recorder for MockPauseStore.
+type MockPauseStoreMockRecorder struct {
+	mock *MockPauseStore
+}
+
+// NewMockPauseStore creates a new mock instance.
+func NewMockPauseStore(ctrl *gomock.Controller) *MockPauseStore {
+	mock := &MockPauseStore{ctrl: ctrl}
+	mock.recorder = &MockPauseStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPauseStore) EXPECT() *MockPauseStoreMockRecorder {
+	return m.recorder
+}
+
+// DeletePauseState mocks base method.
+func (m *MockPauseStore) DeletePauseState() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePauseState")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePauseState indicates an expected call of DeletePauseState.
+func (mr *MockPauseStoreMockRecorder) DeletePauseState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePauseState", reflect.TypeOf((*MockPauseStore)(nil).DeletePauseState))
+}
+
+// GetPauseState mocks base method.
+func (m *MockPauseStore) GetPauseState() (*types.PauseState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPauseState")
+	ret0, _ := ret[0].(*types.PauseState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPauseState indicates an expected call of GetPauseState.
+func (mr *MockPauseStoreMockRecorder) GetPauseState() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPauseState", reflect.TypeOf((*MockPauseStore)(nil).GetPauseState))
+}
+
+// SavePauseState mocks base method.
+func (m *MockPauseStore) SavePauseState(arg0 *types.PauseState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePauseState", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePauseState indicates an expected call of SavePauseState.
+func (mr *MockPauseStoreMockRecorder) SavePauseState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePauseState", reflect.TypeOf((*MockPauseStore)(nil).SavePauseState), arg0)
+}
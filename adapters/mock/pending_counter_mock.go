@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: PendingCounter)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPendingCounter is a mock of PendingCounter interface.
+type MockPendingCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockPendingCounterMockRecorder
+}
+
+// MockPendingCounterMockRecorder is the mock recorder for MockPendingCounter.
+type MockPendingCounterMockRecorder struct {
+	mock *MockPendingCounter
+}
+
+// NewMockPendingCounter creates a new mock instance.
+func NewMockPendingCounter(ctrl *gomock.Controller) *MockPendingCounter {
+	mock := &MockPendingCounter{ctrl: ctrl}
+	mock.recorder = &MockPendingCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPendingCounter) EXPECT() *MockPendingCounterMockRecorder {
+	return m.recorder
+}
+
+// CountChannelMessages mocks base method.
+func (m *MockPendingCounter) CountChannelMessages(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountChannelMessages", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountChannelMessages indicates an expected call of CountChannelMessages.
+func (mr *MockPendingCounterMockRecorder) CountChannelMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountChannelMessages", reflect.TypeOf((*MockPendingCounter)(nil).CountChannelMessages), arg0)
+}
+
+// CountTeamMessages mocks base method.
+func (m *MockPendingCounter) CountTeamMessages(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTeamMessages", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTeamMessages indicates an expected call of CountTeamMessages.
+func (mr *MockPendingCounterMockRecorder) CountTeamMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTeamMessages", reflect.TypeOf((*MockPendingCounter)(nil).CountTeamMessages), arg0)
+}
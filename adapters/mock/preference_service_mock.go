@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: PreferenceService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost/server/public/model"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockPreferenceService is a mock of PreferenceService interface.
+type MockPreferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreferenceServiceMockRecorder
+}
+
+// MockPreferenceServiceMockRecorder is the mock recorder for MockPreferenceService.
+type MockPreferenceServiceMockRecorder struct {
+	mock *MockPreferenceService
+}
+
+// NewMockPreferenceService creates a new mock instance.
+func NewMockPreferenceService(ctrl *gomock.Controller) *MockPreferenceService {
+	mock := &MockPreferenceService{ctrl: ctrl}
+	mock.recorder = &MockPreferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreferenceService) EXPECT() *MockPreferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// Dialog mocks base method.
+func (m *MockPreferenceService) Dialog(arg0 string) (*model.Dialog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dialog", arg0)
+	ret0, _ := ret[0].(*model.Dialog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dialog indicates an expected call of Dialog.
+func (mr *MockPreferenceServiceMockRecorder) Dialog(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dialog", reflect.TypeOf((*MockPreferenceService)(nil).Dialog), arg0)
+}
+
+// Get mocks base method.
+func (m *MockPreferenceService) Get(arg0 string) (*types.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*types.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPreferenceServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPreferenceService)(nil).Get), arg0)
+}
+
+// Update mocks base method.
+func (m *MockPreferenceService) Update(arg0 string, arg1 *types.UserPreferences) (*types.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(*types.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPreferenceServiceMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPreferenceService)(nil).Update), arg0, arg1)
+}
+
+// UpdateFromDialog mocks base method.
+func (m *MockPreferenceService) UpdateFromDialog(arg0 string, arg1 map[string]interface{}) (*types.UserPreferences, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFromDialog", arg0, arg1)
+	ret0, _ := ret[0].(*types.UserPreferences)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFromDialog indicates an expected call of UpdateFromDialog.
+func (mr *MockPreferenceServiceMockRecorder) UpdateFromDialog(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFromDialog", reflect.TypeOf((*MockPreferenceService)(nil).UpdateFromDialog), arg0, arg1)
+}
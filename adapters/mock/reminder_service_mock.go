@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: ReminderService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockReminderService is a mock of ReminderService interface.
+type MockReminderService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderServiceMockRecorder
+}
+
+// MockReminderServiceMockRecorder is the mock recorder for MockReminderService.
+type MockReminderServiceMockRecorder struct {
+	mock *MockReminderService
+}
+
+// NewMockReminderService creates a new mock instance.
+func NewMockReminderService(ctrl *gomock.Controller) *MockReminderService {
+	mock := &MockReminderService{ctrl: ctrl}
+	mock.recorder = &MockReminderServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderService) EXPECT() *MockReminderServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockReminderService) Cancel(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockReminderServiceMockRecorder) Cancel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockReminderService)(nil).Cancel), arg0, arg1)
+}
+
+// SendNow mocks base method.
+func (m *MockReminderService) SendNow(arg0, arg1 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNow", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendNow indicates an expected call of SendNow.
+func (mr *MockReminderServiceMockRecorder) SendNow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNow", reflect.TypeOf((*MockReminderService)(nil).SendNow), arg0, arg1)
+}
@@ -6,9 +6,11 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/mattermost/mattermost/server/public/model"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 // MockScheduleService is a mock of ScheduleService interface.
@@ -48,8 +50,22 @@ func (mr *MockScheduleServiceMockRecorder) Build(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Build", reflect.TypeOf((*MockScheduleService)(nil).Build), arg0, arg1)
 }
 
-// Build mocks base method.
-func (m *MockScheduleService) BuildPost(arg0 string, arg1 string, arg2 []string, arg3 string) (*model.Post, error) {
+// BuildConfirmationPost mocks base method.
+func (m *MockScheduleService) BuildConfirmationPost(arg0 *types.ScheduledMessage) *model.Post {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildConfirmationPost", arg0)
+	ret0, _ := ret[0].(*model.Post)
+	return ret0
+}
+
+// BuildConfirmationPost indicates an expected call of BuildConfirmationPost.
+func (mr *MockScheduleServiceMockRecorder) BuildConfirmationPost(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildConfirmationPost", reflect.TypeOf((*MockScheduleService)(nil).BuildConfirmationPost), arg0)
+}
+
+// BuildPost mocks base method.
+func (m *MockScheduleService) BuildPost(arg0, arg1 string, arg2 []string, arg3 string) (*model.Post, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BuildPost", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(*model.Post)
@@ -57,8 +73,96 @@ func (m *MockScheduleService) BuildPost(arg0 string, arg1 string, arg2 []string,
 	return ret0, ret1
 }
 
-// Build indicates an expected call of Build.
-func (mr *MockScheduleServiceMockRecorder) BuildPost(arg0 string, arg1 string, arg2 []string, arg3 string) *gomock.Call {
+// BuildPost indicates an expected call of BuildPost.
+func (mr *MockScheduleServiceMockRecorder) BuildPost(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildPost", reflect.TypeOf((*MockScheduleService)(nil).BuildPost), arg0, arg1, arg2, arg3)
 }
+
+// Postpone mocks base method.
+func (m *MockScheduleService) Postpone(arg0, arg1, arg2 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Postpone", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Postpone indicates an expected call of Postpone.
+func (mr *MockScheduleServiceMockRecorder) Postpone(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Postpone", reflect.TypeOf((*MockScheduleService)(nil).Postpone), arg0, arg1, arg2)
+}
+
+// PostponeBy mocks base method.
+func (m *MockScheduleService) PostponeBy(arg0, arg1 string, arg2 time.Duration) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostponeBy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostponeBy indicates an expected call of PostponeBy.
+func (mr *MockScheduleServiceMockRecorder) PostponeBy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostponeBy", reflect.TypeOf((*MockScheduleService)(nil).PostponeBy), arg0, arg1, arg2)
+}
+
+// Retime mocks base method.
+func (m *MockScheduleService) Retime(arg0 *types.ScheduledMessage, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retime", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retime indicates an expected call of Retime.
+func (mr *MockScheduleServiceMockRecorder) Retime(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retime", reflect.TypeOf((*MockScheduleService)(nil).Retime), arg0, arg1, arg2)
+}
+
+// ScheduleIntegrationMessage mocks base method.
+func (m *MockScheduleService) ScheduleIntegrationMessage(arg0 *types.IntegrationToken, arg1 string, arg2 []string, arg3 *types.PostMetadata, arg4 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleIntegrationMessage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleIntegrationMessage indicates an expected call of ScheduleIntegrationMessage.
+func (mr *MockScheduleServiceMockRecorder) ScheduleIntegrationMessage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleIntegrationMessage", reflect.TypeOf((*MockScheduleService)(nil).ScheduleIntegrationMessage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// ScheduleMessage mocks base method.
+func (m *MockScheduleService) ScheduleMessage(arg0, arg1 string, arg2 []string, arg3 *types.PostMetadata, arg4 string) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleMessage", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleMessage indicates an expected call of ScheduleMessage.
+func (mr *MockScheduleServiceMockRecorder) ScheduleMessage(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMessage", reflect.TypeOf((*MockScheduleService)(nil).ScheduleMessage), arg0, arg1, arg2, arg3, arg4)
+}
+
+// UserTimezone mocks base method.
+func (m *MockScheduleService) UserTimezone(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserTimezone", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// UserTimezone indicates an expected call of UserTimezone.
+func (mr *MockScheduleServiceMockRecorder) UserTimezone(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserTimezone", reflect.TypeOf((*MockScheduleService)(nil).UserTimezone), arg0)
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: SchedulerMonitor)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockSchedulerMonitor is a mock of SchedulerMonitor interface.
+type MockSchedulerMonitor struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMonitorMockRecorder
+}
+
+// MockSchedulerMonitorMockRecorder is the mock recorder for MockSchedulerMonitor.
+type MockSchedulerMonitorMockRecorder struct {
+	mock *MockSchedulerMonitor
+}
+
+// NewMockSchedulerMonitor creates a new mock instance.
+func NewMockSchedulerMonitor(ctrl *gomock.Controller) *MockSchedulerMonitor {
+	mock := &MockSchedulerMonitor{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMonitorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedulerMonitor) EXPECT() *MockSchedulerMonitorMockRecorder {
+	return m.recorder
+}
+
+// Health mocks base method.
+func (m *MockSchedulerMonitor) Health() types.SchedulerHealth {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Health")
+	ret0, _ := ret[0].(types.SchedulerHealth)
+	return ret0
+}
+
+// Health indicates an expected call of Health.
+func (mr *MockSchedulerMonitorMockRecorder) Health() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockSchedulerMonitor)(nil).Health))
+}
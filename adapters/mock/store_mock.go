@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
@@ -121,6 +122,51 @@ func (mr *MockStoreMockRecorder) ListUserMessageIDs(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserMessageIDs", reflect.TypeOf((*MockStore)(nil).ListUserMessageIDs), arg0)
 }
 
+// ListUserMessages mocks base method.
+func (m *MockStore) ListUserMessages(arg0 string) ([]*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserMessages", arg0)
+	ret0, _ := ret[0].([]*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserMessages indicates an expected call of ListUserMessages.
+func (mr *MockStoreMockRecorder) ListUserMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserMessages", reflect.TypeOf((*MockStore)(nil).ListUserMessages), arg0)
+}
+
+// MarkReminded mocks base method.
+func (m *MockStore) MarkReminded(arg0 string, arg1 time.Time) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReminded", arg0, arg1)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReminded indicates an expected call of MarkReminded.
+func (mr *MockStoreMockRecorder) MarkReminded(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReminded", reflect.TypeOf((*MockStore)(nil).MarkReminded), arg0, arg1)
+}
+
+// QueryMessages mocks base method.
+func (m *MockStore) QueryMessages(arg0 *types.MessageQuery) (*types.MessagePage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryMessages", arg0)
+	ret0, _ := ret[0].(*types.MessagePage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryMessages indicates an expected call of QueryMessages.
+func (mr *MockStoreMockRecorder) QueryMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryMessages", reflect.TypeOf((*MockStore)(nil).QueryMessages), arg0)
+}
+
 // SaveScheduledMessage mocks base method.
 func (m *MockStore) SaveScheduledMessage(arg0 string, arg1 *types.ScheduledMessage) error {
 	m.ctrl.T.Helper()
@@ -134,3 +180,30 @@ func (mr *MockStoreMockRecorder) SaveScheduledMessage(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveScheduledMessage", reflect.TypeOf((*MockStore)(nil).SaveScheduledMessage), arg0, arg1)
 }
+
+// SetMaxUserMessages mocks base method.
+func (m *MockStore) SetMaxUserMessages(arg0 int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetMaxUserMessages", arg0)
+}
+
+// SetMaxUserMessages indicates an expected call of SetMaxUserMessages.
+func (mr *MockStoreMockRecorder) SetMaxUserMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxUserMessages", reflect.TypeOf((*MockStore)(nil).SetMaxUserMessages), arg0)
+}
+
+// UpdateScheduledMessage mocks base method.
+func (m *MockStore) UpdateScheduledMessage(arg0, arg1 string, arg2 func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledMessage", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*types.ScheduledMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledMessage indicates an expected call of UpdateScheduledMessage.
+func (mr *MockStoreMockRecorder) UpdateScheduledMessage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledMessage", reflect.TypeOf((*MockStore)(nil).UpdateScheduledMessage), arg0, arg1, arg2)
+}
@@ -34,6 +34,21 @@ func (m *MockTeamService) EXPECT() *MockTeamServiceMockRecorder {
 	return m.recorder
 }
 
+// CreateMember mocks base method.
+func (m *MockTeamService) CreateMember(arg0, arg1 string) (*model.TeamMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMember", arg0, arg1)
+	ret0, _ := ret[0].(*model.TeamMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMember indicates an expected call of CreateMember.
+func (mr *MockTeamServiceMockRecorder) CreateMember(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMember", reflect.TypeOf((*MockTeamService)(nil).CreateMember), arg0, arg1)
+}
+
 // Get mocks base method.
 func (m *MockTeamService) Get(arg0 string) (*model.Team, error) {
 	m.ctrl.T.Helper()
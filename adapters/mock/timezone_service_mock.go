@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: TimezoneService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTimezoneService is a mock of TimezoneService interface.
+type MockTimezoneService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimezoneServiceMockRecorder
+}
+
+// MockTimezoneServiceMockRecorder is the mock recorder for MockTimezoneService.
+type MockTimezoneServiceMockRecorder struct {
+	mock *MockTimezoneService
+}
+
+// NewMockTimezoneService creates a new mock instance.
+func NewMockTimezoneService(ctrl *gomock.Controller) *MockTimezoneService {
+	mock := &MockTimezoneService{ctrl: ctrl}
+	mock.recorder = &MockTimezoneServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimezoneService) EXPECT() *MockTimezoneServiceMockRecorder {
+	return m.recorder
+}
+
+// UserTimezone mocks base method.
+func (m *MockTimezoneService) UserTimezone(arg0 string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserTimezone", arg0)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// UserTimezone indicates an expected call of UserTimezone.
+func (mr *MockTimezoneServiceMockRecorder) UserTimezone(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserTimezone", reflect.TypeOf((*MockTimezoneService)(nil).UserTimezone), arg0)
+}
@@ -48,3 +48,46 @@ func (mr *MockUserServiceMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUserService)(nil).Get), arg0)
 }
+
+// GetByUsername mocks base method.
+func (m *MockUserService) GetByUsername(arg0 string) (*model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUsername", arg0)
+	ret0, _ := ret[0].(*model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUsername indicates an expected call of GetByUsername.
+func (mr *MockUserServiceMockRecorder) GetByUsername(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUsername", reflect.TypeOf((*MockUserService)(nil).GetByUsername), arg0)
+}
+
+// HasPermissionTo mocks base method.
+func (m *MockUserService) HasPermissionTo(arg0 string, arg1 *model.Permission) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermissionTo", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasPermissionTo indicates an expected call of HasPermissionTo.
+func (mr *MockUserServiceMockRecorder) HasPermissionTo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermissionTo", reflect.TypeOf((*MockUserService)(nil).HasPermissionTo), arg0, arg1)
+}
+
+// HasPermissionToChannel mocks base method.
+func (m *MockUserService) HasPermissionToChannel(arg0, arg1 string, arg2 *model.Permission) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermissionToChannel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasPermissionToChannel indicates an expected call of HasPermissionToChannel.
+func (mr *MockUserServiceMockRecorder) HasPermissionToChannel(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermissionToChannel", reflect.TypeOf((*MockUserService)(nil).HasPermissionToChannel), arg0, arg1, arg2)
+}
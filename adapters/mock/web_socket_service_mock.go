@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: WebSocketService)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/mattermost/mattermost/server/public/model"
+)
+
+// MockWebSocketService is a mock of WebSocketService interface.
+type MockWebSocketService struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebSocketServiceMockRecorder
+}
+
+// MockWebSocketServiceMockRecorder is the mock recorder for MockWebSocketService.
+type MockWebSocketServiceMockRecorder struct {
+	mock *MockWebSocketService
+}
+
+// NewMockWebSocketService creates a new mock instance.
+func NewMockWebSocketService(ctrl *gomock.Controller) *MockWebSocketService {
+	mock := &MockWebSocketService{ctrl: ctrl}
+	mock.recorder = &MockWebSocketServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebSocketService) EXPECT() *MockWebSocketServiceMockRecorder {
+	return m.recorder
+}
+
+// PublishWebSocketEvent mocks base method.
+func (m *MockWebSocketService) PublishWebSocketEvent(arg0 string, arg1 map[string]interface{}, arg2 *model.WebsocketBroadcast) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PublishWebSocketEvent", arg0, arg1, arg2)
+}
+
+// PublishWebSocketEvent indicates an expected call of PublishWebSocketEvent.
+func (mr *MockWebSocketServiceMockRecorder) PublishWebSocketEvent(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishWebSocketEvent", reflect.TypeOf((*MockWebSocketService)(nil).PublishWebSocketEvent), arg0, arg1, arg2)
+}
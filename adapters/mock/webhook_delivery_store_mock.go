@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports (interfaces: WebhookDeliveryStore)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// MockWebhookDeliveryStore is a mock of WebhookDeliveryStore interface.
+type MockWebhookDeliveryStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryStoreMockRecorder
+}
+
+// MockWebhookDeliveryStoreMockRecorder is the mock recorder for MockWebhookDeliveryStore.
+type MockWebhookDeliveryStoreMockRecorder struct {
+	mock *MockWebhookDeliveryStore
+}
+
+// NewMockWebhookDeliveryStore creates a new mock instance.
+func NewMockWebhookDeliveryStore(ctrl *gomock.Controller) *MockWebhookDeliveryStore {
+	mock := &MockWebhookDeliveryStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryStore) EXPECT() *MockWebhookDeliveryStoreMockRecorder {
+	return m.recorder
+}
+
+// ListDeliveries mocks base method.
+func (m *MockWebhookDeliveryStore) ListDeliveries() ([]*types.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveries")
+	ret0, _ := ret[0].([]*types.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeliveries indicates an expected call of ListDeliveries.
+func (mr *MockWebhookDeliveryStoreMockRecorder) ListDeliveries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveries", reflect.TypeOf((*MockWebhookDeliveryStore)(nil).ListDeliveries))
+}
+
+// SaveDelivery mocks base method.
+func (m *MockWebhookDeliveryStore) SaveDelivery(arg0 *types.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDelivery", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDelivery indicates an expected call of SaveDelivery.
+func (mr *MockWebhookDeliveryStoreMockRecorder) SaveDelivery(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDelivery", reflect.TypeOf((*MockWebhookDeliveryStore)(nil).SaveDelivery), arg0)
+}
@@ -14,3 +14,41 @@ package ports
 //go:generate mockgen -destination=../../adapters/mock/scheduler_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports Scheduler
 //go:generate mockgen -destination=../../adapters/mock/list_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports ListService
 //go:generate mockgen -destination=../../adapters/mock/schedule_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports ScheduleService
+//go:generate mockgen -destination=../../adapters/mock/config_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports ConfigService
+//go:generate mockgen -destination=../../adapters/mock/feed_token_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports FeedTokenStore
+//go:generate mockgen -destination=../../adapters/mock/feed_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports FeedService
+//go:generate mockgen -destination=../../adapters/mock/webhook_delivery_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports WebhookDeliveryStore
+//go:generate mockgen -destination=../../adapters/mock/event_notifier_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports EventNotifier
+//go:generate mockgen -destination=../../adapters/mock/integration_token_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports IntegrationTokenStore
+//go:generate mockgen -destination=../../adapters/mock/integration_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports IntegrationService
+//go:generate mockgen -destination=../../adapters/mock/idempotency_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports IdempotencyStore
+//go:generate mockgen -destination=../../adapters/mock/admin_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports AdminService
+//go:generate mockgen -destination=../../adapters/mock/channel_policy_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports ChannelPolicyStore
+//go:generate mockgen -destination=../../adapters/mock/policy_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports PolicyService
+//go:generate mockgen -destination=../../adapters/mock/audit_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports AuditStore
+//go:generate mockgen -destination=../../adapters/mock/audit_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports AuditService
+//go:generate mockgen -destination=../../adapters/mock/history_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports HistoryStore
+//go:generate mockgen -destination=../../adapters/mock/history_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports HistoryService
+//go:generate mockgen -destination=../../adapters/mock/metrics_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports Metrics
+//go:generate mockgen -destination=../../adapters/mock/key_counter_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports KeyCounter
+//go:generate mockgen -destination=../../adapters/mock/pending_counter_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports PendingCounter
+//go:generate mockgen -destination=../../adapters/mock/scheduler_monitor_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports SchedulerMonitor
+//go:generate mockgen -destination=../../adapters/mock/pause_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports PauseStore
+//go:generate mockgen -destination=../../adapters/mock/pause_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports PauseService
+//go:generate mockgen -destination=../../adapters/mock/user_directory_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports UserDirectory
+//go:generate mockgen -destination=../../adapters/mock/held_message_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports HeldMessageStore
+//go:generate mockgen -destination=../../adapters/mock/direct_channel_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports DirectChannelService
+//go:generate mockgen -destination=../../adapters/mock/membership_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports MembershipService
+//go:generate mockgen -destination=../../adapters/mock/preference_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports PreferenceStore
+//go:generate mockgen -destination=../../adapters/mock/message_sender_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports MessageSender
+//go:generate mockgen -destination=../../adapters/mock/reminder_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports ReminderService
+//go:generate mockgen -destination=../../adapters/mock/timezone_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports TimezoneService
+//go:generate mockgen -destination=../../adapters/mock/digest_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports DigestService
+//go:generate mockgen -destination=../../adapters/mock/failed_message_store_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports FailedMessageStore
+//go:generate mockgen -destination=../../adapters/mock/failure_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports FailureService
+//go:generate mockgen -destination=../../adapters/mock/preference_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports PreferenceService
+//go:generate mockgen -destination=../../adapters/mock/dialog_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports DialogService
+//go:generate mockgen -destination=../../adapters/mock/bot_delivery_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports BotDeliveryService
+//go:generate mockgen -destination=../../adapters/mock/file_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports FileService
+//go:generate mockgen -destination=../../adapters/mock/web_socket_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports WebSocketService
+//go:generate mockgen -destination=../../adapters/mock/draft_service_mock.go -package=mock github.com/apartmentlines/mattermost-plugin-poor-mans-scheduled-messages/internal/ports DraftService
@@ -1,6 +1,9 @@
 package ports
 
 import (
+	"io"
+	"time"
+
 	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/mattermost/mattermost/server/public/pluginapi"
 
@@ -34,15 +37,27 @@ type ChannelInfo struct {
 type ChannelService interface {
 	GetInfoOrUnknown(channelID string) *ChannelInfo
 	MakeChannelLink(info *ChannelInfo) string
+	EnsureMember(channelID, userID string) error
 }
 
 type ChannelDataService interface {
 	Get(channelID string) (*model.Channel, error)
 	ListMembers(channelID string, page, perPage int) ([]*model.ChannelMember, error)
+	GetMember(channelID, userID string) (*model.ChannelMember, error)
+	AddMember(channelID, userID string) (*model.ChannelMember, error)
+}
+
+type DirectChannelService interface {
+	GetDirect(userID1, userID2 string) (*model.Channel, error)
 }
 
 type TeamService interface {
 	Get(teamID string) (*model.Team, error)
+	CreateMember(teamID, userID string) (*model.TeamMember, error)
+}
+
+type ConfigService interface {
+	GetConfig() *model.Config
 }
 
 type SlashCommandService interface {
@@ -51,6 +66,14 @@ type SlashCommandService interface {
 
 type UserService interface {
 	Get(userID string) (*model.User, error)
+	GetByUsername(username string) (*model.User, error)
+	HasPermissionTo(userID string, permission *model.Permission) bool
+	HasPermissionToChannel(userID, channelID string, permission *model.Permission) bool
+}
+
+type UserDirectory interface {
+	Get(userID string) (*model.User, error)
+	List(options *model.UserGetOptions) ([]*model.User, error)
 }
 
 type KVService interface {
@@ -77,9 +100,107 @@ type Store interface {
 	DeleteScheduledMessage(userID string, msgID string) error
 	CleanupMessageFromUserIndex(userID string, msgID string) error
 	GetScheduledMessage(msgID string) (*types.ScheduledMessage, error)
+	MarkReminded(msgID string, remindedAt time.Time) (*types.ScheduledMessage, error)
+	UpdateScheduledMessage(userID, msgID string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error)
 	ListScheduledMessages() ([]*types.ScheduledMessage, error)
 	ListUserMessageIDs(userID string) ([]string, error)
+	ListUserMessages(userID string) ([]*types.ScheduledMessage, error)
+	QueryMessages(query *types.MessageQuery) (*types.MessagePage, error)
 	GenerateMessageID() string
+	SetMaxUserMessages(limit int)
+}
+
+type FeedTokenStore interface {
+	GetFeedToken(userID string) (string, error)
+	SaveFeedToken(userID string, token string) error
+	GetFeedTokenOwner(token string) (string, error)
+}
+
+type WebhookDeliveryStore interface {
+	SaveDelivery(delivery *types.WebhookDelivery) error
+	ListDeliveries() ([]*types.WebhookDelivery, error)
+}
+
+type IntegrationTokenStore interface {
+	SaveToken(token *types.IntegrationToken) error
+	ListTokens() ([]*types.IntegrationToken, error)
+	GetTokenByHash(hash string) (*types.IntegrationToken, error)
+	DeleteToken(id string) (bool, error)
+}
+
+type IdempotencyStore interface {
+	Reserve(userID string, key string, record *types.IdempotencyRecord) (bool, *types.IdempotencyRecord, error)
+	Complete(userID string, key string, record *types.IdempotencyRecord) error
+	Release(userID string, key string) error
+}
+
+type ChannelPolicyStore interface {
+	GetChannelPolicy(channelID string) (*types.ChannelPolicy, error)
+	SaveChannelPolicy(policy *types.ChannelPolicy) error
+	DeleteChannelPolicy(channelID string) error
+}
+
+type AuditStore interface {
+	SaveAuditEntry(entry *types.AuditEntry) error
+	ListAuditEntries(query *types.AuditQuery) ([]*types.AuditEntry, error)
+	DeleteAuditEntriesBefore(cutoff time.Time) (int, error)
+}
+
+type HistoryStore interface {
+	SaveHistoryEntry(userID string, entry *types.HistoryEntry, cutoff time.Time) error
+	ListHistory(userID string) ([]*types.HistoryEntry, error)
+}
+
+// PendingCounter reports how many messages are pending in a channel or team,
+// for the policy caps.
+type PendingCounter interface {
+	CountChannelMessages(channelID string) (int, error)
+	CountTeamMessages(teamID string) (int, error)
+}
+
+type KeyCounter interface {
+	CountKeys(prefix string) (int, error)
+}
+
+// HeldMessageStore keeps a deactivated user's messages out of the schedule
+// until the user is reactivated.
+type HeldMessageStore interface {
+	SaveHeldMessages(userID string, msgs []*types.ScheduledMessage) error
+	GetHeldMessages(userID string) ([]*types.ScheduledMessage, error)
+	DeleteHeldMessages(userID string) error
+	ListHeldUserIDs() ([]string, error)
+}
+
+type FailedMessageStore interface {
+	SaveFailedMessage(failed *types.FailedMessage) error
+	GetFailedMessage(msgID string) (*types.FailedMessage, error)
+	DeleteFailedMessage(msgID string) error
+}
+
+// PreferenceStore keeps a user's preferences. MarkDigestSent records the date
+// of the user's last digest apart from them and reports false if it was
+// already recorded.
+type PreferenceStore interface {
+	GetPreferences(userID string) (*types.UserPreferences, error)
+	SavePreferences(userID string, prefs *types.UserPreferences) error
+	MarkDigestSent(userID, date string) (bool, error)
+}
+
+type PauseStore interface {
+	GetPauseState() (*types.PauseState, error)
+	SavePauseState(state *types.PauseState) error
+	DeletePauseState() error
+}
+
+type EventNotifier interface {
+	Notify(event *types.LifecycleEvent)
+}
+
+type Metrics interface {
+	ObserveTick(duration time.Duration, pending int)
+	ObserveStoreOp(op string, duration time.Duration, err error)
+	ObserveRequest(route string, status int, duration time.Duration)
+	WriteMetrics(w io.Writer) error
 }
 
 type Scheduler interface {
@@ -87,12 +208,159 @@ type Scheduler interface {
 	Stop()
 }
 
+// MessageSender delivers a pending message immediately instead of waiting for
+// its PostAt.
+// TimezoneService resolves the timezone a user's times are shown in.
+type TimezoneService interface {
+	UserTimezone(userID string) string
+}
+
+// DigestService sends digests of upcoming messages. The scheduler calls
+// SendDue on each tick with the owners of all pending messages.
+type DigestService interface {
+	SendDue(userIDs []string, now time.Time)
+}
+
+// BotDeliveryService turns the post for a message set to be posted as the
+// bot into the bot's post, joining the bot to the channel if needed.
+type BotDeliveryService interface {
+	Prepare(msg *types.ScheduledMessage, post *model.Post) error
+}
+
+type FileService interface {
+	CopyInfos(ids []string, userID string) ([]string, error)
+}
+
+// FailureService carries out what the owner picks from a failure DM. Draft
+// moves the message to the owner's drafts and forgets the failed message.
+type FailureService interface {
+	Retry(userID, msgID string) (*types.ScheduledMessage, error)
+	Reschedule(userID, msgID string, by time.Duration) (*types.ScheduledMessage, error)
+	Retarget(userID, msgID, channelID string) (*types.ScheduledMessage, error)
+	Draft(userID, msgID string) (*types.ScheduledMessage, error)
+}
+
+// PreferenceService reads and validates a user's preferences, from the JSON
+// API or the settings dialog. Invalid values are reported as
+// *types.PreferenceError.
+type PreferenceService interface {
+	Get(userID string) (*types.UserPreferences, error)
+	Update(userID string, prefs *types.UserPreferences) (*types.UserPreferences, error)
+	Dialog(userID string) (*model.Dialog, error)
+	UpdateFromDialog(userID string, submission map[string]any) (*types.UserPreferences, error)
+}
+
+// DialogService opens interactive dialogs in the webapp.
+type DialogService interface {
+	OpenInteractiveDialog(dialog model.OpenDialogRequest) error
+}
+
+// WebSocketService sends plugin events to connected webapps.
+type WebSocketService interface {
+	PublishWebSocketEvent(event string, payload map[string]any, broadcast *model.WebsocketBroadcast)
+}
+
+// DraftService cancels a user's scheduled message and hands it to their
+// webapp, which saves it as a draft in the message's channel.
+// MoveNextToDraft picks the user's next message due in the channel.
+// SendToDrafts only hands a message to the webapp, for messages that are no
+// longer scheduled.
+type DraftService interface {
+	MoveToDraft(userID, msgID string) (*types.ScheduledMessage, error)
+	MoveNextToDraft(userID, channelID string) (*types.ScheduledMessage, error)
+	SendToDrafts(msg *types.ScheduledMessage)
+}
+
+type MessageSender interface {
+	SendNow(msgID string) (*types.ScheduledMessage, error)
+}
+
+type SchedulerMonitor interface {
+	Health() types.SchedulerHealth
+}
+
 type ListService interface {
-	Build(userID string) *model.CommandResponse
+	Build(query *types.MessageQuery) *model.CommandResponse
 	BuildPost(userID string, channelID string) (*model.Post, error)
+	BuildDigest(query *types.MessageQuery, header string) (*model.Post, error)
+	Query(query *types.MessageQuery) (*types.MessagePage, error)
 }
 
 type ScheduleService interface {
 	Build(args *model.CommandArgs, text string) *model.CommandResponse
 	BuildPost(userID string, channelID string, fileIDs []string, text string) (*model.Post, error)
+	ScheduleMessage(userID string, channelID string, fileIDs []string, meta *types.PostMetadata, text string) (*types.ScheduledMessage, error)
+	ScheduleIntegrationMessage(token *types.IntegrationToken, channelID string, fileIDs []string, meta *types.PostMetadata, text string) (*types.ScheduledMessage, error)
+	BuildConfirmationPost(msg *types.ScheduledMessage) *model.Post
+	UserTimezone(userID string) string
+	Postpone(userID, msgID, option string) (*types.ScheduledMessage, error)
+	PostponeBy(userID, msgID string, by time.Duration) (*types.ScheduledMessage, error)
+	Retime(msg *types.ScheduledMessage, channelID string, postAt time.Time) error
+}
+
+type FeedService interface {
+	URL(userID string) (string, error)
+	Rotate(userID string) (string, error)
+	Render(token string) ([]byte, error)
+}
+
+type AdminService interface {
+	ListMessages(userID string, channelID string) ([]*types.ScheduledMessage, error)
+	CancelMessage(adminID string, msgID string) (*types.ScheduledMessage, error)
+	Status() (*types.SchedulerStatus, error)
+	PauseDelivery(adminID string, resumeAt *time.Time, mode types.ResumeMode) (*types.PauseState, error)
+	ResumeDelivery(adminID string, mode types.ResumeMode) (*types.ResumeResult, error)
+}
+
+// MembershipService follows up on pending messages whose owner left their
+// channel. The owner picks, from a DM, whether each message moves to another
+// channel, goes to their own DM channel or is cancelled.
+type MembershipService interface {
+	UserLeftChannel(userID, channelID string)
+	MoveMessage(userID, msgID, channelID string) (*types.ScheduledMessage, error)
+	MoveMessageToSelf(userID, msgID string) (*types.ScheduledMessage, error)
+	CancelMessage(userID, msgID string) (*types.ScheduledMessage, error)
+}
+
+// ReminderService carries out the owner's choice from a pre-send reminder.
+type ReminderService interface {
+	SendNow(userID, msgID string) (*types.ScheduledMessage, error)
+	Cancel(userID, msgID string) (*types.ScheduledMessage, error)
+}
+
+// PauseService holds delivery of every scheduled message while an admin has
+// paused it. DeliveryPaused is checked by the scheduler on each tick and
+// resumes delivery itself once the pause's resume time has passed.
+type PauseService interface {
+	Pause(adminID string, resumeAt *time.Time, mode types.ResumeMode) (*types.PauseState, error)
+	Resume(actorID string, mode types.ResumeMode) (*types.ResumeResult, error)
+	State() (*types.PauseState, error)
+	DeliveryPaused() bool
+}
+
+type AuditService interface {
+	Query(query *types.AuditQuery) ([]*types.AuditEntry, error)
+}
+
+type HistoryService interface {
+	List(userID string) ([]*types.HistoryEntry, error)
+	Permalink(postID string) string
+}
+
+type PolicyService interface {
+	CheckSchedule(channelID string) error
+	CheckSend(msg *types.ScheduledMessage) error
+	CheckBotDelivery() error
+	GetChannelPolicy(channelID string) (*types.ChannelPolicy, error)
+	SetChannelPolicy(userID string, policy *types.ChannelPolicy) error
+	QuietHours(channelID string) (*types.QuietHours, error)
+}
+
+type IntegrationService interface {
+	Issue(name string, channelIDs []string, botUserID string, createdBy string) (*types.IntegrationToken, string, error)
+	List() ([]*types.IntegrationToken, error)
+	Revoke(id string) (bool, error)
+	Authenticate(secret string) (*types.IntegrationToken, error)
+	ListMessages(token *types.IntegrationToken, query *types.MessageQuery) (*types.MessagePage, error)
+	GetMessage(token *types.IntegrationToken, msgID string) (*types.ScheduledMessage, error)
 }
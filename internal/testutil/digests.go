@@ -0,0 +1,13 @@
+package testutil
+
+import "time"
+
+// FakeDigests is a DigestService that records the owners it is asked to
+// send digests to on each tick.
+type FakeDigests struct {
+	Calls [][]string
+}
+
+func (f *FakeDigests) SendDue(userIDs []string, _ time.Time) {
+	f.Calls = append(f.Calls, userIDs)
+}
@@ -0,0 +1,37 @@
+package testutil
+
+import "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+
+// FakeFailedMessages is an in-memory FailedMessageStore. SaveErr, when set,
+// is returned by SaveFailedMessage instead of saving.
+type FakeFailedMessages struct {
+	Messages map[string]*types.FailedMessage
+	SaveErr  error
+}
+
+func (f *FakeFailedMessages) SaveFailedMessage(failed *types.FailedMessage) error {
+	if f.SaveErr != nil {
+		return f.SaveErr
+	}
+	if f.Messages == nil {
+		f.Messages = map[string]*types.FailedMessage{}
+	}
+	f.Messages[failed.Message.ID] = failed
+	return nil
+}
+
+func (f *FakeFailedMessages) GetFailedMessage(msgID string) (*types.FailedMessage, error) {
+	failed, ok := f.Messages[msgID]
+	if !ok {
+		return nil, types.ErrMessageNotFound
+	}
+	msg := *failed.Message
+	copied := *failed
+	copied.Message = &msg
+	return &copied, nil
+}
+
+func (f *FakeFailedMessages) DeleteFailedMessage(msgID string) error {
+	delete(f.Messages, msgID)
+	return nil
+}
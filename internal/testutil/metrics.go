@@ -0,0 +1,46 @@
+package testutil
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// FakeMetrics records scheduler ticks and counts store operations so tests can
+// assert on them.
+type FakeMetrics struct {
+	mu        sync.Mutex
+	Pending   []int
+	StoreOps  map[string]int
+	StoreErrs map[string]int
+	Requests  []string
+}
+
+func (f *FakeMetrics) ObserveTick(_ time.Duration, pending int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Pending = append(f.Pending, pending)
+}
+
+func (f *FakeMetrics) ObserveStoreOp(op string, _ time.Duration, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.StoreOps == nil {
+		f.StoreOps, f.StoreErrs = map[string]int{}, map[string]int{}
+	}
+	f.StoreOps[op]++
+	if err != nil {
+		f.StoreErrs[op]++
+	}
+}
+
+func (f *FakeMetrics) ObserveRequest(route string, _ int, _ time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.Requests = append(f.Requests, route)
+}
+
+func (f *FakeMetrics) WriteMetrics(w io.Writer) error {
+	_, err := io.WriteString(w, "# fake metrics\n")
+	return err
+}
@@ -0,0 +1,25 @@
+package testutil
+
+import (
+	"sync"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// FakeNotifier records lifecycle events so tests can assert on them.
+type FakeNotifier struct {
+	mu     sync.Mutex
+	events []*types.LifecycleEvent
+}
+
+func (f *FakeNotifier) Notify(event *types.LifecycleEvent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+}
+
+func (f *FakeNotifier) Events() []*types.LifecycleEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]*types.LifecycleEvent(nil), f.events...)
+}
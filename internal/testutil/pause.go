@@ -0,0 +1,31 @@
+package testutil
+
+import (
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// FakePause is a PauseService whose pause is whatever Current holds. Resume
+// clears it and DeliveryPaused reports whether it is set.
+type FakePause struct {
+	Current *types.PauseState
+}
+
+func (f *FakePause) Pause(adminID string, resumeAt *time.Time, mode types.ResumeMode) (*types.PauseState, error) {
+	f.Current = &types.PauseState{PausedBy: adminID, ResumeAt: resumeAt, ResumeMode: mode}
+	return f.Current, nil
+}
+
+func (f *FakePause) Resume(_ string, mode types.ResumeMode) (*types.ResumeResult, error) {
+	if f.Current == nil {
+		return nil, types.ErrDeliveryNotPaused
+	}
+	result := &types.ResumeResult{Pause: f.Current, Mode: mode}
+	f.Current = nil
+	return result, nil
+}
+
+func (f *FakePause) State() (*types.PauseState, error) { return f.Current, nil }
+
+func (f *FakePause) DeliveryPaused() bool { return f.Current != nil }
@@ -0,0 +1,33 @@
+package testutil
+
+import "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+
+// FakePolicy is a PolicyService that allows everything unless ScheduleErr,
+// SendErr or BotDeliveryErr is set. Quiet applies to every channel when set.
+type FakePolicy struct {
+	ScheduleErr    error
+	SendErr        error
+	BotDeliveryErr error
+	Rules          map[string]*types.ChannelPolicy
+	Quiet          *types.QuietHours
+}
+
+func (f *FakePolicy) CheckSchedule(string) error { return f.ScheduleErr }
+
+func (f *FakePolicy) CheckSend(*types.ScheduledMessage) error { return f.SendErr }
+
+func (f *FakePolicy) CheckBotDelivery() error { return f.BotDeliveryErr }
+
+func (f *FakePolicy) GetChannelPolicy(channelID string) (*types.ChannelPolicy, error) {
+	return f.Rules[channelID], nil
+}
+
+func (f *FakePolicy) SetChannelPolicy(_ string, policy *types.ChannelPolicy) error {
+	if f.Rules == nil {
+		f.Rules = map[string]*types.ChannelPolicy{}
+	}
+	f.Rules[policy.ChannelID] = policy
+	return nil
+}
+
+func (f *FakePolicy) QuietHours(string) (*types.QuietHours, error) { return f.Quiet, nil }
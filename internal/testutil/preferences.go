@@ -0,0 +1,37 @@
+package testutil
+
+import "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+
+// FakePreferences is an in-memory PreferenceStore. Users missing from Prefs
+// get the zero value. DigestSentOn holds each user's last digest date.
+type FakePreferences struct {
+	Prefs        map[string]*types.UserPreferences
+	DigestSentOn map[string]string
+}
+
+func (f *FakePreferences) GetPreferences(userID string) (*types.UserPreferences, error) {
+	if prefs, ok := f.Prefs[userID]; ok {
+		copied := *prefs
+		return &copied, nil
+	}
+	return &types.UserPreferences{}, nil
+}
+
+func (f *FakePreferences) SavePreferences(userID string, prefs *types.UserPreferences) error {
+	if f.Prefs == nil {
+		f.Prefs = map[string]*types.UserPreferences{}
+	}
+	f.Prefs[userID] = prefs
+	return nil
+}
+
+func (f *FakePreferences) MarkDigestSent(userID, date string) (bool, error) {
+	if f.DigestSentOn[userID] == date {
+		return false, nil
+	}
+	if f.DigestSentOn == nil {
+		f.DigestSentOn = map[string]string{}
+	}
+	f.DigestSentOn[userID] = date
+	return true, nil
+}
@@ -0,0 +1,200 @@
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service lets System Admins see and cancel everyone's scheduled messages.
+// Callers are responsible for checking the manage_system permission.
+type Service struct {
+	logger  ports.Logger
+	store   ports.Store
+	poster  ports.PostService
+	channel ports.ChannelService
+	events  ports.EventNotifier
+	botID   string
+	monitor ports.SchedulerMonitor
+	keys    ports.KeyCounter
+	clock   ports.Clock
+	pause   ports.PauseService
+}
+
+func New(
+	logger ports.Logger,
+	store ports.Store,
+	poster ports.PostService,
+	channel ports.ChannelService,
+	events ports.EventNotifier,
+	botID string,
+	monitor ports.SchedulerMonitor,
+	keys ports.KeyCounter,
+	clk ports.Clock,
+	pause ports.PauseService,
+) *Service {
+	logger.Debug("Creating new admin Service")
+	return &Service{
+		logger:  logger,
+		store:   store,
+		poster:  poster,
+		channel: channel,
+		events:  events,
+		botID:   botID,
+		monitor: monitor,
+		keys:    keys,
+		clock:   clk,
+		pause:   pause,
+	}
+}
+
+// ListMessages returns every scheduled message, optionally narrowed to one
+// owner and/or one channel, ordered by send time.
+func (s *Service) ListMessages(userID string, channelID string) ([]*types.ScheduledMessage, error) {
+	s.logger.Debug("Listing scheduled messages for admin", "user_id", userID, "channel_id", channelID)
+	all, err := s.store.ListScheduledMessages()
+	if err != nil {
+		s.logger.Error("Failed to list scheduled messages for admin", "error", err)
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	msgs := make([]*types.ScheduledMessage, 0, len(all))
+	for _, msg := range all {
+		if userID != "" && msg.UserID != userID {
+			continue
+		}
+		if channelID != "" && msg.ChannelID != channelID {
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	sort.Slice(msgs, func(i, j int) bool {
+		if !msgs[i].PostAt.Equal(msgs[j].PostAt) {
+			return msgs[i].PostAt.Before(msgs[j].PostAt)
+		}
+		return msgs[i].ID < msgs[j].ID
+	})
+	s.logger.Debug("Listed scheduled messages for admin", "total", len(all), "matched", len(msgs))
+	return msgs, nil
+}
+
+// CancelMessage deletes any user's scheduled message on behalf of adminID and
+// tells the owner by DM, including the original text and files.
+func (s *Service) CancelMessage(adminID string, msgID string) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Admin cancelling scheduled message", "admin_id", adminID, "message_id", msgID)
+	msg, err := s.store.GetScheduledMessage(msgID)
+	if err != nil {
+		s.logger.Warn("Failed to get scheduled message for admin cancel", "admin_id", adminID, "message_id", msgID, "error", err)
+		return nil, err
+	}
+	if err := s.store.DeleteScheduledMessage(msg.UserID, msgID); err != nil {
+		s.logger.Error("Failed to delete scheduled message for admin cancel", "admin_id", adminID, "message_id", msgID, "owner_user_id", msg.UserID, "error", err)
+		return nil, fmt.Errorf("failed to delete scheduled message %s: %w", msgID, err)
+	}
+	s.logger.Info("Admin cancelled scheduled message", "admin_id", adminID, "message_id", msgID, "owner_user_id", msg.UserID)
+	s.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, adminID))
+	if msg.UserID != adminID {
+		s.notifyOwner(msg)
+	}
+	return msg, nil
+}
+
+func (s *Service) notifyOwner(msg *types.ScheduledMessage) {
+	s.logger.Debug("Notifying owner of admin cancel", "message_id", msg.ID, "user_id", msg.UserID)
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		s.logger.Warn("Failed to load message timezone, falling back to UTC", "message_id", msg.ID, "timezone", msg.Timezone, "error", err)
+		loc = time.UTC
+	}
+	channelLink := s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(msg.ChannelID))
+	post := &model.Post{
+		Message: formatter.FormatAdminCancelNotice(msg.PostAt.In(loc), loc.String(), channelLink, msg.MessageContent),
+		FileIds: msg.FileIDs,
+	}
+	if err := s.poster.DM(s.botID, msg.UserID, post); err != nil {
+		s.logger.Error("Failed to DM owner about admin cancel", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+	}
+}
+
+// Status combines the scheduler's own health with the delivery backlog and KV
+// key counts. A failure to count keys is reported in the status rather than
+// failing the whole report.
+func (s *Service) Status() (*types.SchedulerStatus, error) {
+	now := s.clock.Now().UTC()
+	s.logger.Debug("Building scheduler status for admin", "checked_at", now)
+	status := &types.SchedulerStatus{
+		SchedulerHealth: s.monitor.Health(),
+		CheckedAt:       now,
+		StoreKeys:       map[string]int{},
+	}
+	status.Stalled = status.Running && !status.LastTickAt.IsZero() && now.Sub(status.LastTickAt) > constants.SchedulerStallAfter
+
+	msgs, err := s.store.ListScheduledMessages()
+	if err != nil {
+		s.logger.Error("Failed to list scheduled messages for status", "error", err)
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	status.Pending = len(msgs)
+	for _, msg := range msgs {
+		if msg.PostAt.After(now) {
+			continue
+		}
+		if now.Sub(msg.PostAt) <= constants.SchedulerOverdueAfter {
+			status.Due++
+			continue
+		}
+		status.Overdue++
+		if status.OldestOverdue == nil || msg.PostAt.Before(status.OldestOverdue.PostAt) {
+			status.OldestOverdue = &types.OverdueMessage{ID: msg.ID, UserID: msg.UserID, ChannelID: msg.ChannelID, PostAt: msg.PostAt}
+		}
+	}
+
+	pauseState, err := s.pause.State()
+	if err != nil {
+		s.logger.Warn("Failed to read delivery pause for status", "error", err)
+		status.StoreError = err.Error()
+	}
+	status.Pause = pauseState
+
+	for _, prefix := range constants.StatusKeyPrefixes {
+		count, countErr := s.keys.CountKeys(prefix)
+		if countErr != nil {
+			s.logger.Warn("Failed to count KV keys for status", "prefix", prefix, "error", countErr)
+			status.StoreError = countErr.Error()
+			continue
+		}
+		status.StoreKeys[strings.TrimSuffix(prefix, ":")] = count
+	}
+	return status, nil
+}
+
+// PauseDelivery holds delivery of every scheduled message on behalf of
+// adminID. See ports.PauseService for how resumeAt and mode apply.
+func (s *Service) PauseDelivery(adminID string, resumeAt *time.Time, mode types.ResumeMode) (*types.PauseState, error) {
+	s.logger.Debug("Admin pausing delivery", "admin_id", adminID, "resume_at", resumeAt, "resume_mode", mode)
+	state, err := s.pause.Pause(adminID, resumeAt, mode)
+	if err != nil {
+		s.logger.Error("Failed to pause delivery", "admin_id", adminID, "error", err)
+		return nil, fmt.Errorf("failed to pause delivery: %w", err)
+	}
+	return state, nil
+}
+
+// ResumeDelivery ends a pause on behalf of adminID, applying mode to messages
+// that came due while paused.
+func (s *Service) ResumeDelivery(adminID string, mode types.ResumeMode) (*types.ResumeResult, error) {
+	s.logger.Debug("Admin resuming delivery", "admin_id", adminID, "mode", mode)
+	result, err := s.pause.Resume(adminID, mode)
+	if err != nil {
+		s.logger.Warn("Failed to resume delivery", "admin_id", adminID, "error", err)
+		return nil, fmt.Errorf("failed to resume delivery: %w", err)
+	}
+	return result, nil
+}
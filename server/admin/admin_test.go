@@ -0,0 +1,229 @@
+package admin
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type adminMocks struct {
+	store   *mock.MockStore
+	poster  *mock.MockPostService
+	channel *mock.MockChannelService
+	events  *testutil.FakeNotifier
+	monitor *mock.MockSchedulerMonitor
+	keys    *mock.MockKeyCounter
+	pause   *testutil.FakePause
+}
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func setupService(t *testing.T) (*Service, *adminMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &adminMocks{
+		store:   mock.NewMockStore(ctrl),
+		poster:  mock.NewMockPostService(ctrl),
+		channel: mock.NewMockChannelService(ctrl),
+		events:  &testutil.FakeNotifier{},
+		monitor: mock.NewMockSchedulerMonitor(ctrl),
+		keys:    mock.NewMockKeyCounter(ctrl),
+		pause:   &testutil.FakePause{},
+	}
+	clk := testutil.FakeClock{NowTime: testNow}
+	return New(testutil.FakeLogger{}, m.store, m.poster, m.channel, m.events, "bot", m.monitor, m.keys, clk, m.pause), m
+}
+
+func TestListMessages_FiltersAndSorts(t *testing.T) {
+	svc, m := setupService(t)
+	base := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	m.store.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{
+		{ID: "b", UserID: "u1", ChannelID: "c1", PostAt: base},
+		{ID: "c", UserID: "u2", ChannelID: "c1", PostAt: base},
+		{ID: "a", UserID: "u1", ChannelID: "c1", PostAt: base},
+		{ID: "d", UserID: "u1", ChannelID: "c2", PostAt: base.Add(-time.Hour)},
+	}, nil).Times(3)
+
+	all, err := svc.ListMessages("", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "a", "b", "c"}, ids(all))
+
+	byUser, err := svc.ListMessages("u1", "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "a", "b"}, ids(byUser))
+
+	byBoth, err := svc.ListMessages("u1", "c1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids(byBoth))
+}
+
+func TestListMessages_StoreError(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().ListScheduledMessages().Return(nil, errors.New("kv down"))
+	_, err := svc.ListMessages("", "")
+	require.Error(t, err)
+}
+
+func TestCancelMessage_DeletesAndNotifiesOwner(t *testing.T) {
+	svc, m := setupService(t)
+	msg := &types.ScheduledMessage{
+		ID:             "m1",
+		UserID:         "owner",
+		ChannelID:      "c1",
+		PostAt:         time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		Timezone:       "America/New_York",
+		MessageContent: "hello",
+		FileIDs:        []string{"f1"},
+	}
+	info := &ports.ChannelInfo{ChannelID: "c1"}
+	m.store.EXPECT().GetScheduledMessage("m1").Return(msg, nil)
+	m.store.EXPECT().DeleteScheduledMessage("owner", "m1").Return(nil)
+	m.channel.EXPECT().GetInfoOrUnknown("c1").Return(info)
+	m.channel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
+	m.poster.EXPECT().DM("bot", "owner", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		assert.Contains(t, post.Message, "A System Admin cancelled your message")
+		assert.Contains(t, post.Message, "7:00 AM")
+		assert.Contains(t, post.Message, "hello")
+		assert.Equal(t, []string{"f1"}, []string(post.FileIds))
+		return nil
+	})
+
+	got, err := svc.CancelMessage("admin", "m1")
+	require.NoError(t, err)
+	assert.Same(t, msg, got)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventCancelled, events[0].Type)
+	assert.Equal(t, "admin", events[0].ActorID)
+	assert.Equal(t, "owner", events[0].UserID)
+}
+
+func TestCancelMessage_OwnMessageSkipsDM(t *testing.T) {
+	svc, m := setupService(t)
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "admin", ChannelID: "c1"}
+	m.store.EXPECT().GetScheduledMessage("m1").Return(msg, nil)
+	m.store.EXPECT().DeleteScheduledMessage("admin", "m1").Return(nil)
+
+	_, err := svc.CancelMessage("admin", "m1")
+	require.NoError(t, err)
+}
+
+func TestCancelMessage_NotFound(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("missing").Return(nil, types.ErrMessageNotFound)
+	_, err := svc.CancelMessage("admin", "missing")
+	require.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Empty(t, m.events.Events())
+}
+
+func TestCancelMessage_DeleteError(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(&types.ScheduledMessage{ID: "m1", UserID: "owner"}, nil)
+	m.store.EXPECT().DeleteScheduledMessage("owner", "m1").Return(errors.New("kv down"))
+	_, err := svc.CancelMessage("admin", "m1")
+	require.Error(t, err)
+	assert.Empty(t, m.events.Events())
+}
+
+func ids(msgs []*types.ScheduledMessage) []string {
+	out := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		out = append(out, msg.ID)
+	}
+	return out
+}
+
+func TestStatus_ReportsBacklogAndKeys(t *testing.T) {
+	svc, m := setupService(t)
+	m.monitor.EXPECT().Health().Return(types.SchedulerHealth{Running: true, LastTickAt: testNow.Add(-30 * time.Second), LastTickDurationMS: 12})
+	m.store.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{
+		{ID: "future", PostAt: testNow.Add(time.Hour)},
+		{ID: "due", PostAt: testNow.Add(-time.Minute)},
+		{ID: "late", UserID: "u1", ChannelID: "c1", PostAt: testNow.Add(-10 * time.Minute)},
+		{ID: "later", PostAt: testNow.Add(-5 * time.Minute)},
+	}, nil)
+	m.keys.EXPECT().CountKeys(gomock.Any()).DoAndReturn(func(prefix string) (int, error) {
+		if prefix == constants.AuditPrefix {
+			return 0, errors.New("boom")
+		}
+		return 3, nil
+	}).Times(len(constants.StatusKeyPrefixes))
+
+	status, err := svc.Status()
+	require.NoError(t, err)
+	assert.True(t, status.Running)
+	assert.False(t, status.Stalled)
+	assert.Equal(t, testNow, status.CheckedAt)
+	assert.Equal(t, 4, status.Pending)
+	assert.Equal(t, 1, status.Due)
+	assert.Equal(t, 2, status.Overdue)
+	require.NotNil(t, status.OldestOverdue)
+	assert.Equal(t, "late", status.OldestOverdue.ID)
+	assert.Equal(t, 3, status.StoreKeys["schedmsg"])
+	assert.NotContains(t, status.StoreKeys, "audit")
+	assert.Equal(t, "boom", status.StoreError)
+}
+
+func TestStatus_Stalled(t *testing.T) {
+	svc, m := setupService(t)
+	m.monitor.EXPECT().Health().Return(types.SchedulerHealth{Running: true, LastTickAt: testNow.Add(-10 * time.Minute)})
+	m.store.EXPECT().ListScheduledMessages().Return(nil, nil)
+	m.keys.EXPECT().CountKeys(gomock.Any()).Return(0, nil).AnyTimes()
+
+	status, err := svc.Status()
+	require.NoError(t, err)
+	assert.True(t, status.Stalled)
+	assert.Nil(t, status.OldestOverdue)
+	assert.Nil(t, status.Pause)
+}
+
+func TestStatus_ReportsPause(t *testing.T) {
+	svc, m := setupService(t)
+	m.pause.Current = &types.PauseState{PausedBy: "admin", PausedAt: testNow}
+	m.monitor.EXPECT().Health().Return(types.SchedulerHealth{Running: true})
+	m.store.EXPECT().ListScheduledMessages().Return(nil, nil)
+	m.keys.EXPECT().CountKeys(gomock.Any()).Return(0, nil).AnyTimes()
+
+	status, err := svc.Status()
+	require.NoError(t, err)
+	require.NotNil(t, status.Pause)
+	assert.Equal(t, "admin", status.Pause.PausedBy)
+}
+
+func TestPauseAndResumeDelivery(t *testing.T) {
+	svc, m := setupService(t)
+	resumeAt := testNow.Add(time.Hour)
+
+	state, err := svc.PauseDelivery("admin", &resumeAt, types.ResumeSkip)
+	require.NoError(t, err)
+	assert.Equal(t, types.ResumeSkip, state.ResumeMode)
+	assert.Same(t, state, m.pause.Current)
+
+	result, err := svc.ResumeDelivery("admin", types.ResumeSend)
+	require.NoError(t, err)
+	assert.Equal(t, types.ResumeSend, result.Mode)
+	assert.Nil(t, m.pause.Current)
+
+	_, err = svc.ResumeDelivery("admin", types.ResumeSend)
+	assert.ErrorIs(t, err, types.ErrDeliveryNotPaused)
+}
+
+func TestStatus_ListError(t *testing.T) {
+	svc, m := setupService(t)
+	m.monitor.EXPECT().Health().Return(types.SchedulerHealth{})
+	m.store.EXPECT().ListScheduledMessages().Return(nil, errors.New("boom"))
+
+	_, err := svc.Status()
+	require.Error(t, err)
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func (h *Handler) AdminListMessages(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	filterUserID := r.URL.Query().Get("user_id")
+	filterChannelID := r.URL.Query().Get("channel_id")
+	h.logger.Debug("Handling AdminListMessages request", "user_id", userID, "filter_user_id", filterUserID, "filter_channel_id", filterChannelID)
+
+	msgs, err := h.Admin.ListMessages(filterUserID, filterChannelID)
+	if err != nil {
+		h.logger.Error("Failed to list scheduled messages for admin", "user_id", userID, "error", err)
+		http.Error(w, "Failed to list scheduled messages", http.StatusInternalServerError)
+		return
+	}
+	if msgs == nil {
+		msgs = []*types.ScheduledMessage{}
+	}
+	h.writeJSON(w, http.StatusOK, msgs)
+}
+
+func (h *Handler) AdminCancelMessage(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	msgID := mux.Vars(r)["id"]
+	h.logger.Debug("Handling AdminCancelMessage request", "user_id", userID, "message_id", msgID)
+
+	msg, err := h.Admin.CancelMessage(userID, msgID)
+	if errors.Is(err, types.ErrMessageNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to cancel scheduled message for admin", "user_id", userID, "message_id", msgID, "error", err)
+		http.Error(w, "Failed to cancel scheduled message", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, msg)
+}
+
+func (h *Handler) AdminStatus(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling AdminStatus request", "user_id", userID)
+
+	status, err := h.Admin.Status()
+	if err != nil {
+		h.logger.Error("Failed to build scheduler status for admin", "user_id", userID, "error", err)
+		http.Error(w, "Failed to build scheduler status", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, status)
+}
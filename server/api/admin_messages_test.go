@@ -0,0 +1,133 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupAdminMessagesHandler(t *testing.T, isAdmin bool) (*Handler, *mock.MockAdminService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	userMock := mock.NewMockUserService(ctrl)
+	userMock.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(isAdmin)
+	adminMock := mock.NewMockAdminService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, user: userMock, Admin: adminMock}, adminMock
+}
+
+func adminMessagesRequest(method, target string) *http.Request {
+	r := httptest.NewRequest(method, target, nil)
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "admin")
+	return r
+}
+
+func TestServeHTTP_AdminListMessages_Forbidden(t *testing.T) {
+	h, _ := setupAdminMessagesHandler(t, false)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/messages"))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestServeHTTP_AdminListMessages_Filters(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().ListMessages("u1", "c1").Return([]*types.ScheduledMessage{{ID: "m1", UserID: "u1"}}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/messages?user_id=u1&channel_id=c1"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []*types.ScheduledMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "m1", got[0].ID)
+}
+
+func TestServeHTTP_AdminListMessages_Empty(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().ListMessages("", "").Return(nil, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/messages"))
+
+	assert.Equal(t, "[]\n", rr.Body.String())
+}
+
+func TestServeHTTP_AdminCancelMessage(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().CancelMessage("admin", "m1").Return(&types.ScheduledMessage{ID: "m1", UserID: "owner"}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodDelete, "/api/v1/admin/messages/m1"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.ScheduledMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, "owner", got.UserID)
+}
+
+func TestServeHTTP_AdminCancelMessage_NotFound(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().CancelMessage("admin", "gone").Return(nil, types.ErrMessageNotFound)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodDelete, "/api/v1/admin/messages/gone"))
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestServeHTTP_AdminCancelMessage_Error(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().CancelMessage("admin", "m1").Return(nil, errors.New("kv down"))
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodDelete, "/api/v1/admin/messages/m1"))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestServeHTTP_AdminStatus(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().Status().Return(&types.SchedulerStatus{
+		SchedulerHealth: types.SchedulerHealth{Running: true, LastTickDurationMS: 5},
+		Overdue:         1,
+		OldestOverdue:   &types.OverdueMessage{ID: "late"},
+		StoreKeys:       map[string]int{"schedmsg": 1},
+	}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/status"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.SchedulerStatus
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.True(t, got.Running)
+	assert.Equal(t, int64(5), got.LastTickDurationMS)
+	assert.Equal(t, 1, got.Overdue)
+	require.NotNil(t, got.OldestOverdue)
+	assert.Equal(t, "late", got.OldestOverdue.ID)
+	assert.Equal(t, 1, got.StoreKeys["schedmsg"])
+}
+
+func TestServeHTTP_AdminStatus_Error(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().Status().Return(nil, errors.New("boom"))
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/status"))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
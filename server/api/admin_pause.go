@@ -0,0 +1,82 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// PauseDeliveryRequest is the optional body of a pause request. Without
+// ResumeAt, delivery stays paused until resumed.
+type PauseDeliveryRequest struct {
+	ResumeAt   *time.Time `json:"resume_at,omitempty"`
+	ResumeMode string     `json:"resume_mode,omitempty"`
+}
+
+// ResumeDeliveryRequest is the optional body of a resume request.
+type ResumeDeliveryRequest struct {
+	Mode string `json:"mode,omitempty"`
+}
+
+func (h *Handler) AdminPauseDelivery(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling AdminPauseDelivery request", "user_id", userID)
+
+	var req PauseDeliveryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Debug("Failed to decode AdminPauseDelivery request", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	mode, err := types.ParseResumeMode(req.ResumeMode)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.ResumeAt != nil && !req.ResumeAt.After(time.Now()) {
+		http.Error(w, "resume_at must be in the future", http.StatusBadRequest)
+		return
+	}
+
+	state, err := h.Admin.PauseDelivery(userID, req.ResumeAt, mode)
+	if err != nil {
+		h.logger.Error("Failed to pause delivery for admin", "user_id", userID, "error", err)
+		http.Error(w, "Failed to pause delivery", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, state)
+}
+
+func (h *Handler) AdminResumeDelivery(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling AdminResumeDelivery request", "user_id", userID)
+
+	var req ResumeDeliveryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.logger.Debug("Failed to decode AdminResumeDelivery request", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	mode, err := types.ParseResumeMode(req.Mode)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.Admin.ResumeDelivery(userID, mode)
+	if errors.Is(err, types.ErrDeliveryNotPaused) {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to resume delivery for admin", "user_id", userID, "error", err)
+		http.Error(w, "Failed to resume delivery", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,93 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func adminPauseRequest(target, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, target, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "admin")
+	return r
+}
+
+func TestServeHTTP_AdminPauseDelivery(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	resumeAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	adminMock.EXPECT().PauseDelivery("admin", gomock.Any(), types.ResumeSkip).DoAndReturn(func(_ string, at *time.Time, mode types.ResumeMode) (*types.PauseState, error) {
+		require.NotNil(t, at)
+		assert.True(t, resumeAt.Equal(*at))
+		return &types.PauseState{PausedBy: "admin", ResumeAt: at, ResumeMode: mode}, nil
+	})
+	rr := httptest.NewRecorder()
+	body := fmt.Sprintf(`{"resume_at": %q, "resume_mode": "skip"}`, resumeAt.Format(time.RFC3339))
+
+	h.ServeHTTP(nil, rr, adminPauseRequest("/api/v1/admin/pause", body))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.PauseState
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, types.ResumeSkip, got.ResumeMode)
+}
+
+func TestServeHTTP_AdminPauseDelivery_NoBody(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().PauseDelivery("admin", nil, types.ResumeSend).Return(&types.PauseState{PausedBy: "admin"}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminPauseRequest("/api/v1/admin/pause", ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestServeHTTP_AdminPauseDelivery_BadRequest(t *testing.T) {
+	for name, body := range map[string]string{
+		"bad mode":       `{"resume_mode": "later"}`,
+		"past resume_at": `{"resume_at": "2000-01-01T00:00:00Z"}`,
+		"malformed json": `{`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			h, _ := setupAdminMessagesHandler(t, true)
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(nil, rr, adminPauseRequest("/api/v1/admin/pause", body))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestServeHTTP_AdminResumeDelivery(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().ResumeDelivery("admin", types.ResumeSkip).Return(&types.ResumeResult{Mode: types.ResumeSkip, Skipped: 2}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminPauseRequest("/api/v1/admin/resume", `{"mode": "skip"}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.ResumeResult
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, 2, got.Skipped)
+}
+
+func TestServeHTTP_AdminResumeDelivery_NotPaused(t *testing.T) {
+	h, adminMock := setupAdminMessagesHandler(t, true)
+	adminMock.EXPECT().ResumeDelivery("admin", types.ResumeSend).Return(nil, fmt.Errorf("failed to resume delivery: %w", types.ErrDeliveryNotPaused))
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminPauseRequest("/api/v1/admin/resume", ""))
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
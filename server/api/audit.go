@@ -0,0 +1,104 @@
+package api
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var auditCSVHeader = []string{
+	"timestamp", "action", "outcome", "actor_id", "message_id", "user_id", "channel_id", "post_at", "post_id", "error",
+}
+
+func (h *Handler) AdminListAudit(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling AdminListAudit request", "user_id", userID, "query", r.URL.RawQuery)
+
+	query, err := parseAuditQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Debug("Invalid AdminListAudit query", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	entries, err := h.Audit.Query(query)
+	if err != nil {
+		h.logger.Error("Failed to query audit log", "user_id", userID, "error", err)
+		http.Error(w, "Failed to query audit log", http.StatusInternalServerError)
+		return
+	}
+	if entries == nil {
+		entries = []*types.AuditEntry{}
+	}
+
+	if strings.EqualFold(r.URL.Query().Get("format"), "csv") {
+		h.writeAuditCSV(w, entries)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, entries)
+}
+
+// parseAuditQuery reads audit filters from URL parameters: from and to (RFC
+// 3339 or YYYY-MM-DD in UTC, to is exclusive), actor_id, user_id, channel_id,
+// message_id, action, outcome and limit.
+func parseAuditQuery(values url.Values) (*types.AuditQuery, error) {
+	query := &types.AuditQuery{
+		ActorID:   strings.TrimSpace(values.Get("actor_id")),
+		UserID:    strings.TrimSpace(values.Get("user_id")),
+		ChannelID: strings.TrimSpace(values.Get("channel_id")),
+		MessageID: strings.TrimSpace(values.Get("message_id")),
+		Action:    types.EventType(strings.TrimSpace(values.Get("action"))),
+		Outcome:   types.AuditOutcome(strings.TrimSpace(values.Get("outcome"))),
+	}
+
+	var err error
+	if query.From, err = parseQueryTime(values.Get("from")); err != nil {
+		return nil, fmt.Errorf("invalid from: %w", err)
+	}
+	if query.To, err = parseQueryTime(values.Get("to")); err != nil {
+		return nil, fmt.Errorf("invalid to: %w", err)
+	}
+	if query.Outcome != "" && query.Outcome != types.AuditSuccess && query.Outcome != types.AuditFailure {
+		return nil, fmt.Errorf("invalid outcome %q, use success or failure", query.Outcome)
+	}
+	if v := values.Get("limit"); v != "" {
+		limit, parseErr := strconv.Atoi(v)
+		if parseErr != nil || limit < 1 || limit > constants.MaxAuditQueryLimit {
+			return nil, fmt.Errorf("invalid limit %q, use 1 to %d", v, constants.MaxAuditQueryLimit)
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
+
+func (h *Handler) writeAuditCSV(w http.ResponseWriter, entries []*types.AuditEntry) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", constants.AuditCSVFilename))
+	w.WriteHeader(http.StatusOK)
+
+	out := csv.NewWriter(w)
+	rows := [][]string{auditCSVHeader}
+	for _, e := range entries {
+		rows = append(rows, []string{
+			e.Timestamp.UTC().Format(time.RFC3339),
+			string(e.Action),
+			string(e.Outcome),
+			e.ActorID,
+			e.MessageID,
+			e.UserID,
+			e.ChannelID,
+			e.PostAt.UTC().Format(time.RFC3339),
+			e.PostID,
+			e.Error,
+		})
+	}
+	if err := out.WriteAll(rows); err != nil {
+		h.logger.Error("Failed to write audit CSV", "error", err)
+	}
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupAuditHandler(t *testing.T, isAdmin bool) (*Handler, *mock.MockAuditService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	userMock := mock.NewMockUserService(ctrl)
+	userMock.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(isAdmin)
+	auditMock := mock.NewMockAuditService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, user: userMock, Audit: auditMock}, auditMock
+}
+
+func sampleAuditEntry() *types.AuditEntry {
+	return &types.AuditEntry{
+		ID:        "a1",
+		Timestamp: time.Date(2026, 2, 1, 9, 30, 0, 0, time.UTC),
+		ActorID:   "u1",
+		Action:    types.EventFailed,
+		MessageID: "m1",
+		UserID:    "u1",
+		ChannelID: "c1",
+		PostAt:    time.Date(2026, 2, 1, 9, 30, 0, 0, time.UTC),
+		Outcome:   types.AuditFailure,
+		Error:     "channel archived, \"gone\"",
+	}
+}
+
+func TestServeHTTP_AdminListAudit_Forbidden(t *testing.T) {
+	h, _ := setupAuditHandler(t, false)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/audit"))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestServeHTTP_AdminListAudit_Filters(t *testing.T) {
+	h, auditMock := setupAuditHandler(t, true)
+	auditMock.EXPECT().Query(&types.AuditQuery{
+		From:      time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:        time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC),
+		ActorID:   "a",
+		UserID:    "u1",
+		ChannelID: "c1",
+		MessageID: "m1",
+		Action:    types.EventFailed,
+		Outcome:   types.AuditFailure,
+		Limit:     5,
+	}).Return([]*types.AuditEntry{sampleAuditEntry()}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet,
+		"/api/v1/admin/audit?from=2026-02-01&to=2026-02-02&actor_id=a&user_id=u1&channel_id=c1&message_id=m1&action=message.failed&outcome=failure&limit=5"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got []*types.AuditEntry
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "a1", got[0].ID)
+}
+
+func TestServeHTTP_AdminListAudit_Empty(t *testing.T) {
+	h, auditMock := setupAuditHandler(t, true)
+	auditMock.EXPECT().Query(gomock.Any()).Return(nil, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/audit"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, "[]", rr.Body.String())
+}
+
+func TestServeHTTP_AdminListAudit_BadQuery(t *testing.T) {
+	for _, q := range []string{"from=yesterday", "limit=0", "limit=100000", "outcome=maybe"} {
+		t.Run(q, func(t *testing.T) {
+			h, _ := setupAuditHandler(t, true)
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/audit?"+q))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
+
+func TestServeHTTP_AdminListAudit_Error(t *testing.T) {
+	h, auditMock := setupAuditHandler(t, true)
+	auditMock.EXPECT().Query(gomock.Any()).Return(nil, errors.New("boom"))
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/audit"))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestServeHTTP_AdminListAudit_CSV(t *testing.T) {
+	h, auditMock := setupAuditHandler(t, true)
+	auditMock.EXPECT().Query(gomock.Any()).Return([]*types.AuditEntry{sampleAuditEntry()}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, "/api/v1/admin/audit?format=csv"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Header().Get("Content-Disposition"), constants.AuditCSVFilename)
+	rows, err := csv.NewReader(rr.Body).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, auditCSVHeader, rows[0])
+	assert.Equal(t, []string{
+		"2026-02-01T09:30:00Z", "message.failed", "failure", "u1", "m1", "u1", "c1", "2026-02-01T09:30:00Z", "", "channel archived, \"gone\"",
+	}, rows[1])
+}
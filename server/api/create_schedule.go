@@ -1,12 +1,18 @@
 package api
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
+	"github.com/mattermost/mattermost/server/public/model"
+
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 type CreateSceduleRequest struct {
@@ -15,6 +21,21 @@ type CreateSceduleRequest struct {
 	PostAtTime string   `json:"post_at_time"`
 	PostAtDate string   `json:"post_at_date"`
 	Message    string   `json:"message"`
+	// RemindMinutes overrides the user's default reminder for this message.
+	// 0 turns the reminder off.
+	RemindMinutes *int `json:"remind_minutes,omitempty"`
+	// AsBot posts the message as the bot on the user's behalf, when the
+	// System Admin allows it.
+	AsBot bool `json:"as_bot,omitempty"`
+	// Priority, RequestedAck, Attachments and Props are applied to the post
+	// when the message is sent.
+	Priority     string                   `json:"priority,omitempty"`
+	RequestedAck bool                     `json:"requested_ack,omitempty"`
+	Attachments  []*model.SlackAttachment `json:"attachments,omitempty"`
+	Props        map[string]any           `json:"props,omitempty"`
+	// RequestID is a client-supplied idempotency key, used when the
+	// Idempotency-Key header is not sent.
+	RequestID string `json:"request_id,omitempty"`
 }
 
 func (h *Handler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
@@ -30,19 +51,112 @@ func (h *Handler) CreateSchedule(w http.ResponseWriter, r *http.Request) {
 	}
 	h.logger.Debug("Successfully parsed CreateScedule request", "user_id", userID, "channel_id", req.ChannelID)
 
-	h.logger.Debug("Calling ScheduleService BuildPost", "user_id", userID)
+	key := idempotencyKeyFromRequest(r, req)
+	if len(key) > constants.MaxIdempotencyKeyLength {
+		http.Error(w, fmt.Sprintf(constants.ErrIdempotencyKeyTooLong, constants.MaxIdempotencyKeyLength), http.StatusBadRequest)
+		return
+	}
+	fingerprint := req.fingerprint()
+	if key != "" && !h.reserveIdempotencyKey(w, userID, key, fingerprint) {
+		return
+	}
 
-	req.Message = parseRequestToCommand(req)
-	post, err := h.ScheduleService.BuildPost(userID, req.ChannelID, req.FileIDs, req.Message)
+	h.logger.Debug("Calling ScheduleService ScheduleMessage", "user_id", userID)
+	msg, err := h.ScheduleService.ScheduleMessage(userID, req.ChannelID, req.FileIDs, req.metadata(), parseRequestToCommand(req))
 	if err != nil {
-		h.logger.Debug("Failed to BuildPost", "user_id", userID, "error", err)
-		h.poster.SendEphemeralPost(userID, post)
+		h.logger.Debug("Failed to ScheduleMessage", "user_id", userID, "error", err)
+		if key != "" {
+			if releaseErr := h.Idempotency.Release(userID, key); releaseErr != nil {
+				h.logger.Warn("Failed to release idempotency key after scheduling error", "user_id", userID, "error", releaseErr)
+			}
+		}
+		h.poster.SendEphemeralPost(userID, &model.Post{UserId: userID, ChannelId: req.ChannelID, Message: err.Error()})
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-	h.logger.Debug("Success BuildPost", "user_id", userID)
+	h.logger.Debug("Success ScheduleMessage", "user_id", userID, "message_id", msg.ID)
+
+	if key != "" {
+		if err := h.Idempotency.Complete(userID, key, &types.IdempotencyRecord{Fingerprint: fingerprint, Message: msg}); err != nil {
+			h.logger.Warn("Failed to remember idempotency key result", "user_id", userID, "message_id", msg.ID, "error", err)
+		}
+	}
+
+	h.poster.SendEphemeralPost(userID, h.ScheduleService.BuildConfirmationPost(msg))
+	h.writeJSON(w, http.StatusOK, msg)
+}
+
+// reserveIdempotencyKey claims key for a new request. It returns false after
+// writing the response when the key was already used: a replay gets the
+// original message back, a different or still-running request an error.
+func (h *Handler) reserveIdempotencyKey(w http.ResponseWriter, userID, key, fingerprint string) bool {
+	reserved, existing, err := h.Idempotency.Reserve(userID, key, &types.IdempotencyRecord{Fingerprint: fingerprint})
+	if err != nil {
+		h.logger.Error("Failed to reserve idempotency key", "user_id", userID, "error", err)
+		http.Error(w, "Failed to schedule message", http.StatusInternalServerError)
+		return false
+	}
+	if reserved {
+		return true
+	}
 
-	h.poster.SendEphemeralPost(userID, post)
+	switch {
+	case existing.Fingerprint != fingerprint:
+		h.logger.Warn("Idempotency key reused for a different request", "user_id", userID)
+		http.Error(w, constants.ErrIdempotencyKeyReused, http.StatusUnprocessableEntity)
+	case existing.Message == nil:
+		h.logger.Debug("Idempotency key is still in flight", "user_id", userID)
+		http.Error(w, constants.ErrIdempotencyKeyInFlight, http.StatusConflict)
+	default:
+		h.logger.Info("Replaying idempotent schedule request", "user_id", userID, "message_id", existing.Message.ID)
+		w.Header().Set(constants.HTTPHeaderIdempotentReplay, "true")
+		h.writeJSON(w, http.StatusOK, existing.Message)
+	}
+	return false
+}
+
+func idempotencyKeyFromRequest(r *http.Request, req *CreateSceduleRequest) string {
+	if key := strings.TrimSpace(r.Header.Get(constants.HTTPHeaderIdempotencyKey)); key != "" {
+		return key
+	}
+	return strings.TrimSpace(req.RequestID)
+}
+
+// fingerprint identifies the request body so a reused idempotency key with a
+// different payload can be rejected instead of silently replayed.
+func (r *CreateSceduleRequest) fingerprint() string {
+	hash := sha256.New()
+	parts := []string{r.ChannelID, r.PostAtTime, r.PostAtDate, r.Message}
+	if r.RemindMinutes != nil {
+		// Only added when set, so keys stored before reminders existed still match.
+		parts = append(parts, "remind="+strconv.Itoa(*r.RemindMinutes))
+	}
+	if r.AsBot {
+		parts = append(parts, "as="+constants.DeliverAsBot)
+	}
+	if meta := r.metadata(); meta != nil {
+		encoded, _ := json.Marshal(meta)
+		parts = append(parts, "metadata="+string(encoded))
+	}
+	for _, part := range append(parts, r.FileIDs...) {
+		hash.Write([]byte(part))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// metadata returns the post metadata asked for, or nil if there is none.
+func (r *CreateSceduleRequest) metadata() *types.PostMetadata {
+	meta := &types.PostMetadata{
+		Priority:     r.Priority,
+		RequestedAck: r.RequestedAck,
+		Attachments:  r.Attachments,
+		Props:        r.Props,
+	}
+	if meta.IsEmpty() {
+		return nil
+	}
+	return meta
 }
 
 func parseCreateScheduleRequest(h *Handler, r *http.Request) (*CreateSceduleRequest, error) {
@@ -55,17 +169,28 @@ func parseCreateScheduleRequest(h *Handler, r *http.Request) (*CreateSceduleRequ
 	return &req, nil
 }
 
+// parseRequestToCommand turns the request into /schedule command text. The
+// at clause is left out when no time is given, so the user's default time
+// applies.
 func parseRequestToCommand(r *CreateSceduleRequest) string {
 	time := strings.TrimSpace(r.PostAtTime)
 	date := strings.TrimSpace(r.PostAtDate)
 	message := strings.TrimSpace(r.Message)
 
-	cmd := fmt.Sprintf("at %s", time)
-	if strings.TrimSpace(date) != "" {
-		cmd = fmt.Sprintf("%s on %s", cmd, date)
+	var parts []string
+	if time != "" {
+		parts = append(parts, fmt.Sprintf("at %s", time))
 	}
+	if date != "" {
+		parts = append(parts, fmt.Sprintf("on %s", date))
+	}
+	if r.RemindMinutes != nil {
+		parts = append(parts, fmt.Sprintf("remind %d", *r.RemindMinutes))
+	}
+	if r.AsBot {
+		parts = append(parts, fmt.Sprintf("as %s", constants.DeliverAsBot))
+	}
+	parts = append(parts, fmt.Sprintf("message %s", message))
 
-	cmd = fmt.Sprintf("%s message %s", cmd, message)
-
-	return cmd
+	return strings.Join(parts, " ")
 }
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+const createScheduleBody = `{"channel_id":"c1","post_at_time":"9am","post_at_date":"2026-01-02","message":"hello"}`
+
+type createScheduleMocks struct {
+	poster      *mock.MockPostService
+	schedule    *mock.MockScheduleService
+	idempotency *mock.MockIdempotencyStore
+}
+
+func setupCreateScheduleHandler(t *testing.T) (*Handler, *createScheduleMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &createScheduleMocks{
+		poster:      mock.NewMockPostService(ctrl),
+		schedule:    mock.NewMockScheduleService(ctrl),
+		idempotency: mock.NewMockIdempotencyStore(ctrl),
+	}
+	return &Handler{
+		logger:          &testutil.FakeLogger{},
+		poster:          m.poster,
+		ScheduleService: m.schedule,
+		Idempotency:     m.idempotency,
+	}, m
+}
+
+func createScheduleRequest(body, key string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/schedule", strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "user")
+	if key != "" {
+		r.Header.Set(constants.HTTPHeaderIdempotencyKey, key)
+	}
+	return r
+}
+
+func TestCreateSchedule_WithoutKey(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "user", ChannelID: "c1"}
+	confirmation := &model.Post{Message: "scheduled"}
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Nil(), gomock.Nil(), "at 9am on 2026-01-02 message hello").Return(msg, nil)
+	m.schedule.EXPECT().BuildConfirmationPost(msg).Return(confirmation)
+	m.poster.EXPECT().SendEphemeralPost("user", confirmation)
+	rr := httptest.NewRecorder()
+
+	h.CreateSchedule(rr, createScheduleRequest(createScheduleBody, ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var got types.ScheduledMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, "msg1", got.ID)
+}
+
+func TestCreateSchedule_RemindMinutes(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "user", ChannelID: "c1", ReminderMinutes: 30}
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Nil(), gomock.Nil(), "at 9am on 2026-01-02 remind 30 message hello").Return(msg, nil)
+	m.schedule.EXPECT().BuildConfirmationPost(msg).Return(&model.Post{})
+	m.poster.EXPECT().SendEphemeralPost("user", gomock.Any())
+	rr := httptest.NewRecorder()
+	body := `{"channel_id": "c1", "post_at_time": "9am", "post_at_date": "2026-01-02", "message": "hello", "remind_minutes": 30}`
+
+	h.CreateSchedule(rr, createScheduleRequest(body, ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestCreateSchedule_AsBot(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "user", ChannelID: "c1", AsBot: true}
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Nil(), gomock.Nil(), "at 9am remind 0 as bot message hello").Return(msg, nil)
+	m.schedule.EXPECT().BuildConfirmationPost(msg).Return(&model.Post{})
+	m.poster.EXPECT().SendEphemeralPost("user", gomock.Any())
+	rr := httptest.NewRecorder()
+	body := `{"channel_id": "c1", "post_at_time": "9am", "message": "hello", "remind_minutes": 0, "as_bot": true}`
+
+	h.CreateSchedule(rr, createScheduleRequest(body, ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestCreateSchedule_DateOnlyUsesDefaultTime(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "user", ChannelID: "c1"}
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Nil(), gomock.Nil(), "on 2026-01-02 message hello").Return(msg, nil)
+	m.schedule.EXPECT().BuildConfirmationPost(msg).Return(&model.Post{})
+	m.poster.EXPECT().SendEphemeralPost("user", gomock.Any())
+	rr := httptest.NewRecorder()
+	body := `{"channel_id": "c1", "post_at_time": " ", "post_at_date": "2026-01-02", "message": "hello"}`
+
+	h.CreateSchedule(rr, createScheduleRequest(body, ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestCreateSchedule_Metadata(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "user", ChannelID: "c1"}
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Nil(), gomock.Any(), "at 9am message hello").DoAndReturn(
+		func(_, _ string, _ []string, meta *types.PostMetadata, _ string) (*types.ScheduledMessage, error) {
+			assert.Equal(t, "urgent", meta.Priority)
+			assert.True(t, meta.RequestedAck)
+			require.Len(t, meta.Attachments, 1)
+			assert.Equal(t, "Release", meta.Attachments[0].Title)
+			assert.Equal(t, map[string]any{"release": "2.0"}, meta.Props)
+			return msg, nil
+		})
+	m.schedule.EXPECT().BuildConfirmationPost(msg).Return(&model.Post{})
+	m.poster.EXPECT().SendEphemeralPost("user", gomock.Any())
+	rr := httptest.NewRecorder()
+	body := `{"channel_id": "c1", "post_at_time": "9am", "message": "hello", "priority": "urgent", "requested_ack": true, "attachments": [{"title": "Release"}], "props": {"release": "2.0"}}`
+
+	h.CreateSchedule(rr, createScheduleRequest(body, ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestCreateSchedule_FirstRequestWithKey(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "user", ChannelID: "c1"}
+	var fingerprint string
+	m.idempotency.EXPECT().Reserve("user", "key1", gomock.Any()).DoAndReturn(func(_, _ string, rec *types.IdempotencyRecord) (bool, *types.IdempotencyRecord, error) {
+		fingerprint = rec.Fingerprint
+		assert.Nil(t, rec.Message)
+		return true, nil, nil
+	})
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Any(), gomock.Any(), gomock.Any()).Return(msg, nil)
+	m.idempotency.EXPECT().Complete("user", "key1", gomock.Any()).DoAndReturn(func(_, _ string, rec *types.IdempotencyRecord) error {
+		assert.Equal(t, fingerprint, rec.Fingerprint)
+		assert.Same(t, msg, rec.Message)
+		return nil
+	})
+	m.schedule.EXPECT().BuildConfirmationPost(msg).Return(&model.Post{})
+	m.poster.EXPECT().SendEphemeralPost("user", gomock.Any())
+	rr := httptest.NewRecorder()
+
+	h.CreateSchedule(rr, createScheduleRequest(createScheduleBody, "key1"))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Empty(t, rr.Header().Get(constants.HTTPHeaderIdempotentReplay))
+}
+
+func TestCreateSchedule_ReplayReturnsOriginalMessage(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	req := &CreateSceduleRequest{ChannelID: "c1", PostAtTime: "9am", PostAtDate: "2026-01-02", Message: "hello"}
+	stored := &types.IdempotencyRecord{Fingerprint: req.fingerprint(), Message: &types.ScheduledMessage{ID: "msg1"}}
+	m.idempotency.EXPECT().Reserve("user", "key1", gomock.Any()).Return(false, stored, nil)
+	rr := httptest.NewRecorder()
+
+	h.CreateSchedule(rr, createScheduleRequest(createScheduleBody, "key1"))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "true", rr.Header().Get(constants.HTTPHeaderIdempotentReplay))
+	var got types.ScheduledMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, "msg1", got.ID)
+}
+
+func TestCreateSchedule_RequestIDFieldUsedAsKey(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	m.idempotency.EXPECT().Reserve("user", "client-1", gomock.Any()).Return(false, &types.IdempotencyRecord{Fingerprint: "fp"}, nil)
+	rr := httptest.NewRecorder()
+
+	body := strings.Replace(createScheduleBody, "{", `{"request_id":"client-1",`, 1)
+	h.CreateSchedule(rr, createScheduleRequest(body, ""))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+}
+
+func TestCreateSchedule_KeyInFlight(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	req := &CreateSceduleRequest{ChannelID: "c1", PostAtTime: "9am", PostAtDate: "2026-01-02", Message: "hello"}
+	m.idempotency.EXPECT().Reserve("user", "key1", gomock.Any()).Return(false, &types.IdempotencyRecord{Fingerprint: req.fingerprint()}, nil)
+	rr := httptest.NewRecorder()
+
+	h.CreateSchedule(rr, createScheduleRequest(createScheduleBody, "key1"))
+
+	assert.Equal(t, http.StatusConflict, rr.Code)
+}
+
+func TestCreateSchedule_FailureReleasesKey(t *testing.T) {
+	h, m := setupCreateScheduleHandler(t)
+	m.idempotency.EXPECT().Reserve("user", "key1", gomock.Any()).Return(true, nil, nil)
+	m.schedule.EXPECT().ScheduleMessage("user", "c1", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("bad time"))
+	m.idempotency.EXPECT().Release("user", "key1").Return(nil)
+	m.poster.EXPECT().SendEphemeralPost("user", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Equal(t, "bad time", post.Message)
+	})
+	rr := httptest.NewRecorder()
+
+	h.CreateSchedule(rr, createScheduleRequest(createScheduleBody, "key1"))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestCreateSchedule_KeyTooLong(t *testing.T) {
+	h, _ := setupCreateScheduleHandler(t)
+	rr := httptest.NewRecorder()
+
+	h.CreateSchedule(rr, createScheduleRequest(createScheduleBody, strings.Repeat("k", constants.MaxIdempotencyKeyLength+1)))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// FailureAction handles the buttons on a failure DM. On success the DM is
+// replaced with the outcome.
+func (h *Handler) FailureAction(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling FailureAction request", "user_id", userID)
+
+	var req model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("Failed to decode FailureAction request", "user_id", userID, "error", err)
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	action, _ := req.Context["action"].(string)
+	msgID, _ := req.Context["id"].(string)
+	if msgID == "" {
+		http.Error(w, "missing message id", http.StatusBadRequest)
+		return
+	}
+
+	var msg *types.ScheduledMessage
+	var err error
+	switch action {
+	case constants.FailureActionRetry:
+		msg, err = h.Failures.Retry(userID, msgID)
+	case constants.FailureActionReschedule:
+		option, _ := req.Context["selected_option"].(string)
+		by, parseErr := time.ParseDuration(option)
+		if parseErr != nil || by <= 0 {
+			http.Error(w, fmt.Sprintf("invalid reschedule delay %q", option), http.StatusBadRequest)
+			return
+		}
+		msg, err = h.Failures.Reschedule(userID, msgID, by)
+	case constants.FailureActionRetarget:
+		channelID, _ := req.Context["selected_option"].(string)
+		if channelID == "" {
+			http.Error(w, "missing channel", http.StatusBadRequest)
+			return
+		}
+		msg, err = h.Failures.Retarget(userID, msgID, channelID)
+	case constants.FailureActionDraft:
+		msg, err = h.Failures.Draft(userID, msgID)
+	default:
+		http.Error(w, fmt.Sprintf("unknown action %q", action), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Warn("Failed to apply failure action", "user_id", userID, "message_id", msgID, "action", action, "error", err)
+		h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{EphemeralText: failureActionErrorText(err)})
+		return
+	}
+
+	channelLink := h.Channel.MakeChannelLink(h.Channel.GetInfoOrUnknown(msg.ChannelID))
+	if action == constants.FailureActionDraft {
+		h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{
+			Update: &model.Post{Message: formatter.FormatMovedToDraft(channelLink, msg.MessageContent)},
+		})
+		return
+	}
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	queued := action == constants.FailureActionReschedule || (action == constants.FailureActionRetarget && msg.ShiftedFrom != nil)
+	h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{
+		Update: &model.Post{Message: formatter.FormatFailureResolved(queued, msg.PostAt.In(loc), loc.String(), channelLink)},
+	})
+}
+
+func failureActionErrorText(err error) string {
+	switch {
+	case errors.Is(err, types.ErrMessageNotFound):
+		return fmt.Sprintf("%s That message is no longer available. It may already have been dealt with.", constants.EmojiError)
+	case errors.Is(err, types.ErrDeliveryPaused):
+		return fmt.Sprintf("%s Delivery is paused by an admin. Your message is queued again and will be posted when delivery resumes.", constants.EmojiWarning)
+	default:
+		return fmt.Sprintf("%s Could not post the message: %v", constants.EmojiError, err)
+	}
+}
@@ -0,0 +1,136 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupFailureHandler(t *testing.T) (*Handler, *mock.MockFailureService, *mock.MockChannelService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	failuresMock := mock.NewMockFailureService(ctrl)
+	channelMock := mock.NewMockChannelService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, Failures: failuresMock, Channel: channelMock}, failuresMock, channelMock
+}
+
+func failureRequest(context string) *http.Request {
+	body := `{"user_id": "u1", "context": ` + context + `}`
+	r := httptest.NewRequest(http.MethodPost, "/api/v1"+constants.FailurePath, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "u1")
+	return r
+}
+
+func TestServeHTTP_FailureAction_Reschedule(t *testing.T) {
+	h, failuresMock, channelMock := setupFailureHandler(t)
+	postAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	failuresMock.EXPECT().Reschedule("u1", "m1", 3*time.Hour).Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c1", PostAt: postAt, Timezone: "UTC"}, nil)
+	info := &ports.ChannelInfo{ChannelID: "c1"}
+	channelMock.EXPECT().GetInfoOrUnknown("c1").Return(info)
+	channelMock.EXPECT().MakeChannelLink(info).Return("in channel: ~c1")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, failureRequest(`{"action": "reschedule", "id": "m1", "selected_option": "3h"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "Rescheduled your message in channel: ~c1")
+	assert.Contains(t, resp.Update.Message, postAt.Format(constants.TimeLayout))
+}
+
+func TestServeHTTP_FailureAction_Retarget(t *testing.T) {
+	h, failuresMock, channelMock := setupFailureHandler(t)
+	failuresMock.EXPECT().Retarget("u1", "m1", "c2").Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c2", Timezone: "UTC"}, nil)
+	channelMock.EXPECT().GetInfoOrUnknown("c2").Return(&ports.ChannelInfo{})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("in channel: ~c2")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, failureRequest(`{"action": "retarget", "id": "m1", "selected_option": "c2"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "Posted your message in channel: ~c2")
+}
+
+func TestServeHTTP_FailureAction_RetargetShifted(t *testing.T) {
+	h, failuresMock, channelMock := setupFailureHandler(t)
+	postAt := time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC)
+	requested := postAt.Add(-12 * time.Hour)
+	failuresMock.EXPECT().Retarget("u1", "m1", "c2").Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c2", PostAt: postAt, ShiftedFrom: &requested, Timezone: "UTC"}, nil)
+	channelMock.EXPECT().GetInfoOrUnknown("c2").Return(&ports.ChannelInfo{})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("in channel: ~c2")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, failureRequest(`{"action": "retarget", "id": "m1", "selected_option": "c2"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "Rescheduled your message in channel: ~c2")
+	assert.Contains(t, resp.Update.Message, postAt.Format(constants.TimeLayout))
+}
+
+func TestServeHTTP_FailureAction_Draft(t *testing.T) {
+	h, failuresMock, channelMock := setupFailureHandler(t)
+	failuresMock.EXPECT().Draft("u1", "m1").Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c1", MessageContent: "the full text"}, nil)
+	channelMock.EXPECT().GetInfoOrUnknown("c1").Return(&ports.ChannelInfo{})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("in channel: ~c1")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, failureRequest(`{"action": "draft", "id": "m1"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "to your drafts")
+	assert.Contains(t, resp.Update.Message, "in channel: ~c1")
+	assert.Contains(t, resp.Update.Message, "```\nthe full text\n```")
+}
+
+func TestServeHTTP_FailureAction_Paused(t *testing.T) {
+	h, failuresMock, _ := setupFailureHandler(t)
+	failuresMock.EXPECT().Retry("u1", "m1").Return(&types.ScheduledMessage{ID: "m1"}, types.ErrDeliveryPaused)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, failureRequest(`{"action": "retry", "id": "m1"}`))
+
+	resp := decodeActionResponse(t, rr)
+	assert.Nil(t, resp.Update)
+	assert.Contains(t, resp.EphemeralText, "will be posted when delivery resumes")
+}
+
+func TestServeHTTP_FailureAction_NotFound(t *testing.T) {
+	h, failuresMock, _ := setupFailureHandler(t)
+	failuresMock.EXPECT().Retry("u1", "m1").Return(nil, types.ErrMessageNotFound)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, failureRequest(`{"action": "retry", "id": "m1"}`))
+
+	resp := decodeActionResponse(t, rr)
+	assert.Contains(t, resp.EphemeralText, "no longer available")
+}
+
+func TestServeHTTP_FailureAction_BadRequest(t *testing.T) {
+	h, _, _ := setupFailureHandler(t)
+
+	for _, context := range []string{
+		`{"action": "retry"}`,
+		`{"action": "reschedule", "id": "m1", "selected_option": "soon"}`,
+		`{"action": "retarget", "id": "m1"}`,
+		`{"action": "explode", "id": "m1"}`,
+	} {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(nil, rr, failureRequest(context))
+		assert.Equal(t, http.StatusBadRequest, rr.Code, context)
+	}
+}
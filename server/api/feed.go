@@ -0,0 +1,35 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/feed"
+)
+
+func (h *Handler) GetFeed(w http.ResponseWriter, r *http.Request) {
+	h.logger.Debug("Handling GetFeed request", "remote_addr", r.RemoteAddr)
+	token := mux.Vars(r)["token"]
+
+	body, err := h.Feed.Render(token)
+	if errors.Is(err, feed.ErrInvalidToken) {
+		h.logger.Debug("Calendar feed requested with unknown token", "remote_addr", r.RemoteAddr)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to render calendar feed", "error", err)
+		http.Error(w, "Failed to render calendar feed", http.StatusInternalServerError)
+		return
+	}
+	h.logger.Debug("Successfully rendered calendar feed", "bytes", len(body))
+
+	w.Header().Set("Content-Type", constants.FeedContentType)
+	w.Header().Set("Cache-Control", "no-store")
+	if _, err := w.Write(body); err != nil {
+		h.logger.Warn("Failed to write calendar feed response", "error", err)
+	}
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/feed"
+)
+
+func setupFeedHandler(t *testing.T) (*Handler, *mock.MockFeedService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	feedMock := mock.NewMockFeedService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, Feed: feedMock}, feedMock
+}
+
+func TestServeHTTP_Feed_NoSessionRequired(t *testing.T) {
+	h, feedMock := setupFeedHandler(t)
+	feedMock.EXPECT().Render("abc123").Return([]byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/feed/abc123.ics", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, req)
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, constants.FeedContentType, rr.Header().Get("Content-Type"))
+	assert.Contains(t, rr.Body.String(), "BEGIN:VCALENDAR")
+}
+
+func TestServeHTTP_Feed_UnknownToken(t *testing.T) {
+	h, feedMock := setupFeedHandler(t)
+	feedMock.EXPECT().Render("abc123").Return(nil, feed.ErrInvalidToken)
+
+	req := httptest.NewRequest(http.MethodGet, "/feed/abc123.ics", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
+
+func TestServeHTTP_Feed_RenderError(t *testing.T) {
+	h, feedMock := setupFeedHandler(t)
+	feedMock.EXPECT().Render("abc123").Return(nil, errors.New("kv down"))
+
+	req := httptest.NewRequest(http.MethodGet, "/feed/abc123.ics", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	assert.NotContains(t, rr.Body.String(), "kv down")
+}
+
+func TestServeHTTP_Feed_MalformedToken(t *testing.T) {
+	h, _ := setupFeedHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/feed/not-a-token!.ics", nil)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, req)
+
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
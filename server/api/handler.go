@@ -8,48 +8,130 @@ import (
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/command"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
 )
 
 type Handler struct {
 	logger          ports.Logger
 	poster          ports.PostService
+	user            ports.UserService
 	Command         command.Interface
 	ScheduleService ports.ScheduleService
 	ListService     ports.ListService
 	Channel         ports.ChannelService
+	Feed            ports.FeedService
+	Webhooks        ports.WebhookDeliveryStore
+	Integrations    ports.IntegrationService
+	Idempotency     ports.IdempotencyStore
+	Admin           ports.AdminService
+	Audit           ports.AuditService
+	Metrics         ports.Metrics
+	Membership      ports.MembershipService
+	Reminders       ports.ReminderService
+	Failures        ports.FailureService
+	Preferences     ports.PreferenceService
+	Drafts          ports.DraftService
 }
 
 func NewHandler(
 	logger ports.Logger,
 	poster ports.PostService,
+	user ports.UserService,
 	channel ports.ChannelService,
 	command command.Interface,
 	listService ports.ListService,
 	scheduleService ports.ScheduleService,
+	feed ports.FeedService,
+	webhooks ports.WebhookDeliveryStore,
+	integrations ports.IntegrationService,
+	idempotency ports.IdempotencyStore,
+	admin ports.AdminService,
+	audit ports.AuditService,
+	metrics ports.Metrics,
+	membership ports.MembershipService,
+	reminders ports.ReminderService,
+	failures ports.FailureService,
+	preferences ports.PreferenceService,
+	drafts ports.DraftService,
 ) *Handler {
 	logger.Debug("Creating new api Handler")
 	return &Handler{
 		logger:          logger,
 		poster:          poster,
+		user:            user,
 		Channel:         channel,
 		Command:         command,
 		ListService:     listService,
 		ScheduleService: scheduleService,
+		Feed:            feed,
+		Webhooks:        webhooks,
+		Integrations:    integrations,
+		Idempotency:     idempotency,
+		Admin:           admin,
+		Audit:           audit,
+		Metrics:         metrics,
+		Membership:      membership,
+		Reminders:       reminders,
+		Failures:        failures,
+		Preferences:     preferences,
+		Drafts:          drafts,
 	}
 }
 
 // ServeHTTP sets up the HTTP router and handlers for the API.
 func (h *Handler) ServeHTTP(c *plugin.Context, w http.ResponseWriter, r *http.Request) {
 	router := mux.NewRouter()
+	if h.Metrics != nil {
+		router.Use(h.InstrumentRequests)
+	}
+
+	// The calendar feed is authorized by the secret token in its URL, so calendar
+	// apps can subscribe without a Mattermost session.
+	router.HandleFunc(constants.FeedPathPrefix+"{token:[0-9a-f]+}"+constants.FeedFileExtension, h.GetFeed).Methods(http.MethodGet)
 
-	// Apply middleware to require Mattermost authorization.
-	router.Use(h.MattermostAuthorizationRequired)
+	// Integration routes are authorized by an admin-issued token instead of a
+	// Mattermost session, so they are registered before the session-only routes.
+	integrations := router.PathPrefix("/api/v1/integration").Subrouter()
+	integrations.Use(h.IntegrationTokenRequired)
+	integrations.HandleFunc("/schedule", h.IntegrationCreateSchedule).Methods(http.MethodPost)
+	integrations.HandleFunc("/schedule", h.IntegrationListSchedules).Methods(http.MethodGet)
+	integrations.HandleFunc("/schedule/{id}", h.IntegrationDeleteSchedule).Methods(http.MethodDelete)
 
-	// Set up /api/v1 routes.
+	// Metrics are for System Admins only. Scrapers authenticate with an admin's
+	// personal access token.
+	metrics := router.Path(constants.MetricsPath).Subrouter()
+	metrics.Use(h.MattermostAuthorizationRequired, h.SystemAdminRequired)
+	metrics.Methods(http.MethodGet).HandlerFunc(h.GetMetrics)
+
+	// Set up /api/v1 routes, which require Mattermost authorization.
 	api := router.PathPrefix("/api/v1").Subrouter()
+	api.Use(h.MattermostAuthorizationRequired)
 	api.HandleFunc("/delete", h.ListDeleteMessage).Methods(http.MethodPost)
+	api.HandleFunc(constants.ListPostponePath, h.ListPostponeMessage).Methods(http.MethodPost)
+	api.HandleFunc(constants.ListDraftPath, h.ListDraftMessage).Methods(http.MethodPost)
 	api.HandleFunc("/schedule", h.CreateSchedule).Methods(http.MethodPost)
 	api.HandleFunc("/schedule/{channelId}", h.GetSchedules).Methods(http.MethodGet)
+	api.HandleFunc("/messages", h.ListMessages).Methods(http.MethodGet)
+	api.HandleFunc(constants.LeftChannelPath, h.LeftChannelAction).Methods(http.MethodPost)
+	api.HandleFunc(constants.ReminderPath, h.ReminderAction).Methods(http.MethodPost)
+	api.HandleFunc(constants.FailurePath, h.FailureAction).Methods(http.MethodPost)
+	api.HandleFunc(constants.PreferencesPath, h.GetPreferences).Methods(http.MethodGet)
+	api.HandleFunc(constants.PreferencesPath, h.UpdatePreferences).Methods(http.MethodPut)
+	api.HandleFunc(constants.PreferencesDialogPath, h.SubmitPreferencesDialog).Methods(http.MethodPost)
+
+	// Admin-only routes.
+	admin := api.PathPrefix("/admin").Subrouter()
+	admin.Use(h.SystemAdminRequired)
+	admin.HandleFunc("/webhooks/deliveries", h.ListWebhookDeliveries).Methods(http.MethodGet)
+	admin.HandleFunc("/integrations/tokens", h.ListIntegrationTokens).Methods(http.MethodGet)
+	admin.HandleFunc("/integrations/tokens", h.CreateIntegrationToken).Methods(http.MethodPost)
+	admin.HandleFunc("/integrations/tokens/{id}", h.RevokeIntegrationToken).Methods(http.MethodDelete)
+	admin.HandleFunc("/messages", h.AdminListMessages).Methods(http.MethodGet)
+	admin.HandleFunc("/messages/{id}", h.AdminCancelMessage).Methods(http.MethodDelete)
+	admin.HandleFunc("/audit", h.AdminListAudit).Methods(http.MethodGet)
+	admin.HandleFunc("/status", h.AdminStatus).Methods(http.MethodGet)
+	admin.HandleFunc("/pause", h.AdminPauseDelivery).Methods(http.MethodPost)
+	admin.HandleFunc("/resume", h.AdminResumeDelivery).Methods(http.MethodPost)
 
 	router.ServeHTTP(w, r)
 }
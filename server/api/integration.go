@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/integration"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type CreateIntegrationTokenRequest struct {
+	Name       string   `json:"name"`
+	ChannelIDs []string `json:"channel_ids"`
+	BotUserID  string   `json:"bot_user_id"`
+}
+
+type CreateIntegrationTokenResponse struct {
+	Token  *types.IntegrationToken `json:"token"`
+	Secret string                  `json:"secret"`
+}
+
+func (h *Handler) IntegrationCreateSchedule(w http.ResponseWriter, r *http.Request) {
+	token := integrationTokenFromContext(r)
+	h.logger.Debug("Handling IntegrationCreateSchedule request", "token_id", token.ID)
+
+	req, err := parseCreateScheduleRequest(h, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !token.AllowsChannel(req.ChannelID) {
+		h.logger.Warn("Integration token used outside its channel scope", "token_id", token.ID, "channel_id", req.ChannelID)
+		http.Error(w, integration.ErrChannelNotAllowed.Error(), http.StatusForbidden)
+		return
+	}
+
+	msg, err := h.ScheduleService.ScheduleIntegrationMessage(token, req.ChannelID, req.FileIDs, req.metadata(), parseRequestToCommand(req))
+	if err != nil {
+		h.logger.Debug("Failed to schedule message for integration token", "token_id", token.ID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.logger.Info("Scheduled message via integration token", "token_id", token.ID, "message_id", msg.ID, "channel_id", msg.ChannelID)
+	h.writeJSON(w, http.StatusCreated, msg)
+}
+
+func (h *Handler) IntegrationListSchedules(w http.ResponseWriter, r *http.Request) {
+	token := integrationTokenFromContext(r)
+	h.logger.Debug("Handling IntegrationListSchedules request", "token_id", token.ID, "query", r.URL.RawQuery)
+
+	query, err := parseMessageQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	page, err := h.Integrations.ListMessages(token, query)
+	switch {
+	case errors.Is(err, integration.ErrChannelNotAllowed):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, types.ErrInvalidCursor):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case err != nil:
+		h.logger.Error("Failed to list messages for integration token", "token_id", token.ID, "error", err)
+		http.Error(w, "Failed to list scheduled messages", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, page)
+}
+
+func (h *Handler) IntegrationDeleteSchedule(w http.ResponseWriter, r *http.Request) {
+	token := integrationTokenFromContext(r)
+	msgID := mux.Vars(r)["id"]
+	h.logger.Debug("Handling IntegrationDeleteSchedule request", "token_id", token.ID, "message_id", msgID)
+
+	if _, err := h.Integrations.GetMessage(token, msgID); err != nil {
+		if errors.Is(err, integration.ErrChannelNotAllowed) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	deleted, err := h.Command.UserDeleteMessage(token.BotUserID, msgID)
+	if err != nil {
+		h.logger.Error("Failed to delete message for integration token", "token_id", token.ID, "message_id", msgID, "error", err)
+		http.Error(w, "Failed to delete scheduled message", http.StatusInternalServerError)
+		return
+	}
+	h.logger.Info("Deleted scheduled message via integration token", "token_id", token.ID, "message_id", msgID)
+	h.writeJSON(w, http.StatusOK, deleted)
+}
+
+func (h *Handler) ListIntegrationTokens(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling ListIntegrationTokens request", "user_id", userID)
+
+	tokens, err := h.Integrations.List()
+	if err != nil {
+		h.logger.Error("Failed to list integration tokens", "user_id", userID, "error", err)
+		http.Error(w, "Failed to list integration tokens", http.StatusInternalServerError)
+		return
+	}
+	if tokens == nil {
+		tokens = []*types.IntegrationToken{}
+	}
+	h.writeJSON(w, http.StatusOK, tokens)
+}
+
+func (h *Handler) CreateIntegrationToken(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling CreateIntegrationToken request", "user_id", userID)
+
+	var req CreateIntegrationTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("Failed to decode CreateIntegrationToken request", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	token, secret, err := h.Integrations.Issue(req.Name, req.ChannelIDs, req.BotUserID, userID)
+	if err != nil {
+		h.logger.Warn("Failed to issue integration token", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.writeJSON(w, http.StatusCreated, &CreateIntegrationTokenResponse{Token: token, Secret: secret})
+}
+
+func (h *Handler) RevokeIntegrationToken(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	tokenID := mux.Vars(r)["id"]
+	h.logger.Debug("Handling RevokeIntegrationToken request", "user_id", userID, "token_id", tokenID)
+
+	deleted, err := h.Integrations.Revoke(tokenID)
+	if err != nil {
+		h.logger.Error("Failed to revoke integration token", "user_id", userID, "token_id", tokenID, "error", err)
+		http.Error(w, "Failed to revoke integration token", http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		http.Error(w, "Integration token not found", http.StatusNotFound)
+		return
+	}
+	h.logger.Info("Revoked integration token", "user_id", userID, "token_id", tokenID)
+	w.WriteHeader(http.StatusNoContent)
+}
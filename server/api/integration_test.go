@@ -0,0 +1,196 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/integration"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type integrationMocks struct {
+	integrations *mock.MockIntegrationService
+	schedule     *mock.MockScheduleService
+	user         *mock.MockUserService
+	command      *mockCommand
+}
+
+var testIntegrationToken = &types.IntegrationToken{ID: "tok1", BotUserID: "bot", ChannelIDs: []string{"c1"}}
+
+func setupIntegrationHandler(t *testing.T) (*Handler, *integrationMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &integrationMocks{
+		integrations: mock.NewMockIntegrationService(ctrl),
+		schedule:     mock.NewMockScheduleService(ctrl),
+		user:         mock.NewMockUserService(ctrl),
+		command:      &mockCommand{},
+	}
+	return &Handler{
+		logger:          &testutil.FakeLogger{},
+		user:            m.user,
+		Command:         m.command,
+		ScheduleService: m.schedule,
+		Integrations:    m.integrations,
+	}, m
+}
+
+func integrationRequest(method, target, body string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderIntegrationToken, "secret")
+	return r
+}
+
+func TestServeHTTP_Integration_InvalidToken(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.integrations.EXPECT().Authenticate("secret").Return(nil, integration.ErrInvalidToken)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodGet, "/api/v1/integration/schedule", ""))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestServeHTTP_Integration_CreateSchedule(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.integrations.EXPECT().Authenticate("secret").Return(testIntegrationToken, nil)
+	m.schedule.EXPECT().ScheduleIntegrationMessage(testIntegrationToken, "c1", nil, gomock.Nil(), "at 22:00 on 2025-01-02 message deploy window opens").
+		Return(&types.ScheduledMessage{ID: "m1", UserID: "bot", ChannelID: "c1"}, nil)
+	rr := httptest.NewRecorder()
+
+	body := `{"channel_id":"c1","post_at_time":"22:00","post_at_date":"2025-01-02","message":"deploy window opens"}`
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodPost, "/api/v1/integration/schedule", body))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var got types.ScheduledMessage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, "m1", got.ID)
+}
+
+func TestServeHTTP_Integration_CreateSchedule_ChannelOutsideScope(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.integrations.EXPECT().Authenticate("secret").Return(testIntegrationToken, nil)
+	rr := httptest.NewRecorder()
+
+	body := `{"channel_id":"c2","post_at_time":"22:00","message":"hi"}`
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodPost, "/api/v1/integration/schedule", body))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestServeHTTP_Integration_CreateSchedule_ValidationError(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.integrations.EXPECT().Authenticate("secret").Return(testIntegrationToken, nil)
+	m.schedule.EXPECT().ScheduleIntegrationMessage(testIntegrationToken, "c1", nil, gomock.Nil(), gomock.Any()).Return(nil, errors.New("bad time"))
+	rr := httptest.NewRecorder()
+
+	body := `{"channel_id":"c1","post_at_time":"nope","message":"hi"}`
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodPost, "/api/v1/integration/schedule", body))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "bad time")
+}
+
+func TestServeHTTP_Integration_ListSchedules(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.integrations.EXPECT().Authenticate("secret").Return(testIntegrationToken, nil)
+	m.integrations.EXPECT().ListMessages(testIntegrationToken, &types.MessageQuery{ChannelIDs: []string{"c1"}, Limit: 5}).
+		Return(&types.MessagePage{Messages: []*types.ScheduledMessage{{ID: "m1"}}, NextCursor: "next"}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodGet, "/api/v1/integration/schedule?channel_id=c1&limit=5", ""))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.MessagePage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got.Messages, 1)
+	assert.Equal(t, "next", got.NextCursor)
+}
+
+func TestServeHTTP_Integration_DeleteSchedule(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "bot", ChannelID: "c1"}
+	m.integrations.EXPECT().Authenticate("secret").Return(testIntegrationToken, nil)
+	m.integrations.EXPECT().GetMessage(testIntegrationToken, "m1").Return(msg, nil)
+	var deletedBy string
+	m.command.ListDeleteMessageFunc = func(userID, _ string) (*types.ScheduledMessage, error) {
+		deletedBy = userID
+		return msg, nil
+	}
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodDelete, "/api/v1/integration/schedule/m1", ""))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "bot", deletedBy)
+}
+
+func TestServeHTTP_Integration_DeleteSchedule_OutsideScope(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.integrations.EXPECT().Authenticate("secret").Return(testIntegrationToken, nil)
+	m.integrations.EXPECT().GetMessage(testIntegrationToken, "m1").Return(nil, integration.ErrChannelNotAllowed)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, integrationRequest(http.MethodDelete, "/api/v1/integration/schedule/m1", ""))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func adminTokenRequest(method, target, body string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "admin")
+	return r
+}
+
+func TestServeHTTP_CreateIntegrationToken(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.user.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(true)
+	issued := &types.IntegrationToken{ID: "tok1", Name: "CI", ChannelIDs: []string{"c1"}, BotUserID: "bot"}
+	m.integrations.EXPECT().Issue("CI", []string{"c1"}, "", "admin").Return(issued, "s3cret", nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminTokenRequest(http.MethodPost, "/api/v1/admin/integrations/tokens", `{"name":"CI","channel_ids":["c1"]}`))
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var got CreateIntegrationTokenResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.Equal(t, "s3cret", got.Secret)
+	assert.Equal(t, "tok1", got.Token.ID)
+}
+
+func TestServeHTTP_CreateIntegrationToken_Forbidden(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.user.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(false)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminTokenRequest(http.MethodPost, "/api/v1/admin/integrations/tokens", `{}`))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestServeHTTP_RevokeIntegrationToken(t *testing.T) {
+	h, m := setupIntegrationHandler(t)
+	m.user.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(true).Times(2)
+	m.integrations.EXPECT().Revoke("tok1").Return(true, nil)
+	m.integrations.EXPECT().Revoke("missing").Return(false, nil)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, adminTokenRequest(http.MethodDelete, "/api/v1/admin/integrations/tokens/tok1", ""))
+	assert.Equal(t, http.StatusNoContent, rr.Code)
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, adminTokenRequest(http.MethodDelete, "/api/v1/admin/integrations/tokens/missing", ""))
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
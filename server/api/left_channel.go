@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// LeftChannelAction handles the buttons on the DM sent when an owner leaves
+// the channel one of their messages is scheduled for. On success the DM is
+// replaced with the outcome so the buttons cannot be pressed twice.
+func (h *Handler) LeftChannelAction(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling LeftChannelAction request", "user_id", userID)
+
+	var req model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("Failed to decode LeftChannelAction request", "user_id", userID, "error", err)
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	action, _ := req.Context["action"].(string)
+	msgID, _ := req.Context["id"].(string)
+	if msgID == "" {
+		http.Error(w, "missing message id", http.StatusBadRequest)
+		return
+	}
+
+	var msg *types.ScheduledMessage
+	var err error
+	switch action {
+	case constants.LeftChannelActionMove:
+		channelID, _ := req.Context["selected_option"].(string)
+		if channelID == "" {
+			http.Error(w, "missing selected channel", http.StatusBadRequest)
+			return
+		}
+		msg, err = h.Membership.MoveMessage(userID, msgID, channelID)
+	case constants.LeftChannelActionSelf:
+		msg, err = h.Membership.MoveMessageToSelf(userID, msgID)
+	case constants.LeftChannelActionCancel:
+		msg, err = h.Membership.CancelMessage(userID, msgID)
+	default:
+		http.Error(w, fmt.Sprintf("unknown action %q", action), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Warn("Failed to apply left channel action", "user_id", userID, "message_id", msgID, "action", action, "error", err)
+		text := fmt.Sprintf("%s Could not update message: %v", constants.EmojiError, err)
+		if errors.Is(err, types.ErrMessageNotFound) {
+			text = fmt.Sprintf("%s That message no longer exists. It may already have been sent or cancelled.", constants.EmojiError)
+		}
+		h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{EphemeralText: text})
+		return
+	}
+
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	channelLink := h.Channel.MakeChannelLink(h.Channel.GetInfoOrUnknown(msg.ChannelID))
+	h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{
+		Update: &model.Post{Message: formatter.FormatLeftChannelResolved(action, msg.PostAt.In(loc), loc.String(), channelLink)},
+	})
+}
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/membership"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupLeftChannelHandler(t *testing.T) (*Handler, *mock.MockMembershipService, *mock.MockChannelService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	membershipMock := mock.NewMockMembershipService(ctrl)
+	channelMock := mock.NewMockChannelService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, Membership: membershipMock, Channel: channelMock}, membershipMock, channelMock
+}
+
+func leftChannelRequest(context string) *http.Request {
+	body := `{"user_id": "u1", "context": ` + context + `}`
+	r := httptest.NewRequest(http.MethodPost, "/api/v1"+constants.LeftChannelPath, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "u1")
+	return r
+}
+
+func decodeActionResponse(t *testing.T, rr *httptest.ResponseRecorder) *model.PostActionIntegrationResponse {
+	t.Helper()
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp model.PostActionIntegrationResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	return &resp
+}
+
+func TestServeHTTP_LeftChannelAction_Move(t *testing.T) {
+	h, membershipMock, channelMock := setupLeftChannelHandler(t)
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "u1", ChannelID: "c2", PostAt: time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC), Timezone: "UTC"}
+	membershipMock.EXPECT().MoveMessage("u1", "m1", "c2").Return(msg, nil)
+	info := &ports.ChannelInfo{ChannelID: "c2"}
+	channelMock.EXPECT().GetInfoOrUnknown("c2").Return(info)
+	channelMock.EXPECT().MakeChannelLink(info).Return("in channel: ~random")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, leftChannelRequest(`{"action": "move", "id": "m1", "selected_option": "c2"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "in channel: ~random")
+	assert.Empty(t, resp.Update.Attachments())
+}
+
+func TestServeHTTP_LeftChannelAction_Cancel(t *testing.T) {
+	h, membershipMock, channelMock := setupLeftChannelHandler(t)
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "u1", ChannelID: "c1", Timezone: "UTC"}
+	membershipMock.EXPECT().CancelMessage("u1", "m1").Return(msg, nil)
+	channelMock.EXPECT().GetInfoOrUnknown("c1").Return(&ports.ChannelInfo{})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, leftChannelRequest(`{"action": "cancel", "id": "m1"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "Cancelled")
+}
+
+func TestServeHTTP_LeftChannelAction_ErrorIsEphemeral(t *testing.T) {
+	h, membershipMock, _ := setupLeftChannelHandler(t)
+	membershipMock.EXPECT().MoveMessageToSelf("u1", "m1").Return(nil, types.ErrMessageNotFound)
+	membershipMock.EXPECT().MoveMessage("u1", "m1", "c2").Return(nil, membership.ErrCannotPost)
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, leftChannelRequest(`{"action": "self", "id": "m1"}`))
+	resp := decodeActionResponse(t, rr)
+	assert.Nil(t, resp.Update)
+	assert.Contains(t, resp.EphemeralText, "no longer exists")
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, leftChannelRequest(`{"action": "move", "id": "m1", "selected_option": "c2"}`))
+	resp = decodeActionResponse(t, rr)
+	assert.Contains(t, resp.EphemeralText, membership.ErrCannotPost.Error())
+}
+
+func TestServeHTTP_LeftChannelAction_BadRequest(t *testing.T) {
+	for name, context := range map[string]string{
+		"missing id":        `{"action": "cancel"}`,
+		"unknown action":    `{"action": "snooze", "id": "m1"}`,
+		"move without pick": `{"action": "move", "id": "m1"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			h, _, _ := setupLeftChannelHandler(t)
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(nil, rr, leftChannelRequest(context))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
@@ -101,7 +101,7 @@ func (h *Handler) sendDeletionConfirmation(userID string, channelID string, dele
 		h.logger.Warn("Failed to load timezone for confirmation message, falling back to UTC", "user_id", userID, "message_id", deletedMsg.ID, "timezone", deletedMsg.Timezone, "error", err)
 		loc = time.UTC
 	}
-	humanTime := deletedMsg.PostAt.In(loc).Format(constants.TimeLayout)
+	humanTime := deletedMsg.PostAt.In(loc).Format(h.timeLayout(userID))
 	h.logger.Debug("Formatted time for confirmation message", "user_id", userID, "message_id", deletedMsg.ID, "formatted_time", humanTime, "location", loc.String())
 	channelInfo := h.Channel.MakeChannelLink(h.Channel.GetInfoOrUnknown(deletedMsg.ChannelID))
 	confirmation := &model.Post{
@@ -20,6 +20,7 @@ import (
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/preferences"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
@@ -59,6 +60,8 @@ func setupHandler(t *testing.T, ctrl *gomock.Controller) (*Handler, *mock.MockPo
 		Command:         cmdMock,
 		ScheduleService: scheduleSvc,
 		Channel:         channelMock,
+		Preferences:     preferences.New(&testutil.FakeLogger{}, &testutil.FakePreferences{}),
+		Drafts:          mock.NewMockDraftService(ctrl),
 	}
 
 	return p, postMock, channelMock, cmdMock
@@ -0,0 +1,70 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+)
+
+// ListDraftMessage handles the Move to draft button under each message in the
+// ephemeral list. Like ListDeleteMessage, it refreshes the list in place and
+// reports the outcome in an ephemeral post.
+func (h *Handler) ListDraftMessage(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling ListDraftMessage request", "user_id", userID)
+
+	req, msgID, err := parseDraftRequest(h, r)
+	if err != nil {
+		h.logger.Error("Failed to parse draft request", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.logger.Debug("Successfully parsed draft request", "user_id", userID, "message_id", msgID, "post_id", req.PostId, "channel_id", req.ChannelId)
+
+	msg, err := h.Drafts.MoveToDraft(userID, msgID)
+	h.logger.Debug("Building updated ephemeral list", "user_id", userID)
+	updatedList := h.Command.BuildEphemeralList(&model.CommandArgs{UserId: userID})
+	h.updateEphemeralPostWithList(userID, req.PostId, req.ChannelId, updatedList)
+	if err != nil {
+		h.logger.Error("Failed to move message to drafts", "user_id", userID, "message_id", msgID, "error", err)
+		http.Error(w, fmt.Sprintf("Failed to move message to drafts: %v", err), http.StatusInternalServerError)
+		h.poster.SendEphemeralPost(userID, &model.Post{
+			UserId:    userID,
+			ChannelId: req.ChannelId,
+			Message:   fmt.Sprintf("%s Could not move message to drafts: %v", constants.EmojiError, err),
+		})
+		return
+	}
+	h.logger.Info("Successfully moved message to drafts from list", "user_id", userID, "message_id", msgID)
+	channelLink := h.Channel.MakeChannelLink(h.Channel.GetInfoOrUnknown(msg.ChannelID))
+	h.poster.SendEphemeralPost(userID, &model.Post{
+		UserId:    userID,
+		ChannelId: req.ChannelId,
+		Message:   formatter.FormatMovedToDraft(channelLink, msg.MessageContent),
+	})
+}
+
+func parseDraftRequest(h *Handler, r *http.Request) (*model.PostActionIntegrationRequest, string, error) {
+	h.logger.Debug("Decoding JSON body for draft request")
+	var req model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode JSON body", "error", err)
+		return nil, "", fmt.Errorf("invalid request body: %w", err)
+	}
+
+	h.logger.Debug("Validating draft request context", "context", req.Context)
+	action, _ := req.Context["action"].(string)
+	msgID, _ := req.Context["id"].(string)
+	if action != constants.ListDraftAction || msgID == "" {
+		err := errors.New("invalid draft request context: missing or invalid action/id")
+		h.logger.Error("Draft request context validation failed", "error", err, "action", action, "msg_id", msgID)
+		return nil, "", err
+	}
+	return &req, msgID, nil
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func createDraftRequest(t *testing.T, context map[string]any) *http.Request {
+	t.Helper()
+	b, err := json.Marshal(model.PostActionIntegrationRequest{PostId: "ephemeral123", ChannelId: "chanABC", Context: context})
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1"+constants.ListDraftPath, bytes.NewReader(b))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "u1")
+	return r
+}
+
+func TestServeHTTP_Draft_HappyPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	h, postMock, channelMock, cmdMock := setupHandler(t, ctrl)
+	draftMock := h.Drafts.(*mock.MockDraftService)
+
+	draftMock.EXPECT().MoveToDraft("u1", "msg1").Return(&types.ScheduledMessage{ID: "msg1", UserID: "u1", ChannelID: "chanDEF", MessageContent: "hello"}, nil)
+	cmdMock.BuildEphemeralListFunc = func(args *model.CommandArgs) *model.CommandResponse {
+		assert.Equal(t, "u1", args.UserId)
+		return &model.CommandResponse{Props: map[string]any{"attachments": expectedAttachments}}
+	}
+	channelMock.EXPECT().GetInfoOrUnknown("chanDEF").Return(&ports.ChannelInfo{ChannelID: "chanDEF"})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("in channel: ~town-square")
+	postMock.EXPECT().UpdateEphemeralPost("u1", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Equal(t, "ephemeral123", post.Id)
+		assert.Equal(t, expectedAttachments, post.Props["attachments"])
+	})
+	postMock.EXPECT().SendEphemeralPost("u1", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Equal(t, "chanABC", post.ChannelId)
+		assert.Contains(t, post.Message, "Moved your scheduled message in channel: ~town-square to your drafts.")
+		assert.Contains(t, post.Message, "```\nhello\n```")
+	})
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, createDraftRequest(t, map[string]any{"action": constants.ListDraftAction, "id": "msg1"}))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestServeHTTP_Draft_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	h, postMock, _, cmdMock := setupHandler(t, ctrl)
+	draftMock := h.Drafts.(*mock.MockDraftService)
+
+	draftMock.EXPECT().MoveToDraft("u1", "msg1").Return(nil, types.ErrMessageNotFound)
+	cmdMock.BuildEphemeralListFunc = func(*model.CommandArgs) *model.CommandResponse {
+		return &model.CommandResponse{Props: map[string]any{"attachments": expectedAttachments}}
+	}
+	postMock.EXPECT().UpdateEphemeralPost("u1", gomock.Any())
+	postMock.EXPECT().SendEphemeralPost("u1", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Contains(t, post.Message, "Could not move message to drafts")
+	})
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, createDraftRequest(t, map[string]any{"action": constants.ListDraftAction, "id": "msg1"}))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestServeHTTP_Draft_InvalidContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		context map[string]any
+	}{
+		{"wrong action", map[string]any{"action": "delete", "id": "msg1"}},
+		{"missing id", map[string]any{"action": constants.ListDraftAction}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h, _, _, _ := setupHandler(t, gomock.NewController(t))
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(nil, rr, createDraftRequest(t, tc.context))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
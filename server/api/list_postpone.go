@@ -0,0 +1,91 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// ListPostponeMessage handles the postpone buttons under each message in the
+// ephemeral list. Like ListDeleteMessage, it refreshes the list in place and
+// reports the outcome in an ephemeral post.
+func (h *Handler) ListPostponeMessage(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling ListPostponeMessage request", "user_id", userID)
+
+	req, msgID, option, err := parsePostponeRequest(h, r)
+	if err != nil {
+		h.logger.Error("Failed to parse postpone request", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.logger.Debug("Successfully parsed postpone request", "user_id", userID, "message_id", msgID, "option", option, "post_id", req.PostId, "channel_id", req.ChannelId)
+
+	postponedMsg, err := h.ScheduleService.Postpone(userID, msgID, option)
+	h.logger.Debug("Building updated ephemeral list", "user_id", userID)
+	updatedList := h.Command.BuildEphemeralList(&model.CommandArgs{UserId: userID})
+	h.updateEphemeralPostWithList(userID, req.PostId, req.ChannelId, updatedList)
+	if err != nil {
+		h.logger.Error("Failed to postpone message", "user_id", userID, "message_id", msgID, "option", option, "error", err)
+		http.Error(w, fmt.Sprintf("Failed to postpone message: %v", err), http.StatusInternalServerError)
+		h.sendPostponeError(userID, req.ChannelId, msgID, err)
+		return
+	}
+	h.logger.Info("Successfully postponed message from list", "user_id", userID, "message_id", msgID, "option", option)
+	h.sendPostponeConfirmation(userID, req.ChannelId, postponedMsg)
+}
+
+func parsePostponeRequest(h *Handler, r *http.Request) (*model.PostActionIntegrationRequest, string, string, error) {
+	h.logger.Debug("Decoding JSON body for postpone request")
+	var req model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode JSON body", "error", err)
+		return nil, "", "", fmt.Errorf("invalid request body: %w", err)
+	}
+
+	h.logger.Debug("Validating postpone request context", "context", req.Context)
+	action, _ := req.Context["action"].(string)
+	msgID, _ := req.Context["id"].(string)
+	option, _ := req.Context["option"].(string)
+	if action != constants.ListPostponeAction || msgID == "" || option == "" {
+		err := errors.New("invalid postpone request context: missing or invalid action/id/option")
+		h.logger.Error("Postpone request context validation failed", "error", err, "action", action, "msg_id", msgID, "option", option)
+		return nil, "", "", err
+	}
+	return &req, msgID, option, nil
+}
+
+func (h *Handler) sendPostponeConfirmation(userID string, channelID string, msg *types.ScheduledMessage) {
+	h.logger.Debug("Preparing postpone confirmation message", "user_id", userID, "channel_id", channelID, "message_id", msg.ID, "timezone", msg.Timezone)
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		h.logger.Warn("Failed to load timezone for postpone confirmation, falling back to UTC", "user_id", userID, "message_id", msg.ID, "timezone", msg.Timezone, "error", err)
+		loc = time.UTC
+	}
+	humanTime := msg.PostAt.In(loc).Format(h.timeLayout(userID))
+	channelInfo := h.Channel.MakeChannelLink(h.Channel.GetInfoOrUnknown(msg.ChannelID))
+	confirmation := &model.Post{
+		UserId:    userID,
+		ChannelId: channelID,
+		Message:   fmt.Sprintf("%s Message %s postponed to **%s** (%s).", constants.EmojiSuccess, channelInfo, humanTime, loc.String()),
+	}
+	h.poster.SendEphemeralPost(userID, confirmation)
+	h.logger.Debug("Sent ephemeral postpone confirmation", "user_id", userID, "channel_id", channelID, "message_id", msg.ID)
+}
+
+func (h *Handler) sendPostponeError(userID string, channelID string, msgID string, err error) {
+	alert := &model.Post{
+		UserId:    userID,
+		ChannelId: channelID,
+		Message:   fmt.Sprintf("%s Could not postpone message: %v", constants.EmojiError, err),
+	}
+	h.poster.SendEphemeralPost(userID, alert)
+	h.logger.Debug("Sent ephemeral postpone error", "user_id", userID, "channel_id", channelID, "message_id", msgID)
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func createPostponeRequest(t *testing.T, context map[string]any) *http.Request {
+	t.Helper()
+	b, err := json.Marshal(model.PostActionIntegrationRequest{PostId: "ephemeral123", ChannelId: "chanABC", Context: context})
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodPost, "/api/v1"+constants.ListPostponePath, bytes.NewReader(b))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "u1")
+	return r
+}
+
+func TestServeHTTP_Postpone_HappyPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	h, postMock, channelMock, cmdMock := setupHandler(t, ctrl)
+	scheduleMock := h.ScheduleService.(*mock.MockScheduleService)
+	postAt := time.Date(2025, 1, 2, 16, 4, 0, 0, time.UTC)
+
+	scheduleMock.EXPECT().Postpone("u1", "msg1", constants.ListPostpone1h).Return(&types.ScheduledMessage{
+		ID: "msg1", UserID: "u1", ChannelID: "chanDEF", PostAt: postAt, Timezone: "UTC",
+	}, nil)
+	cmdMock.BuildEphemeralListFunc = func(args *model.CommandArgs) *model.CommandResponse {
+		assert.Equal(t, "u1", args.UserId)
+		return &model.CommandResponse{Props: map[string]any{"attachments": expectedAttachments}}
+	}
+	channelMock.EXPECT().GetInfoOrUnknown("chanDEF").Return(&ports.ChannelInfo{ChannelID: "chanDEF"})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("~town-square")
+	postMock.EXPECT().UpdateEphemeralPost("u1", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Equal(t, "ephemeral123", post.Id)
+		assert.Equal(t, expectedAttachments, post.Props["attachments"])
+	})
+	postMock.EXPECT().SendEphemeralPost("u1", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Equal(t, "chanABC", post.ChannelId)
+		assert.Contains(t, post.Message, "~town-square postponed to **"+postAt.Format(constants.TimeLayout)+"** (UTC)")
+	})
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, createPostponeRequest(t, map[string]any{"action": "postpone", "id": "msg1", "option": constants.ListPostpone1h}))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestServeHTTP_Postpone_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	h, postMock, _, cmdMock := setupHandler(t, ctrl)
+	scheduleMock := h.ScheduleService.(*mock.MockScheduleService)
+
+	scheduleMock.EXPECT().Postpone("u1", "msg1", constants.ListPostpone15m).Return(nil, types.ErrMessageNotFound)
+	cmdMock.BuildEphemeralListFunc = func(*model.CommandArgs) *model.CommandResponse {
+		return &model.CommandResponse{Props: map[string]any{"attachments": expectedAttachments}}
+	}
+	postMock.EXPECT().UpdateEphemeralPost("u1", gomock.Any())
+	postMock.EXPECT().SendEphemeralPost("u1", gomock.Any()).Do(func(_ string, post *model.Post) {
+		assert.Contains(t, post.Message, "Could not postpone message")
+	})
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(nil, rr, createPostponeRequest(t, map[string]any{"action": "postpone", "id": "msg1", "option": constants.ListPostpone15m}))
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
+
+func TestServeHTTP_Postpone_InvalidContext(t *testing.T) {
+	tests := []struct {
+		name    string
+		context map[string]any
+	}{
+		{"wrong action", map[string]any{"action": "delete", "id": "msg1", "option": constants.ListPostpone15m}},
+		{"missing id", map[string]any{"action": "postpone", "option": constants.ListPostpone15m}},
+		{"missing option", map[string]any{"action": "postpone", "id": "msg1"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h, _, _, _ := setupHandler(t, gomock.NewController(t))
+			rr := httptest.NewRecorder()
+			h.ServeHTTP(nil, rr, createPostponeRequest(t, tc.context))
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
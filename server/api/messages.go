@@ -0,0 +1,93 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func (h *Handler) ListMessages(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling ListMessages request", "user_id", userID, "query", r.URL.RawQuery)
+
+	query, err := parseMessageQuery(r.URL.Query())
+	if err != nil {
+		h.logger.Debug("Invalid ListMessages query", "user_id", userID, "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query.UserID = userID
+
+	page, err := h.ListService.Query(query)
+	if errors.Is(err, types.ErrInvalidCursor) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to query scheduled messages", "user_id", userID, "error", err)
+		http.Error(w, "Failed to list scheduled messages", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, page)
+}
+
+// parseMessageQuery reads list filters from URL parameters: channel_id (may
+// repeat), from and to (RFC 3339 or YYYY-MM-DD in UTC, to is exclusive),
+// has_files, q, sort, cursor and limit.
+func parseMessageQuery(values url.Values) (*types.MessageQuery, error) {
+	query := &types.MessageQuery{
+		Text:   strings.TrimSpace(values.Get("q")),
+		Cursor: values.Get("cursor"),
+	}
+	for _, channelID := range values["channel_id"] {
+		if channelID = strings.TrimSpace(channelID); channelID != "" {
+			query.ChannelIDs = append(query.ChannelIDs, channelID)
+		}
+	}
+
+	var err error
+	if query.From, err = parseQueryTime(values.Get("from")); err != nil {
+		return nil, fmt.Errorf("invalid from: %w", err)
+	}
+	if query.To, err = parseQueryTime(values.Get("to")); err != nil {
+		return nil, fmt.Errorf("invalid to: %w", err)
+	}
+	if v := values.Get("has_files"); v != "" {
+		hasFiles, parseErr := strconv.ParseBool(v)
+		if parseErr != nil {
+			return nil, fmt.Errorf("invalid has_files: %w", parseErr)
+		}
+		query.HasFiles = &hasFiles
+	}
+	if v := values.Get("sort"); v != "" {
+		query.Sort = types.SortOrder(strings.ToLower(v))
+		if query.Sort != types.SortAscending && query.Sort != types.SortDescending {
+			return nil, fmt.Errorf("invalid sort %q, use asc or desc", v)
+		}
+	}
+	if v := values.Get("limit"); v != "" {
+		limit, parseErr := strconv.Atoi(v)
+		if parseErr != nil || limit < 1 || limit > constants.MaxQueryLimit {
+			return nil, fmt.Errorf("invalid limit %q, use 1 to %d", v, constants.MaxQueryLimit)
+		}
+		query.Limit = limit
+	}
+	return query, nil
+}
+
+func parseQueryTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	return time.Parse(constants.DateParseLayoutYYYYMMDD, value)
+}
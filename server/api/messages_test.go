@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestParseMessageQuery(t *testing.T) {
+	values, err := url.ParseQuery("channel_id=c1&channel_id=c2&from=2025-01-02&to=2025-01-03T10:00:00%2B02:00&has_files=true&q=+deploy+&sort=DESC&cursor=abc&limit=10")
+	require.NoError(t, err)
+
+	query, err := parseMessageQuery(values)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c1", "c2"}, query.ChannelIDs)
+	assert.True(t, query.From.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, query.To.Equal(time.Date(2025, 1, 3, 8, 0, 0, 0, time.UTC)))
+	require.NotNil(t, query.HasFiles)
+	assert.True(t, *query.HasFiles)
+	assert.Equal(t, "deploy", query.Text)
+	assert.Equal(t, types.SortDescending, query.Sort)
+	assert.Equal(t, "abc", query.Cursor)
+	assert.Equal(t, 10, query.Limit)
+}
+
+func TestParseMessageQuery_Invalid(t *testing.T) {
+	for _, raw := range []string{"from=soon", "has_files=maybe", "sort=random", "limit=0", "limit=1000"} {
+		t.Run(raw, func(t *testing.T) {
+			values, err := url.ParseQuery(raw)
+			require.NoError(t, err)
+			_, err = parseMessageQuery(values)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func setupMessagesHandler(t *testing.T) (*Handler, *mock.MockListService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	listMock := mock.NewMockListService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, ListService: listMock}, listMock
+}
+
+func messagesRequest(rawQuery string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/messages?"+rawQuery, nil)
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "user1")
+	return r
+}
+
+func TestServeHTTP_ListMessages(t *testing.T) {
+	h, listMock := setupMessagesHandler(t)
+	listMock.EXPECT().Query(&types.MessageQuery{UserID: "user1", Text: "deploy"}).
+		Return(&types.MessagePage{Messages: []*types.ScheduledMessage{{ID: "m1"}}}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, messagesRequest("q=deploy"))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.MessagePage
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got.Messages, 1)
+	assert.Empty(t, got.NextCursor)
+}
+
+func TestServeHTTP_ListMessages_InvalidCursor(t *testing.T) {
+	h, listMock := setupMessagesHandler(t)
+	listMock.EXPECT().Query(gomock.Any()).Return(nil, types.ErrInvalidCursor)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, messagesRequest("cursor=bad"))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestServeHTTP_ListMessages_BadQuery(t *testing.T) {
+	h, _ := setupMessagesHandler(t)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, messagesRequest("limit=abc"))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+)
+
+func (h *Handler) GetMetrics(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling GetMetrics request", "user_id", userID)
+
+	w.Header().Set("Content-Type", constants.MetricsContentType)
+	w.WriteHeader(http.StatusOK)
+	if err := h.Metrics.WriteMetrics(w); err != nil {
+		h.logger.Error("Failed to write metrics", "user_id", userID, "error", err)
+	}
+}
+
+// InstrumentRequests records the status and duration of every request by its
+// route template, so IDs in paths do not create a series per message.
+func (h *Handler) InstrumentRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.URL.Path
+		if current := mux.CurrentRoute(r); current != nil {
+			if tmpl, err := current.GetPathTemplate(); err == nil {
+				route = tmpl
+			}
+		}
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+		next.ServeHTTP(rec, r)
+		h.Metrics.ObserveRequest(r.Method+" "+route, rec.status, time.Since(start))
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(status int) {
+	s.status = status
+	s.ResponseWriter.WriteHeader(status)
+}
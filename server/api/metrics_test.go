@@ -0,0 +1,71 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupMetricsHandler(t *testing.T, isAdmin bool) (*Handler, *testutil.FakeMetrics) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	userMock := mock.NewMockUserService(ctrl)
+	userMock.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(isAdmin).AnyTimes()
+	auditMock := mock.NewMockAuditService(ctrl)
+	auditMock.EXPECT().Query(gomock.Any()).Return([]*types.AuditEntry{}, nil).AnyTimes()
+	metrics := &testutil.FakeMetrics{}
+	return &Handler{logger: &testutil.FakeLogger{}, user: userMock, Audit: auditMock, Metrics: metrics}, metrics
+}
+
+func TestServeHTTP_GetMetrics(t *testing.T) {
+	h, _ := setupMetricsHandler(t, true)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, constants.MetricsPath))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, constants.MetricsContentType, rr.Header().Get("Content-Type"))
+	assert.Equal(t, "# fake metrics\n", rr.Body.String())
+}
+
+func TestServeHTTP_GetMetrics_Forbidden(t *testing.T) {
+	h, _ := setupMetricsHandler(t, false)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, adminMessagesRequest(http.MethodGet, constants.MetricsPath))
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestServeHTTP_GetMetrics_Unauthorized(t *testing.T) {
+	h, _ := setupMetricsHandler(t, true)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, httptest.NewRequest(http.MethodGet, constants.MetricsPath, nil))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestServeHTTP_InstrumentsRequestsByRouteTemplate(t *testing.T) {
+	h, metrics := setupMetricsHandler(t, true)
+	adminMock := mock.NewMockAdminService(gomock.NewController(t))
+	adminMock.EXPECT().CancelMessage("admin", "m1").Return(nil, types.ErrMessageNotFound)
+	h.Admin = adminMock
+
+	h.ServeHTTP(nil, httptest.NewRecorder(), adminMessagesRequest(http.MethodGet, "/api/v1/admin/audit"))
+	h.ServeHTTP(nil, httptest.NewRecorder(), adminMessagesRequest(http.MethodDelete, "/api/v1/admin/messages/m1"))
+
+	require.Len(t, metrics.Requests, 2)
+	assert.Equal(t, "GET /api/v1/admin/audit", metrics.Requests[0])
+	assert.Equal(t, "DELETE /api/v1/admin/messages/{id}", metrics.Requests[1])
+}
@@ -1,9 +1,15 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"net/http"
 
+	"github.com/mattermost/mattermost/server/public/model"
+
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/integration"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 func (h *Handler) MattermostAuthorizationRequired(next http.Handler) http.Handler {
@@ -19,3 +25,45 @@ func (h *Handler) MattermostAuthorizationRequired(next http.Handler) http.Handle
 		next.ServeHTTP(w, r)
 	})
 }
+
+func (h *Handler) SystemAdminRequired(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+		h.logger.Debug("Checking system admin permission", "user_id", userID, "url", r.URL.String())
+		if !h.user.HasPermissionTo(userID, model.PermissionManageSystem) {
+			h.logger.Warn("Authorization failed: user lacks manage_system permission", "user_id", userID, "url", r.URL.String())
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// IntegrationTokenRequired authorizes requests from external systems by the
+// integration token in the X-Scheduled-Messages-Token header. The Authorization
+// header is left alone because Mattermost consumes it for session tokens.
+func (h *Handler) IntegrationTokenRequired(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.logger.Debug("Checking integration token", "url", r.URL.String())
+		token, err := h.Integrations.Authenticate(r.Header.Get(constants.HTTPHeaderIntegrationToken))
+		if errors.Is(err, integration.ErrInvalidToken) {
+			h.logger.Warn("Authorization failed: invalid integration token", "remote_addr", r.RemoteAddr, "url", r.URL.String())
+			http.Error(w, "Not authorized", http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			h.logger.Error("Failed to authenticate integration token", "error", err)
+			http.Error(w, "Failed to authenticate integration token", http.StatusInternalServerError)
+			return
+		}
+		h.logger.Debug("Integration token authorized", "token_id", token.ID, "bot_user_id", token.BotUserID)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), integrationTokenKey{}, token)))
+	})
+}
+
+type integrationTokenKey struct{}
+
+func integrationTokenFromContext(r *http.Request) *types.IntegrationToken {
+	token, _ := r.Context().Value(integrationTokenKey{}).(*types.IntegrationToken)
+	return token
+}
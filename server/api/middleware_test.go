@@ -5,8 +5,11 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/stretchr/testify/assert"
 
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
 )
@@ -46,3 +49,37 @@ func TestMattermostAuthorizationRequired_Authorized(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 	assert.True(t, handlerCalled, "Wrapped handler should have been called")
 }
+
+func TestSystemAdminRequired(t *testing.T) {
+	tests := []struct {
+		name       string
+		isAdmin    bool
+		wantStatus int
+		wantCalled bool
+	}{
+		{"admin", true, http.StatusOK, true},
+		{"not admin", false, http.StatusForbidden, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			userMock := mock.NewMockUserService(ctrl)
+			userMock.EXPECT().HasPermissionTo("test-user-id", model.PermissionManageSystem).Return(tc.isAdmin)
+			p := &Handler{logger: &testutil.FakeLogger{}, user: userMock}
+			handlerCalled := false
+			dummyHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerCalled = true
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set(constants.HTTPHeaderMattermostUserID, "test-user-id")
+			rr := httptest.NewRecorder()
+
+			p.SystemAdminRequired(dummyHandler).ServeHTTP(rr, req)
+
+			assert.Equal(t, tc.wantStatus, rr.Code)
+			assert.Equal(t, tc.wantCalled, handlerCalled)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// GetPreferences returns the calling user's preferences.
+func (h *Handler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling GetPreferences request", "user_id", userID)
+
+	prefs, err := h.Preferences.Get(userID)
+	if err != nil {
+		h.logger.Error("Failed to get user preferences", "user_id", userID, "error", err)
+		http.Error(w, "Failed to get preferences", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, prefs)
+}
+
+// UpdatePreferences replaces the calling user's preferences with the ones in
+// the request body. Preferences left out are reset to their defaults.
+func (h *Handler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling UpdatePreferences request", "user_id", userID)
+
+	var prefs types.UserPreferences
+	if err := json.NewDecoder(r.Body).Decode(&prefs); err != nil {
+		h.logger.Debug("Failed to decode UpdatePreferences request", "user_id", userID, "error", err)
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	saved, err := h.Preferences.Update(userID, &prefs)
+	var prefErr *types.PreferenceError
+	if errors.As(err, &prefErr) {
+		http.Error(w, fmt.Sprintf("invalid %s: %s", prefErr.Field, prefErr.Message), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to update user preferences", "user_id", userID, "error", err)
+		http.Error(w, "Failed to save preferences", http.StatusInternalServerError)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, saved)
+}
+
+// SubmitPreferencesDialog saves the settings dialog opened by `/schedule
+// settings`. Invalid values are shown next to their field, and the saved
+// settings are listed to the user in the channel they opened the dialog from.
+func (h *Handler) SubmitPreferencesDialog(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling SubmitPreferencesDialog request", "user_id", userID)
+
+	var req model.SubmitDialogRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("Failed to decode SubmitPreferencesDialog request", "user_id", userID, "error", err)
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	if req.Cancelled {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	saved, err := h.Preferences.UpdateFromDialog(userID, req.Submission)
+	var prefErr *types.PreferenceError
+	if errors.As(err, &prefErr) {
+		h.writeJSON(w, http.StatusOK, &model.SubmitDialogResponse{Errors: map[string]string{prefErr.Field: prefErr.Message}})
+		return
+	}
+	if err != nil {
+		h.logger.Error("Failed to save preferences from dialog", "user_id", userID, "error", err)
+		h.writeJSON(w, http.StatusOK, &model.SubmitDialogResponse{Error: fmt.Sprintf("Could not save your settings: %v", err)})
+		return
+	}
+	h.poster.SendEphemeralPost(userID, &model.Post{
+		UserId:    userID,
+		ChannelId: req.ChannelId,
+		Message:   formatter.FormatPreferences(saved, true),
+	})
+	w.WriteHeader(http.StatusOK)
+}
+
+// timeLayout is the layout the user wants times shown in.
+func (h *Handler) timeLayout(userID string) string {
+	prefs, err := h.Preferences.Get(userID)
+	if err != nil {
+		h.logger.Warn("Failed to get user preferences, using the 12-hour clock", "user_id", userID, "error", err)
+		return constants.TimeLayout
+	}
+	return formatter.TimeLayout(prefs.Use24Hour)
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupPreferencesHandler(t *testing.T) (*Handler, *mock.MockPreferenceService, *mock.MockPostService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	prefsMock := mock.NewMockPreferenceService(ctrl)
+	postMock := mock.NewMockPostService(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, poster: postMock, Preferences: prefsMock}, prefsMock, postMock
+}
+
+func preferencesRequest(method, path, body string) *http.Request {
+	r := httptest.NewRequest(method, "/api/v1"+path, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "u1")
+	return r
+}
+
+func TestServeHTTP_GetPreferences(t *testing.T) {
+	h, prefsMock, _ := setupPreferencesHandler(t)
+	prefsMock.EXPECT().Get("u1").Return(&types.UserPreferences{Use24Hour: true, ListPageSize: 5}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodGet, constants.PreferencesPath, ""))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var got types.UserPreferences
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	assert.True(t, got.Use24Hour)
+	assert.Equal(t, 5, got.ListPageSize)
+}
+
+func TestServeHTTP_UpdatePreferences(t *testing.T) {
+	h, prefsMock, _ := setupPreferencesHandler(t)
+	prefsMock.EXPECT().Update("u1", &types.UserPreferences{DefaultTime: "9am"}).Return(&types.UserPreferences{DefaultTime: "09:00"}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodPut, constants.PreferencesPath, `{"default_time": "9am"}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), `"default_time":"09:00"`)
+}
+
+func TestServeHTTP_UpdatePreferences_Invalid(t *testing.T) {
+	h, prefsMock, _ := setupPreferencesHandler(t)
+	prefsMock.EXPECT().Update("u1", gomock.Any()).Return(nil, &types.PreferenceError{Field: constants.PreferenceTimezone, Message: "unknown timezone"})
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodPut, constants.PreferencesPath, `{"timezone": "Mars/Olympus"}`))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+	assert.Contains(t, rr.Body.String(), "invalid timezone")
+}
+
+func TestServeHTTP_SubmitPreferencesDialog(t *testing.T) {
+	h, prefsMock, postMock := setupPreferencesHandler(t)
+	saved := &types.UserPreferences{Use24Hour: true}
+	prefsMock.EXPECT().UpdateFromDialog("u1", map[string]any{constants.PreferenceClock: "24"}).Return(saved, nil)
+	postMock.EXPECT().SendEphemeralPost("u1", gomock.Any()).DoAndReturn(func(_ string, post *model.Post) *model.Post {
+		assert.Equal(t, "c1", post.ChannelId)
+		assert.Contains(t, post.Message, "24-hour")
+		return post
+	})
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodPost, constants.PreferencesDialogPath, `{"channel_id": "c1", "submission": {"clock": "24"}}`))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestServeHTTP_SubmitPreferencesDialog_FieldError(t *testing.T) {
+	h, prefsMock, _ := setupPreferencesHandler(t)
+	prefsMock.EXPECT().UpdateFromDialog("u1", gomock.Any()).Return(nil, &types.PreferenceError{Field: constants.PreferenceDefaultTime, Message: constants.ErrPreferenceDefaultTime})
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodPost, constants.PreferencesDialogPath, `{"submission": {"default_time": "noon"}}`))
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	var resp model.SubmitDialogResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Equal(t, constants.ErrPreferenceDefaultTime, resp.Errors[constants.PreferenceDefaultTime])
+}
+
+func TestServeHTTP_SubmitPreferencesDialog_SaveError(t *testing.T) {
+	h, prefsMock, _ := setupPreferencesHandler(t)
+	prefsMock.EXPECT().UpdateFromDialog("u1", gomock.Any()).Return(nil, errors.New("kv down"))
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodPost, constants.PreferencesDialogPath, `{"submission": {}}`))
+
+	var resp model.SubmitDialogResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	assert.Contains(t, resp.Error, "kv down")
+}
+
+func TestServeHTTP_SubmitPreferencesDialog_Cancelled(t *testing.T) {
+	h, _, _ := setupPreferencesHandler(t)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, preferencesRequest(http.MethodPost, constants.PreferencesDialogPath, `{"cancelled": true}`))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
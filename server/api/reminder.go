@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// ReminderAction handles the buttons on a pre-send reminder DM. On success the
+// reminder is replaced with the outcome.
+func (h *Handler) ReminderAction(w http.ResponseWriter, r *http.Request) {
+	userID := r.Header.Get(constants.HTTPHeaderMattermostUserID)
+	h.logger.Debug("Handling ReminderAction request", "user_id", userID)
+
+	var req model.PostActionIntegrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Debug("Failed to decode ReminderAction request", "user_id", userID, "error", err)
+		http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+	action, _ := req.Context["action"].(string)
+	msgID, _ := req.Context["id"].(string)
+	if msgID == "" {
+		http.Error(w, "missing message id", http.StatusBadRequest)
+		return
+	}
+
+	var msg *types.ScheduledMessage
+	var err error
+	switch action {
+	case constants.ReminderActionSend:
+		msg, err = h.Reminders.SendNow(userID, msgID)
+	case constants.ReminderActionPostpone:
+		option, _ := req.Context["selected_option"].(string)
+		by, parseErr := time.ParseDuration(option)
+		if parseErr != nil || by <= 0 {
+			http.Error(w, fmt.Sprintf("invalid postpone duration %q", option), http.StatusBadRequest)
+			return
+		}
+		msg, err = h.ScheduleService.PostponeBy(userID, msgID, by)
+	case constants.ReminderActionCancel:
+		msg, err = h.Reminders.Cancel(userID, msgID)
+	default:
+		http.Error(w, fmt.Sprintf("unknown action %q", action), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		h.logger.Warn("Failed to apply reminder action", "user_id", userID, "message_id", msgID, "action", action, "error", err)
+		text := fmt.Sprintf("%s Could not update message: %v", constants.EmojiError, err)
+		switch {
+		case errors.Is(err, types.ErrMessageNotFound):
+			text = fmt.Sprintf("%s That message no longer exists. It may already have been sent or cancelled.", constants.EmojiError)
+		case errors.Is(err, types.ErrMessageChanged):
+			text = fmt.Sprintf("%s That message changed while it was being updated. Please try again.", constants.EmojiError)
+		}
+		h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{EphemeralText: text})
+		return
+	}
+
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	channelLink := h.Channel.MakeChannelLink(h.Channel.GetInfoOrUnknown(msg.ChannelID))
+	h.writeJSON(w, http.StatusOK, &model.PostActionIntegrationResponse{
+		Update: &model.Post{Message: formatter.FormatReminderResolved(action, msg.PostAt.In(loc), loc.String(), channelLink)},
+	})
+}
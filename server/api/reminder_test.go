@@ -0,0 +1,125 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupReminderHandler(t *testing.T) (*Handler, *mock.MockReminderService, *mock.MockChannelService) {
+	t.Helper()
+	h, remindersMock, _, channelMock := setupReminderHandlerWithSchedule(t)
+	return h, remindersMock, channelMock
+}
+
+func setupReminderHandlerWithSchedule(t *testing.T) (*Handler, *mock.MockReminderService, *mock.MockScheduleService, *mock.MockChannelService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	remindersMock := mock.NewMockReminderService(ctrl)
+	scheduleMock := mock.NewMockScheduleService(ctrl)
+	channelMock := mock.NewMockChannelService(ctrl)
+	h := &Handler{logger: &testutil.FakeLogger{}, Reminders: remindersMock, ScheduleService: scheduleMock, Channel: channelMock}
+	return h, remindersMock, scheduleMock, channelMock
+}
+
+func reminderRequest(context string) *http.Request {
+	body := `{"user_id": "u1", "context": ` + context + `}`
+	r := httptest.NewRequest(http.MethodPost, "/api/v1"+constants.ReminderPath, strings.NewReader(body))
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "u1")
+	return r
+}
+
+func TestServeHTTP_ReminderAction_Postpone(t *testing.T) {
+	h, _, scheduleMock, channelMock := setupReminderHandlerWithSchedule(t)
+	postAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	scheduleMock.EXPECT().PostponeBy("u1", "m1", time.Hour).Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c1", PostAt: postAt, Timezone: "UTC"}, nil)
+	info := &ports.ChannelInfo{ChannelID: "c1"}
+	channelMock.EXPECT().GetInfoOrUnknown("c1").Return(info)
+	channelMock.EXPECT().MakeChannelLink(info).Return("in channel: ~c1")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, reminderRequest(`{"action": "postpone", "id": "m1", "selected_option": "1h"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "Postponed")
+	assert.Contains(t, resp.Update.Message, postAt.Format(constants.TimeLayout))
+}
+
+func TestServeHTTP_ReminderAction_PostponeAfterSent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		want string
+	}{
+		"taken for sending": {types.ErrMessageNotFound, "no longer exists"},
+		"changed meanwhile": {types.ErrMessageChanged, "try again"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			h, _, scheduleMock, _ := setupReminderHandlerWithSchedule(t)
+			scheduleMock.EXPECT().PostponeBy("u1", "m1", time.Hour).Return(nil, tc.err)
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(nil, rr, reminderRequest(`{"action": "postpone", "id": "m1", "selected_option": "1h"}`))
+
+			resp := decodeActionResponse(t, rr)
+			assert.Nil(t, resp.Update)
+			assert.Contains(t, resp.EphemeralText, tc.want)
+		})
+	}
+}
+
+func TestServeHTTP_ReminderAction_SendNow(t *testing.T) {
+	h, remindersMock, channelMock := setupReminderHandler(t)
+	remindersMock.EXPECT().SendNow("u1", "m1").Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c1", Timezone: "UTC"}, nil)
+	channelMock.EXPECT().GetInfoOrUnknown("c1").Return(&ports.ChannelInfo{})
+	channelMock.EXPECT().MakeChannelLink(gomock.Any()).Return("in channel: ~c1")
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, reminderRequest(`{"action": "send", "id": "m1"}`))
+
+	resp := decodeActionResponse(t, rr)
+	require.NotNil(t, resp.Update)
+	assert.Contains(t, resp.Update.Message, "Sent your message")
+}
+
+func TestServeHTTP_ReminderAction_PausedIsEphemeral(t *testing.T) {
+	h, remindersMock, _ := setupReminderHandler(t)
+	remindersMock.EXPECT().SendNow("u1", "m1").Return(nil, types.ErrDeliveryPaused)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, reminderRequest(`{"action": "send", "id": "m1"}`))
+
+	resp := decodeActionResponse(t, rr)
+	assert.Nil(t, resp.Update)
+	assert.Contains(t, resp.EphemeralText, types.ErrDeliveryPaused.Error())
+}
+
+func TestServeHTTP_ReminderAction_BadRequest(t *testing.T) {
+	for name, context := range map[string]string{
+		"missing id":        `{"action": "send"}`,
+		"unknown action":    `{"action": "snooze", "id": "m1"}`,
+		"bad duration":      `{"action": "postpone", "id": "m1", "selected_option": "soon"}`,
+		"negative duration": `{"action": "postpone", "id": "m1", "selected_option": "-1h"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			h, _, _ := setupReminderHandler(t)
+			rr := httptest.NewRecorder()
+
+			h.ServeHTTP(nil, rr, reminderRequest(context))
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+		})
+	}
+}
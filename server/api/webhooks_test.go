@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupWebhooksHandler(t *testing.T, isAdmin bool) (*Handler, *mock.MockWebhookDeliveryStore) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	userMock := mock.NewMockUserService(ctrl)
+	userMock.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(isAdmin)
+	deliveries := mock.NewMockWebhookDeliveryStore(ctrl)
+	return &Handler{logger: &testutil.FakeLogger{}, user: userMock, Webhooks: deliveries}, deliveries
+}
+
+func webhookDeliveriesRequest() *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/admin/webhooks/deliveries", nil)
+	r.Header.Set(constants.HTTPHeaderMattermostUserID, "admin")
+	return r
+}
+
+func TestServeHTTP_WebhookDeliveries_Forbidden(t *testing.T) {
+	h, _ := setupWebhooksHandler(t, false)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, webhookDeliveriesRequest())
+
+	assert.Equal(t, http.StatusForbidden, rr.Code)
+}
+
+func TestServeHTTP_WebhookDeliveries_Success(t *testing.T) {
+	h, deliveries := setupWebhooksHandler(t, true)
+	deliveries.EXPECT().ListDeliveries().Return([]*types.WebhookDelivery{{ID: "d1", Event: types.EventSent, Success: true}}, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, webhookDeliveriesRequest())
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "application/json", rr.Header().Get("Content-Type"))
+	var got []*types.WebhookDelivery
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+	require.Len(t, got, 1)
+	assert.Equal(t, "d1", got[0].ID)
+}
+
+func TestServeHTTP_WebhookDeliveries_Empty(t *testing.T) {
+	h, deliveries := setupWebhooksHandler(t, true)
+	deliveries.EXPECT().ListDeliveries().Return(nil, nil)
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, webhookDeliveriesRequest())
+
+	assert.Equal(t, "[]\n", rr.Body.String())
+}
+
+func TestServeHTTP_WebhookDeliveries_StoreError(t *testing.T) {
+	h, deliveries := setupWebhooksHandler(t, true)
+	deliveries.EXPECT().ListDeliveries().Return(nil, errors.New("kv down"))
+	rr := httptest.NewRecorder()
+
+	h.ServeHTTP(nil, rr, webhookDeliveriesRequest())
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -0,0 +1,104 @@
+package audit
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Log persists every lifecycle event as an audit entry. Entries are written
+// synchronously so a recorded action always has its audit trail; expired
+// entries are pruned in the background at most once per AuditPruneInterval.
+type Log struct {
+	logger    ports.Logger
+	store     ports.AuditStore
+	clock     ports.Clock
+	wg        sync.WaitGroup
+	mu        sync.Mutex
+	retention time.Duration
+	lastPrune time.Time
+	pruning   bool
+}
+
+func New(logger ports.Logger, store ports.AuditStore, clk ports.Clock, retentionDays int) *Log {
+	logger.Debug("Creating new audit Log", "retention_days", retentionDays)
+	return &Log{
+		logger:    logger,
+		store:     store,
+		clock:     clk,
+		retention: retentionFor(retentionDays),
+	}
+}
+
+// Configure changes how long entries are kept. Zero keeps them forever.
+func (l *Log) Configure(retentionDays int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.retention = retentionFor(retentionDays)
+	l.lastPrune = time.Time{}
+	l.logger.Debug("Audit log configured", "retention_days", retentionDays)
+}
+
+func (l *Log) Notify(event *types.LifecycleEvent) {
+	now := l.clock.Now().UTC()
+	if event.Timestamp.IsZero() {
+		event.Timestamp = now
+	}
+	entry := types.NewAuditEntry(uuid.NewString(), event)
+	if err := l.store.SaveAuditEntry(entry); err != nil {
+		l.logger.Error("Failed to record audit entry", "action", event.Type, "message_id", event.MessageID, "error", err)
+	}
+	l.pruneIfDue(now)
+}
+
+// Query returns matching entries newest first, applying the default limit
+// when none is given.
+func (l *Log) Query(query *types.AuditQuery) ([]*types.AuditEntry, error) {
+	if query.Limit <= 0 {
+		query.Limit = constants.DefaultAuditQueryLimit
+	}
+	l.logger.Debug("Querying audit log", "action", query.Action, "limit", query.Limit)
+	return l.store.ListAuditEntries(query)
+}
+
+// Close waits for a running prune to finish.
+func (l *Log) Close() {
+	l.wg.Wait()
+}
+
+func (l *Log) pruneIfDue(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.retention == 0 || l.pruning || now.Sub(l.lastPrune) < constants.AuditPruneInterval {
+		return
+	}
+	l.pruning = true
+	l.lastPrune = now
+	cutoff := now.Add(-l.retention)
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		deleted, err := l.store.DeleteAuditEntriesBefore(cutoff)
+		if err != nil {
+			l.logger.Error("Failed to prune audit log", "cutoff", cutoff, "error", err)
+		} else if deleted > 0 {
+			l.logger.Info("Pruned expired audit entries", "cutoff", cutoff, "deleted", deleted)
+		}
+		l.mu.Lock()
+		l.pruning = false
+		l.mu.Unlock()
+	}()
+}
+
+func retentionFor(days int) time.Duration {
+	if days <= 0 {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func sampleEvent(eventType types.EventType) *types.LifecycleEvent {
+	return &types.LifecycleEvent{
+		Type:      eventType,
+		ActorID:   "actor",
+		MessageID: "m1",
+		UserID:    "u1",
+		ChannelID: "c1",
+		PostAt:    testNow.Add(time.Hour),
+	}
+}
+
+func TestNotify_RecordsEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockAuditStore(ctrl)
+	log := New(testutil.FakeLogger{}, store, &testutil.FakeClock{NowTime: testNow}, 0)
+
+	var saved *types.AuditEntry
+	store.EXPECT().SaveAuditEntry(gomock.Any()).DoAndReturn(func(e *types.AuditEntry) error {
+		saved = e
+		return nil
+	})
+
+	log.Notify(sampleEvent(types.EventScheduled))
+	log.Close()
+
+	require.NotNil(t, saved)
+	assert.NotEmpty(t, saved.ID)
+	assert.Equal(t, testNow, saved.Timestamp)
+	assert.Equal(t, "actor", saved.ActorID)
+	assert.Equal(t, types.EventScheduled, saved.Action)
+	assert.Equal(t, "c1", saved.ChannelID)
+	assert.Equal(t, types.AuditSuccess, saved.Outcome)
+}
+
+func TestNotify_FailedEventIsFailureOutcome(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockAuditStore(ctrl)
+	log := New(testutil.FakeLogger{}, store, &testutil.FakeClock{NowTime: testNow}, 0)
+
+	event := sampleEvent(types.EventFailed)
+	event.Error = "channel archived"
+	store.EXPECT().SaveAuditEntry(gomock.Any()).DoAndReturn(func(e *types.AuditEntry) error {
+		assert.Equal(t, types.AuditFailure, e.Outcome)
+		assert.Equal(t, "channel archived", e.Error)
+		return nil
+	})
+
+	log.Notify(event)
+	log.Close()
+}
+
+func TestNotify_SaveErrorIsLogged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockAuditStore(ctrl)
+	log := New(testutil.FakeLogger{}, store, &testutil.FakeClock{NowTime: testNow}, 0)
+
+	store.EXPECT().SaveAuditEntry(gomock.Any()).Return(errors.New("boom"))
+
+	log.Notify(sampleEvent(types.EventSent))
+	log.Close()
+}
+
+func TestNotify_PrunesOncePerInterval(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockAuditStore(ctrl)
+	clk := &testutil.FakeClock{NowTime: testNow}
+	log := New(testutil.FakeLogger{}, store, clk, 30)
+
+	store.EXPECT().SaveAuditEntry(gomock.Any()).Return(nil).Times(3)
+	store.EXPECT().DeleteAuditEntriesBefore(testNow.Add(-30*24*time.Hour)).Return(2, nil)
+	later := testNow.Add(constants.AuditPruneInterval)
+	store.EXPECT().DeleteAuditEntriesBefore(later.Add(-30*24*time.Hour)).Return(0, nil)
+
+	log.Notify(sampleEvent(types.EventScheduled))
+	log.Close()
+	log.Notify(sampleEvent(types.EventEdited))
+	log.Close()
+	clk.NowTime = later
+	log.Notify(sampleEvent(types.EventSent))
+	log.Close()
+}
+
+func TestQuery_AppliesDefaultLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockAuditStore(ctrl)
+	log := New(testutil.FakeLogger{}, store, &testutil.FakeClock{NowTime: testNow}, 0)
+
+	want := []*types.AuditEntry{{ID: "a1"}}
+	store.EXPECT().ListAuditEntries(&types.AuditQuery{UserID: "u1", Limit: constants.DefaultAuditQueryLimit}).Return(want, nil)
+
+	got, err := log.Query(&types.AuditQuery{UserID: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
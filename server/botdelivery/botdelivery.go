@@ -0,0 +1,71 @@
+package botdelivery
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service posts messages as the bot on their owner's behalf. The bot is
+// joined to the channel if needed, the owner is named at the end of the
+// message and the owner's files are copied to the bot so they stay attached.
+type Service struct {
+	logger  ports.Logger
+	channel ports.ChannelService
+	users   ports.UserService
+	files   ports.FileService
+	botID   string
+}
+
+func New(
+	logger ports.Logger,
+	channel ports.ChannelService,
+	users ports.UserService,
+	files ports.FileService,
+	botID string,
+) *Service {
+	logger.Debug("Creating new bot delivery Service")
+	return &Service{
+		logger:  logger,
+		channel: channel,
+		users:   users,
+		files:   files,
+		botID:   botID,
+	}
+}
+
+// Prepare rewrites post to come from the bot. The bot cannot join direct or
+// group messages, so a message that was moved into one after it was scheduled
+// is left to be posted as its owner.
+func (s *Service) Prepare(msg *types.ScheduledMessage, post *model.Post) error {
+	s.logger.Debug("Preparing message to be posted as the bot", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID)
+	info := s.channel.GetInfoOrUnknown(msg.ChannelID)
+	if info.ChannelType == model.ChannelTypeDirect || info.ChannelType == model.ChannelTypeGroup {
+		s.logger.Info("Posting message as its owner because the bot cannot post in direct or group messages", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID)
+		return nil
+	}
+	owner, err := s.users.Get(msg.UserID)
+	if err != nil {
+		s.logger.Error("Failed to get owner of message posted as the bot", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+		return fmt.Errorf("failed to get message owner: %w", err)
+	}
+	if err := s.channel.EnsureMember(msg.ChannelID, s.botID); err != nil {
+		return fmt.Errorf("the bot could not join the channel: %w", err)
+	}
+	if len(post.FileIds) > 0 {
+		fileIDs, err := s.files.CopyInfos(post.FileIds, s.botID)
+		if err != nil {
+			s.logger.Error("Failed to copy files for the bot", "message_id", msg.ID, "user_id", msg.UserID, "file_ids", post.FileIds, "error", err)
+			return fmt.Errorf("failed to attach files: %w", err)
+		}
+		post.FileIds = fileIDs
+	}
+	post.UserId = s.botID
+	post.Message = formatter.FormatBotAttribution(post.Message, owner.Username)
+	s.logger.Debug("Prepared message to be posted as the bot", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID)
+	return nil
+}
@@ -0,0 +1,79 @@
+package botdelivery
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type testMocks struct {
+	channel *mock.MockChannelService
+	users   *mock.MockUserService
+	files   *mock.MockFileService
+}
+
+func setup(t *testing.T) (*Service, *testMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		channel: mock.NewMockChannelService(ctrl),
+		users:   mock.NewMockUserService(ctrl),
+		files:   mock.NewMockFileService(ctrl),
+	}
+	return New(testutil.FakeLogger{}, m.channel, m.users, m.files, "bot"), m
+}
+
+func testMessage() (*types.ScheduledMessage, *model.Post) {
+	msg := &types.ScheduledMessage{ID: "msg1", UserID: "u1", ChannelID: "chan", MessageContent: "Release notes", AsBot: true}
+	post := &model.Post{ChannelId: "chan", UserId: "u1", Message: "Release notes", FileIds: []string{"f1"}}
+	return msg, post
+}
+
+func TestPrepare(t *testing.T) {
+	s, m := setup(t)
+	msg, post := testMessage()
+	m.channel.EXPECT().GetInfoOrUnknown("chan").Return(&ports.ChannelInfo{ChannelID: "chan", ChannelType: model.ChannelTypeOpen})
+	m.users.EXPECT().Get("u1").Return(&model.User{Id: "u1", Username: "alice"}, nil)
+	m.channel.EXPECT().EnsureMember("chan", "bot").Return(nil)
+	m.files.EXPECT().CopyInfos([]string{"f1"}, "bot").Return([]string{"f2"}, nil)
+
+	require.NoError(t, s.Prepare(msg, post))
+
+	assert.Equal(t, "bot", post.UserId)
+	assert.Equal(t, "Release notes\n\n_Posted on behalf of @alice_", post.Message)
+	assert.Equal(t, model.StringArray{"f2"}, post.FileIds)
+}
+
+func TestPrepare_DirectMessagePostsAsOwner(t *testing.T) {
+	s, m := setup(t)
+	msg, post := testMessage()
+	m.channel.EXPECT().GetInfoOrUnknown("chan").Return(&ports.ChannelInfo{ChannelID: "chan", ChannelType: model.ChannelTypeDirect})
+
+	require.NoError(t, s.Prepare(msg, post))
+
+	assert.Equal(t, "u1", post.UserId)
+	assert.Equal(t, "Release notes", post.Message)
+}
+
+func TestPrepare_BotCannotJoin(t *testing.T) {
+	s, m := setup(t)
+	msg, post := testMessage()
+	m.channel.EXPECT().GetInfoOrUnknown("chan").Return(&ports.ChannelInfo{ChannelID: "chan", ChannelType: model.ChannelTypePrivate})
+	m.users.EXPECT().Get("u1").Return(&model.User{Id: "u1", Username: "alice"}, nil)
+	m.channel.EXPECT().EnsureMember("chan", "bot").Return(errors.New("boom"))
+
+	err := s.Prepare(msg, post)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "the bot could not join the channel")
+	assert.Equal(t, "u1", post.UserId)
+}
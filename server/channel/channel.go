@@ -116,6 +116,34 @@ func (c *Channel) MakeChannelLink(channelInfo *ports.ChannelInfo) string {
 	return fmt.Sprintf("in channel: %s", channelInfo.ChannelLink)
 }
 
+// EnsureMember adds userID to the channel, and to its team first, unless they
+// are already a member. Members cannot be added to direct or group messages.
+func (c *Channel) EnsureMember(channelID, userID string) error {
+	c.logger.Debug("Ensuring user is a channel member", "channel_id", channelID, "user_id", userID)
+	if _, err := c.channelAPI.GetMember(channelID, userID); err == nil {
+		c.logger.Debug("User is already a channel member", "channel_id", channelID, "user_id", userID)
+		return nil
+	}
+	channel, err := c.channelAPI.Get(channelID)
+	if err != nil {
+		c.logger.Error("Failed to get channel from API", "channel_id", channelID, "error", err)
+		return fmt.Errorf("failed to get channel %s: %w", channelID, err)
+	}
+	if channel.Type == model.ChannelTypeDirect || channel.Type == model.ChannelTypeGroup {
+		return fmt.Errorf("cannot add members to direct or group message %s", channelID)
+	}
+	if _, err := c.teamAPI.CreateMember(channel.TeamId, userID); err != nil {
+		c.logger.Error("Failed to add user to team", "team_id", channel.TeamId, "user_id", userID, "error", err)
+		return fmt.Errorf("failed to join team %s: %w", channel.TeamId, err)
+	}
+	if _, err := c.channelAPI.AddMember(channelID, userID); err != nil {
+		c.logger.Error("Failed to add user to channel", "channel_id", channelID, "user_id", userID, "error", err)
+		return fmt.Errorf("failed to join channel %s: %w", channelID, err)
+	}
+	c.logger.Info("Added user to channel", "channel_id", channelID, "user_id", userID)
+	return nil
+}
+
 func (c *Channel) mapMembersToUsernames(members []*model.ChannelMember) ([]string, error) {
 	c.logger.Debug("Mapping channel members to usernames", "member_count", len(members))
 	var usernames []string
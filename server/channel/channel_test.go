@@ -387,3 +387,58 @@ func TestMakeChannelLink(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsureMember(t *testing.T) {
+	t.Run("already a member", func(t *testing.T) {
+		ch, chData, _, _, ctrl := newTestChannel(t)
+		defer ctrl.Finish()
+
+		chData.EXPECT().GetMember("chan", "bot").Return(&model.ChannelMember{ChannelId: "chan", UserId: "bot"}, nil)
+
+		if err := ch.EnsureMember("chan", "bot"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("joins team then channel", func(t *testing.T) {
+		ch, chData, teamSvc, _, ctrl := newTestChannel(t)
+		defer ctrl.Finish()
+
+		chData.EXPECT().GetMember("chan", "bot").Return(nil, errors.New("not found"))
+		chData.EXPECT().Get("chan").Return(&model.Channel{Id: "chan", Type: model.ChannelTypePrivate, TeamId: "team"}, nil)
+		gomock.InOrder(
+			teamSvc.EXPECT().CreateMember("team", "bot").Return(&model.TeamMember{}, nil),
+			chData.EXPECT().AddMember("chan", "bot").Return(&model.ChannelMember{}, nil),
+		)
+
+		if err := ch.EnsureMember("chan", "bot"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("direct message cannot be joined", func(t *testing.T) {
+		ch, chData, _, _, ctrl := newTestChannel(t)
+		defer ctrl.Finish()
+
+		chData.EXPECT().GetMember("dm", "bot").Return(nil, errors.New("not found"))
+		chData.EXPECT().Get("dm").Return(&model.Channel{Id: "dm", Type: model.ChannelTypeDirect}, nil)
+
+		if err := ch.EnsureMember("dm", "bot"); err == nil {
+			t.Fatal("expected an error for a direct message")
+		}
+	})
+
+	t.Run("add member failure", func(t *testing.T) {
+		ch, chData, teamSvc, _, ctrl := newTestChannel(t)
+		defer ctrl.Finish()
+
+		chData.EXPECT().GetMember("chan", "bot").Return(nil, errors.New("not found"))
+		chData.EXPECT().Get("chan").Return(&model.Channel{Id: "chan", Type: model.ChannelTypeOpen, TeamId: "team"}, nil)
+		teamSvc.EXPECT().CreateMember("team", "bot").Return(&model.TeamMember{}, nil)
+		chData.EXPECT().AddMember("chan", "bot").Return(nil, errors.New("boom"))
+
+		if err := ch.EnsureMember("chan", "bot"); err == nil {
+			t.Fatal("expected an error when the channel cannot be joined")
+		}
+	})
+}
@@ -0,0 +1,200 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func (h *Handler) handleAdmin(args *model.CommandArgs, text string) *model.CommandResponse {
+	if !h.user.HasPermissionTo(args.UserId, model.PermissionManageSystem) {
+		h.logger.Warn("Non-admin attempted to use admin subcommand", "user_id", args.UserId)
+		return errorResponse(fmt.Sprintf("%s %s", constants.EmojiError, constants.AdminPermissionDenied))
+	}
+	fields := strings.Fields(text)
+	switch {
+	case len(fields) >= 1 && strings.EqualFold(fields[0], constants.AdminList):
+		return h.adminList(args, fields[1:])
+	case len(fields) == 2 && strings.EqualFold(fields[0], constants.AdminCancel):
+		return h.adminCancel(args.UserId, fields[1])
+	case len(fields) == 1 && strings.EqualFold(fields[0], constants.AdminStatus):
+		return h.adminStatus(args.UserId)
+	case len(fields) >= 1 && len(fields) <= 3 && strings.EqualFold(fields[0], constants.AdminPause):
+		return h.adminPause(args.UserId, fields[1:])
+	case len(fields) >= 1 && len(fields) <= 2 && strings.EqualFold(fields[0], constants.AdminResume):
+		return h.adminResume(args.UserId, fields[1:])
+	default:
+		h.logger.Debug("Unknown admin subcommand", "user_id", args.UserId, "text", text)
+		return errorResponse(formatter.FormatAdminUsage())
+	}
+}
+
+func (h *Handler) adminList(args *model.CommandArgs, filters []string) *model.CommandResponse {
+	var userID, channelID string
+	for _, field := range filters {
+		switch {
+		case strings.HasPrefix(field, "@"):
+			user, err := h.user.GetByUsername(strings.TrimPrefix(field, "@"))
+			if err != nil || user == nil {
+				h.logger.Debug("Unknown user in admin list filter", "user_id", args.UserId, "filter", field, "error", err)
+				return errorResponse(formatter.FormatListFilterError(fmt.Errorf(constants.AdminUnknownUserMessage, field)))
+			}
+			userID = user.Id
+		case strings.HasPrefix(field, "~"):
+			id, ok := args.ChannelMentions[strings.TrimPrefix(field, "~")]
+			if !ok {
+				return errorResponse(formatter.FormatListFilterError(fmt.Errorf("unknown channel %s", field)))
+			}
+			channelID = id
+		default:
+			return errorResponse(formatter.FormatAdminUsage())
+		}
+	}
+
+	h.logger.Debug("Admin listing scheduled messages", "user_id", args.UserId, "filter_user_id", userID, "filter_channel_id", channelID)
+	msgs, err := h.admin.ListMessages(userID, channelID)
+	if err != nil {
+		h.logger.Error("Failed to list scheduled messages for admin", "user_id", args.UserId, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not list scheduled messages: %v", constants.EmojiError, err))
+	}
+	if len(msgs) == 0 {
+		return errorResponse(constants.AdminEmptyListMessage)
+	}
+
+	shown := msgs
+	if len(shown) > constants.AdminListMaxEntries {
+		shown = shown[:constants.AdminListMaxEntries]
+	}
+	usernames := map[string]string{}
+	lines := []string{constants.AdminListHeader}
+	for _, msg := range shown {
+		loc, locErr := time.LoadLocation(msg.Timezone)
+		if locErr != nil {
+			loc = time.UTC
+		}
+		channelLink := h.channel.MakeChannelLink(h.channel.GetInfoOrUnknown(msg.ChannelID))
+		lines = append(lines, formatter.FormatAdminListEntry(msg.ID, msg.PostAt.In(loc), loc.String(), h.username(usernames, msg.UserID), channelLink, msg.MessageContent))
+	}
+	if len(shown) < len(msgs) {
+		lines = append(lines, "", formatter.FormatAdminListTruncated(len(shown), len(msgs)))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         strings.Join(lines, "\n"),
+	}
+}
+
+func (h *Handler) adminCancel(adminID string, msgID string) *model.CommandResponse {
+	h.logger.Debug("Admin cancelling scheduled message", "user_id", adminID, "message_id", msgID)
+	msg, err := h.admin.CancelMessage(adminID, msgID)
+	if errors.Is(err, types.ErrMessageNotFound) {
+		return errorResponse(fmt.Sprintf("%s No scheduled message with ID `%s`. It may already have been sent.", constants.EmojiError, msgID))
+	}
+	if err != nil {
+		h.logger.Error("Admin failed to cancel scheduled message", "user_id", adminID, "message_id", msgID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not cancel message: %v", constants.EmojiError, err))
+	}
+	loc, locErr := time.LoadLocation(msg.Timezone)
+	if locErr != nil {
+		loc = time.UTC
+	}
+	channelLink := h.channel.MakeChannelLink(h.channel.GetInfoOrUnknown(msg.ChannelID))
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatAdminCancelled(msg.ID, h.username(map[string]string{}, msg.UserID), msg.PostAt.In(loc), loc.String(), channelLink),
+	}
+}
+
+func (h *Handler) adminStatus(adminID string) *model.CommandResponse {
+	h.logger.Debug("Admin requested scheduler status", "user_id", adminID)
+	status, err := h.admin.Status()
+	if err != nil {
+		h.logger.Error("Failed to build scheduler status for admin", "user_id", adminID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not build scheduler status: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatSchedulerStatus(status),
+	}
+}
+
+// adminPause pauses delivery. The optional arguments are a duration after
+// which delivery resumes on its own and the resume mode to apply then.
+func (h *Handler) adminPause(adminID string, opts []string) *model.CommandResponse {
+	var resumeAt *time.Time
+	mode := types.ResumeSend
+	for _, opt := range opts {
+		if d, err := time.ParseDuration(opt); err == nil && resumeAt == nil {
+			if d <= 0 {
+				return errorResponse(fmt.Sprintf("%s The pause duration must be positive.", constants.EmojiError))
+			}
+			at := h.clock.Now().UTC().Add(d)
+			resumeAt = &at
+			continue
+		}
+		parsed, err := types.ParseResumeMode(opt)
+		if err != nil {
+			return errorResponse(formatter.FormatAdminUsage())
+		}
+		mode = parsed
+	}
+
+	h.logger.Debug("Admin pausing delivery", "user_id", adminID, "resume_at", resumeAt, "resume_mode", mode)
+	state, err := h.admin.PauseDelivery(adminID, resumeAt, mode)
+	if err != nil {
+		h.logger.Error("Failed to pause delivery", "user_id", adminID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not pause delivery: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatDeliveryPaused(state),
+	}
+}
+
+func (h *Handler) adminResume(adminID string, opts []string) *model.CommandResponse {
+	mode := types.ResumeSend
+	if len(opts) == 1 {
+		parsed, err := types.ParseResumeMode(opts[0])
+		if err != nil {
+			return errorResponse(formatter.FormatAdminUsage())
+		}
+		mode = parsed
+	}
+
+	h.logger.Debug("Admin resuming delivery", "user_id", adminID, "mode", mode)
+	result, err := h.admin.ResumeDelivery(adminID, mode)
+	if errors.Is(err, types.ErrDeliveryNotPaused) {
+		return errorResponse(constants.AdminNotPausedMessage)
+	}
+	if err != nil {
+		h.logger.Error("Failed to resume delivery", "user_id", adminID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not resume delivery: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatDeliveryResumed(result),
+	}
+}
+
+// username resolves userID to a username, memoizing lookups in cache and
+// falling back to the ID when the user cannot be loaded.
+func (h *Handler) username(cache map[string]string, userID string) string {
+	if name, ok := cache[userID]; ok {
+		return name
+	}
+	name := userID
+	if user, err := h.user.Get(userID); err == nil && user != nil {
+		name = user.Username
+	} else {
+		h.logger.Warn("Failed to look up scheduled message owner", "user_id", userID, "error", err)
+	}
+	cache[userID] = name
+	return name
+}
@@ -0,0 +1,235 @@
+package command_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func adminArgs(text string) *model.CommandArgs {
+	return &model.CommandArgs{
+		UserId:    "adminID",
+		ChannelId: "testChannelID",
+		Command:   "/" + constants.CommandTrigger + " " + constants.SubcommandAdmin + text,
+	}
+}
+
+func expectAdmin(mocks *testMocks, isAdmin bool) {
+	mocks.user.EXPECT().HasPermissionTo("adminID", model.PermissionManageSystem).Return(isAdmin)
+}
+
+func expectChannelLink(mocks *testMocks) {
+	info := &ports.ChannelInfo{ChannelID: "c1"}
+	mocks.channel.EXPECT().GetInfoOrUnknown(gomock.Any()).Return(info).AnyTimes()
+	mocks.channel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square").AnyTimes()
+}
+
+func TestExecute_Admin_RequiresManageSystem(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, false)
+
+	resp, appErr := handler.Execute(adminArgs(" list"))
+
+	require.Nil(t, appErr)
+	assert.Contains(t, resp.Text, constants.AdminPermissionDenied)
+}
+
+func TestExecute_Admin_Usage(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+
+	resp, _ := handler.Execute(adminArgs(" frobnicate"))
+
+	assert.Contains(t, resp.Text, "Usage:")
+}
+
+func TestExecute_Admin_ListWithFilters(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	expectChannelLink(mocks)
+	args := adminArgs(" list @alice ~town-square")
+	args.AddChannelMention("town-square", "c1")
+	postAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	mocks.user.EXPECT().GetByUsername("alice").Return(&model.User{Id: "aliceID", Username: "alice"}, nil)
+	mocks.admin.EXPECT().ListMessages("aliceID", "c1").Return([]*types.ScheduledMessage{
+		{ID: "m1", UserID: "aliceID", ChannelID: "c1", PostAt: postAt, Timezone: "UTC", MessageContent: "first"},
+		{ID: "m2", UserID: "aliceID", ChannelID: "c1", PostAt: postAt, Timezone: "UTC", MessageContent: "second"},
+	}, nil)
+	mocks.user.EXPECT().Get("aliceID").Return(&model.User{Id: "aliceID", Username: "alice"}, nil).Times(1)
+
+	resp, _ := handler.Execute(args)
+
+	assert.Contains(t, resp.Text, constants.AdminListHeader)
+	assert.Contains(t, resp.Text, "`m1`")
+	assert.Contains(t, resp.Text, "`m2`")
+	assert.Contains(t, resp.Text, "@alice")
+}
+
+func TestExecute_Admin_ListUnknownUser(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.user.EXPECT().GetByUsername("ghost").Return(nil, errors.New("not found"))
+
+	resp, _ := handler.Execute(adminArgs(" list @ghost"))
+
+	assert.Contains(t, resp.Text, "unknown user @ghost")
+}
+
+func TestExecute_Admin_ListEmpty(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().ListMessages("", "").Return(nil, nil)
+
+	resp, _ := handler.Execute(adminArgs(" list"))
+
+	assert.Equal(t, constants.AdminEmptyListMessage, resp.Text)
+}
+
+func TestExecute_Admin_ListTruncates(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	expectChannelLink(mocks)
+	msgs := make([]*types.ScheduledMessage, constants.AdminListMaxEntries+5)
+	for i := range msgs {
+		msgs[i] = &types.ScheduledMessage{ID: fmt.Sprintf("m%d", i), UserID: "u1", Timezone: "UTC"}
+	}
+	mocks.admin.EXPECT().ListMessages("", "").Return(msgs, nil)
+	mocks.user.EXPECT().Get("u1").Return(&model.User{Username: "bob"}, nil)
+
+	resp, _ := handler.Execute(adminArgs(" list"))
+
+	assert.Contains(t, resp.Text, fmt.Sprintf("Showing %d of %d", constants.AdminListMaxEntries, len(msgs)))
+	assert.NotContains(t, resp.Text, fmt.Sprintf("`m%d`", constants.AdminListMaxEntries))
+}
+
+func TestExecute_Admin_Cancel(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	expectChannelLink(mocks)
+	mocks.admin.EXPECT().CancelMessage("adminID", "m1").Return(&types.ScheduledMessage{ID: "m1", UserID: "u1", ChannelID: "c1", Timezone: "UTC"}, nil)
+	mocks.user.EXPECT().Get("u1").Return(&model.User{Username: "bob"}, nil)
+
+	resp, _ := handler.Execute(adminArgs(" cancel m1"))
+
+	assert.Contains(t, resp.Text, "Cancelled message `m1` from @bob")
+}
+
+func TestExecute_Admin_CancelNotFound(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().CancelMessage("adminID", "gone").Return(nil, types.ErrMessageNotFound)
+
+	resp, _ := handler.Execute(adminArgs(" cancel gone"))
+
+	assert.Contains(t, resp.Text, "No scheduled message with ID `gone`")
+}
+
+func TestExecute_Admin_Status(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	tick := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	mocks.admin.EXPECT().Status().Return(&types.SchedulerStatus{
+		SchedulerHealth: types.SchedulerHealth{Running: true, LastTickAt: tick, LastTickDurationMS: 7},
+		Pending:         3,
+		Due:             1,
+		Overdue:         1,
+		OldestOverdue:   &types.OverdueMessage{ID: "late", PostAt: tick.Add(-time.Hour)},
+		StoreKeys:       map[string]int{"schedmsg": 3},
+	}, nil)
+
+	resp, _ := handler.Execute(adminArgs(" status"))
+
+	assert.Contains(t, resp.Text, constants.AdminStatusHeader)
+	assert.Contains(t, resp.Text, "**Pending:** 3 (due: 1, overdue: 1)")
+	assert.Contains(t, resp.Text, "`late`")
+}
+
+func TestExecute_Admin_StatusError(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().Status().Return(nil, errors.New("kv down"))
+
+	resp, _ := handler.Execute(adminArgs(" status"))
+
+	assert.Contains(t, resp.Text, "Could not build scheduler status: kv down")
+}
+
+func TestExecute_Admin_PauseWithDurationAndMode(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().PauseDelivery("adminID", gomock.Any(), types.ResumeSkip).DoAndReturn(func(_ string, resumeAt *time.Time, mode types.ResumeMode) (*types.PauseState, error) {
+		require.NotNil(t, resumeAt)
+		assert.Equal(t, mocks.clock.NowTime.Add(2*time.Hour), *resumeAt)
+		return &types.PauseState{PausedBy: "adminID", ResumeAt: resumeAt, ResumeMode: mode}, nil
+	})
+
+	resp, _ := handler.Execute(adminArgs(" pause 2h skip"))
+
+	assert.Contains(t, resp.Text, "is paused until")
+	assert.Contains(t, resp.Text, "skipped and returned to their owners")
+}
+
+func TestExecute_Admin_PauseIndefinitely(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().PauseDelivery("adminID", nil, types.ResumeSend).Return(&types.PauseState{PausedBy: "adminID"}, nil)
+
+	resp, _ := handler.Execute(adminArgs(" pause"))
+
+	assert.Contains(t, resp.Text, "admin resume")
+}
+
+func TestExecute_Admin_PauseBadArgument(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+
+	resp, _ := handler.Execute(adminArgs(" pause soon"))
+
+	assert.Contains(t, resp.Text, "Usage:")
+}
+
+func TestExecute_Admin_Resume(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().ResumeDelivery("adminID", types.ResumeSkip).Return(&types.ResumeResult{Mode: types.ResumeSkip, Skipped: 3}, nil)
+
+	resp, _ := handler.Execute(adminArgs(" resume skip"))
+
+	assert.Contains(t, resp.Text, "Skipped 3 overdue messages")
+}
+
+func TestExecute_Admin_ResumeNotPaused(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectAdmin(mocks, true)
+	mocks.admin.EXPECT().ResumeDelivery("adminID", types.ResumeSend).Return(nil, fmt.Errorf("failed to resume delivery: %w", types.ErrDeliveryNotPaused))
+
+	resp, _ := handler.Execute(adminArgs(" resume"))
+
+	assert.Equal(t, constants.AdminNotPausedMessage, resp.Text)
+}
@@ -0,0 +1,25 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+)
+
+// handleDraft moves the user's next scheduled message in the current channel
+// back to their drafts, so it can be finished in the message box.
+func (h *Handler) handleDraft(args *model.CommandArgs) *model.CommandResponse {
+	msg, err := h.drafts.MoveNextToDraft(args.UserId, args.ChannelId)
+	if err != nil {
+		h.logger.Error("Failed to move scheduled message to drafts", "user_id", args.UserId, "channel_id", args.ChannelId, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not move your message to drafts: %v", constants.EmojiError, err))
+	}
+	if msg == nil {
+		return errorResponse(constants.DraftNoMessagesMessage)
+	}
+	channelLink := h.channel.MakeChannelLink(h.channel.GetInfoOrUnknown(msg.ChannelID))
+	return errorResponse(formatter.FormatMovedToDraft(channelLink, msg.MessageContent))
+}
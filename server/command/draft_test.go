@@ -0,0 +1,54 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func draftArgs() *model.CommandArgs {
+	return &model.CommandArgs{
+		UserId:    "testUserID",
+		ChannelId: "testChannelID",
+		Command:   "/" + constants.CommandTrigger + " " + constants.SubcommandDraft,
+	}
+}
+
+func TestExecute_Draft_MovesNextMessage(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectChannelLink(mocks)
+	mocks.drafts.EXPECT().MoveNextToDraft("testUserID", "testChannelID").Return(&types.ScheduledMessage{ID: "m1", ChannelID: "c1", MessageContent: "hello"}, nil)
+
+	resp, appErr := handler.Execute(draftArgs())
+	require.Nil(t, appErr)
+	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
+	assert.Contains(t, resp.Text, "Moved your scheduled message in channel: ~town-square to your drafts.")
+	assert.Contains(t, resp.Text, "```\nhello\n```")
+}
+
+func TestExecute_Draft_NothingScheduled(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	mocks.drafts.EXPECT().MoveNextToDraft("testUserID", "testChannelID").Return(nil, nil)
+
+	resp, appErr := handler.Execute(draftArgs())
+	require.Nil(t, appErr)
+	assert.Equal(t, constants.DraftNoMessagesMessage, resp.Text)
+}
+
+func TestExecute_Draft_Error(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	mocks.drafts.EXPECT().MoveNextToDraft("testUserID", "testChannelID").Return(nil, errors.New("kv down"))
+
+	resp, appErr := handler.Execute(draftArgs())
+	require.Nil(t, appErr)
+	assert.Contains(t, resp.Text, "Could not move your message to drafts: kv down")
+}
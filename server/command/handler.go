@@ -19,6 +19,15 @@ type Handler struct {
 	channel         ports.ChannelService
 	listService     ports.ListService
 	scheduleService ports.ScheduleService
+	feed            ports.FeedService
+	admin           ports.AdminService
+	policy          ports.PolicyService
+	history         ports.HistoryService
+	preferences     ports.PreferenceService
+	dialogs         ports.DialogService
+	events          ports.EventNotifier
+	drafts          ports.DraftService
+	clock           ports.Clock
 	helpText        string
 }
 
@@ -30,6 +39,15 @@ func NewHandler(
 	channel ports.ChannelService,
 	listSvc ports.ListService,
 	scheduleSvc ports.ScheduleService,
+	feed ports.FeedService,
+	admin ports.AdminService,
+	policy ports.PolicyService,
+	history ports.HistoryService,
+	preferences ports.PreferenceService,
+	dialogs ports.DialogService,
+	events ports.EventNotifier,
+	drafts ports.DraftService,
+	clk ports.Clock,
 	helpText string,
 ) *Handler {
 	logger.Debug("Creating new command Handler")
@@ -41,6 +59,15 @@ func NewHandler(
 		channel:         channel,
 		listService:     listSvc,
 		scheduleService: scheduleSvc,
+		feed:            feed,
+		admin:           admin,
+		policy:          policy,
+		history:         history,
+		preferences:     preferences,
+		dialogs:         dialogs,
+		events:          events,
+		drafts:          drafts,
+		clock:           clk,
 		helpText:        helpText,
 	}
 }
@@ -65,7 +92,22 @@ func (h *Handler) Execute(args *model.CommandArgs) (*model.CommandResponse, *mod
 		return h.scheduleHelp(), nil
 	case strings.HasPrefix(commandText, constants.SubcommandList):
 		h.logger.Debug("Handling list subcommand", "user_id", args.UserId)
-		return h.BuildEphemeralList(args), nil
+		return h.handleList(args, strings.TrimSpace(commandText[len(constants.SubcommandList):])), nil
+	case strings.HasPrefix(commandText, constants.SubcommandSettings):
+		h.logger.Debug("Handling settings subcommand", "user_id", args.UserId)
+		return h.handleSettings(args, strings.TrimSpace(commandText[len(constants.SubcommandSettings):])), nil
+	case strings.HasPrefix(commandText, constants.SubcommandAdmin):
+		h.logger.Debug("Handling admin subcommand", "user_id", args.UserId)
+		return h.handleAdmin(args, strings.TrimSpace(commandText[len(constants.SubcommandAdmin):])), nil
+	case strings.HasPrefix(commandText, constants.SubcommandHistory):
+		h.logger.Debug("Handling history subcommand", "user_id", args.UserId)
+		return h.handleHistory(args), nil
+	case strings.HasPrefix(commandText, constants.SubcommandPolicy):
+		h.logger.Debug("Handling policy subcommand", "user_id", args.UserId)
+		return h.handlePolicy(args, strings.TrimSpace(commandText[len(constants.SubcommandPolicy):])), nil
+	case strings.HasPrefix(commandText, constants.SubcommandDraft):
+		h.logger.Debug("Handling draft subcommand", "user_id", args.UserId)
+		return h.handleDraft(args), nil
 	default:
 		h.logger.Debug("Handling schedule subcommand", "user_id", args.UserId, "command_text", commandText)
 		return h.handleSchedule(args, commandText), nil
@@ -74,7 +116,7 @@ func (h *Handler) Execute(args *model.CommandArgs) (*model.CommandResponse, *mod
 
 func (h *Handler) BuildEphemeralList(args *model.CommandArgs) *model.CommandResponse {
 	h.logger.Debug("Building ephemeral list response", "user_id", args.UserId)
-	return h.listService.Build(args.UserId)
+	return h.listService.Build(&types.MessageQuery{UserID: args.UserId})
 }
 
 func (h *Handler) UserDeleteMessage(userID string, msgID string) (*types.ScheduledMessage, error) {
@@ -94,6 +136,7 @@ func (h *Handler) UserDeleteMessage(userID string, msgID string) (*types.Schedul
 		return nil, fmt.Errorf("failed to delete scheduled message %s: %w", msgID, err)
 	}
 	h.logger.Info("Successfully deleted scheduled message", "user_id", userID, "message_id", msgID)
+	h.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, userID))
 	return msg, nil
 }
 
@@ -121,6 +164,36 @@ func (h *Handler) getScheduleAutocompleteData() *model.AutocompleteData {
 	list := model.NewAutocompleteData(constants.SubcommandList, constants.AutocompleteListHint, constants.AutocompleteListDesc)
 	schedule.AddCommand(list)
 
+	history := model.NewAutocompleteData(constants.SubcommandHistory, constants.AutocompleteHistoryHint, constants.AutocompleteHistoryDesc)
+	schedule.AddCommand(history)
+
+	draft := model.NewAutocompleteData(constants.SubcommandDraft, constants.AutocompleteDraftHint, constants.AutocompleteDraftDesc)
+	schedule.AddCommand(draft)
+
+	settings := model.NewAutocompleteData(constants.SubcommandSettings, constants.AutocompleteSettingsHint, constants.AutocompleteSettingsDesc)
+	feed := model.NewAutocompleteData(constants.SettingsFeed, constants.AutocompleteFeedHint, constants.AutocompleteFeedDesc)
+	feed.AddCommand(model.NewAutocompleteData(constants.SettingsFeedRotate, constants.AutocompleteRotateHint, constants.AutocompleteRotateDesc))
+	settings.AddCommand(feed)
+	settings.AddCommand(model.NewAutocompleteData(constants.SettingsReminder, constants.AutocompleteReminderHint, constants.AutocompleteReminderDesc))
+	settings.AddCommand(model.NewAutocompleteData(constants.SettingsConfirmations, constants.AutocompleteConfirmHint, constants.AutocompleteConfirmDesc))
+	settings.AddCommand(model.NewAutocompleteData(constants.SettingsDigest, constants.AutocompleteDigestHint, constants.AutocompleteDigestDesc))
+	schedule.AddCommand(settings)
+
+	policy := model.NewAutocompleteData(constants.SubcommandPolicy, constants.AutocompletePolicyHint, constants.AutocompletePolicyDesc)
+	policy.AddCommand(model.NewAutocompleteData(constants.PolicyEnable, "", constants.AutocompletePolicyOnDesc))
+	policy.AddCommand(model.NewAutocompleteData(constants.PolicyDisable, "", constants.AutocompletePolicyOffDesc))
+	policy.AddCommand(model.NewAutocompleteData(constants.PolicyCap, constants.AutocompletePolicyCapHint, constants.AutocompletePolicyCapDesc))
+	schedule.AddCommand(policy)
+
+	admin := model.NewAutocompleteData(constants.SubcommandAdmin, constants.AutocompleteAdminHint, constants.AutocompleteAdminDesc)
+	admin.RoleID = model.SystemAdminRoleId
+	admin.AddCommand(model.NewAutocompleteData(constants.AdminList, constants.AutocompleteAdminListHint, constants.AutocompleteAdminListDesc))
+	admin.AddCommand(model.NewAutocompleteData(constants.AdminCancel, constants.AutocompleteAdminCanHint, constants.AutocompleteAdminCanDesc))
+	admin.AddCommand(model.NewAutocompleteData(constants.AdminStatus, "", constants.AutocompleteAdminStatDesc))
+	admin.AddCommand(model.NewAutocompleteData(constants.AdminPause, constants.AutocompleteAdminPauseHint, constants.AutocompleteAdminPauseDesc))
+	admin.AddCommand(model.NewAutocompleteData(constants.AdminResume, constants.AutocompleteAdminResHint, constants.AutocompleteAdminResDesc))
+	schedule.AddCommand(admin)
+
 	help := model.NewAutocompleteData(constants.SubcommandHelp, constants.AutocompleteHelpHint, constants.AutocompleteHelpDesc)
 	schedule.AddCommand(help)
 
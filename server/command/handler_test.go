@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost/server/public/model"
@@ -25,6 +26,15 @@ type testMocks struct {
 	channel         *mock.MockChannelService
 	listService     *mock.MockListService
 	scheduleService *mock.MockScheduleService
+	feed            *mock.MockFeedService
+	admin           *mock.MockAdminService
+	policy          *testutil.FakePolicy
+	history         *mock.MockHistoryService
+	preferences     *mock.MockPreferenceService
+	dialogs         *mock.MockDialogService
+	events          *testutil.FakeNotifier
+	drafts          *mock.MockDraftService
+	clock           testutil.FakeClock
 }
 
 func setup(t *testing.T) (*command.Handler, *testMocks, *gomock.Controller) {
@@ -38,6 +48,15 @@ func setup(t *testing.T) (*command.Handler, *testMocks, *gomock.Controller) {
 		channel:         mock.NewMockChannelService(ctrl),
 		listService:     mock.NewMockListService(ctrl),
 		scheduleService: mock.NewMockScheduleService(ctrl),
+		feed:            mock.NewMockFeedService(ctrl),
+		admin:           mock.NewMockAdminService(ctrl),
+		policy:          &testutil.FakePolicy{},
+		history:         mock.NewMockHistoryService(ctrl),
+		preferences:     mock.NewMockPreferenceService(ctrl),
+		dialogs:         mock.NewMockDialogService(ctrl),
+		events:          &testutil.FakeNotifier{},
+		drafts:          mock.NewMockDraftService(ctrl),
+		clock:           testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)},
 	}
 
 	helpText := "Sample help text"
@@ -50,6 +69,15 @@ func setup(t *testing.T) (*command.Handler, *testMocks, *gomock.Controller) {
 		mocks.channel,
 		mocks.listService,
 		mocks.scheduleService,
+		mocks.feed,
+		mocks.admin,
+		mocks.policy,
+		mocks.history,
+		mocks.preferences,
+		mocks.dialogs,
+		mocks.events,
+		mocks.drafts,
+		mocks.clock,
 		helpText,
 	)
 	require.NotNil(t, handler)
@@ -66,6 +94,7 @@ func TestNewHandler_SuccessfulInstantiation(t *testing.T) {
 	mockChannel := mock.NewMockChannelService(ctrl)
 	mockListService := mock.NewMockListService(ctrl)
 	mockScheduleService := mock.NewMockScheduleService(ctrl)
+	mockFeed := mock.NewMockFeedService(ctrl)
 	helpText := "Test Help"
 
 	handler := command.NewHandler(
@@ -76,6 +105,15 @@ func TestNewHandler_SuccessfulInstantiation(t *testing.T) {
 		mockChannel,
 		mockListService,
 		mockScheduleService,
+		mockFeed,
+		mock.NewMockAdminService(ctrl),
+		&testutil.FakePolicy{},
+		mock.NewMockHistoryService(ctrl),
+		mock.NewMockPreferenceService(ctrl),
+		mock.NewMockDialogService(ctrl),
+		&testutil.FakeNotifier{},
+		mock.NewMockDraftService(ctrl),
+		testutil.FakeClock{NowTime: time.Now()},
 		helpText,
 	)
 
@@ -137,7 +175,7 @@ func TestExecute_ListSubcommand(t *testing.T) {
 	}
 	expectedResp := &model.CommandResponse{Text: "List response"}
 
-	mocks.listService.EXPECT().Build(userID).Return(expectedResp)
+	mocks.listService.EXPECT().Build(&types.MessageQuery{UserID: userID}).Return(expectedResp)
 
 	resp, appErr := handler.Execute(args)
 
@@ -145,6 +183,49 @@ func TestExecute_ListSubcommand(t *testing.T) {
 	assert.Equal(t, expectedResp, resp)
 }
 
+func TestExecute_ListSubcommand_WithFilters(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	args := &model.CommandArgs{
+		UserId:    "testUserID",
+		ChannelId: "testChannelID",
+		Command:   "/" + constants.CommandTrigger + " " + constants.SubcommandList + " here files:no standup",
+	}
+	expectedResp := &model.CommandResponse{Text: "List response"}
+
+	mocks.scheduleService.EXPECT().UserTimezone("testUserID").Return("UTC")
+	mocks.listService.EXPECT().Build(gomock.Any()).DoAndReturn(func(query *types.MessageQuery) *model.CommandResponse {
+		assert.Equal(t, "testUserID", query.UserID)
+		assert.Equal(t, []string{"testChannelID"}, query.ChannelIDs)
+		require.NotNil(t, query.HasFiles)
+		assert.False(t, *query.HasFiles)
+		assert.Equal(t, "standup", query.Text)
+		return expectedResp
+	})
+
+	resp, appErr := handler.Execute(args)
+
+	require.Nil(t, appErr)
+	assert.Equal(t, expectedResp, resp)
+}
+
+func TestExecute_ListSubcommand_InvalidFilter(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	args := &model.CommandArgs{
+		UserId:  "testUserID",
+		Command: "/" + constants.CommandTrigger + " " + constants.SubcommandList + " sort:sideways",
+	}
+	mocks.scheduleService.EXPECT().UserTimezone("testUserID").Return("UTC")
+
+	resp, appErr := handler.Execute(args)
+
+	require.Nil(t, appErr)
+	assert.Contains(t, resp.Text, "invalid sort")
+}
+
 func TestExecute_ScheduleSubcommand_Default(t *testing.T) {
 	handler, mocks, ctrl := setup(t)
 	defer ctrl.Finish()
@@ -194,7 +275,7 @@ func TestBuildEphemeralList_SuccessfulListBuild(t *testing.T) {
 	args := &model.CommandArgs{UserId: userID}
 	expectedResp := &model.CommandResponse{Text: "List built"}
 
-	mocks.listService.EXPECT().Build(userID).Return(expectedResp)
+	mocks.listService.EXPECT().Build(&types.MessageQuery{UserID: userID}).Return(expectedResp)
 
 	resp := handler.BuildEphemeralList(args)
 
@@ -216,6 +297,11 @@ func TestUserDeleteMessage_SuccessfulDeletion(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, msg, returnedMsg)
+	events := mocks.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventCancelled, events[0].Type)
+	assert.Equal(t, msgID, events[0].MessageID)
+	assert.Equal(t, userID, events[0].ActorID)
 }
 
 func TestUserDeleteMessage_Failure_GetScheduledMessageFails(t *testing.T) {
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+)
+
+func (h *Handler) handleHistory(args *model.CommandArgs) *model.CommandResponse {
+	h.logger.Debug("Showing sent message history", "user_id", args.UserId)
+	entries, err := h.history.List(args.UserId)
+	if err != nil {
+		h.logger.Error("Failed to list sent message history", "user_id", args.UserId, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not load your sent message history: %v", constants.EmojiError, err))
+	}
+	if len(entries) == 0 {
+		return errorResponse(constants.HistoryEmptyMessage)
+	}
+
+	shown := entries
+	if len(shown) > constants.HistoryShownEntries {
+		shown = shown[:constants.HistoryShownEntries]
+	}
+	channelLinks := map[string]string{}
+	lines := []string{constants.HistoryHeader}
+	for _, entry := range shown {
+		loc, locErr := time.LoadLocation(entry.Timezone)
+		if locErr != nil {
+			loc = time.UTC
+		}
+		link, ok := channelLinks[entry.ChannelID]
+		if !ok {
+			link = h.channel.MakeChannelLink(h.channel.GetInfoOrUnknown(entry.ChannelID))
+			channelLinks[entry.ChannelID] = link
+		}
+		lines = append(lines, formatter.FormatHistoryEntry(entry, entry.SentAt.In(loc), loc.String(), link, h.history.Permalink(entry.PostID)))
+	}
+	if len(shown) < len(entries) {
+		lines = append(lines, "", formatter.FormatHistoryTruncated(len(shown), len(entries)))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         strings.Join(lines, "\n"),
+	}
+}
@@ -0,0 +1,83 @@
+package command_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func historyArgs() *model.CommandArgs {
+	return &model.CommandArgs{
+		UserId:    "testUserID",
+		ChannelId: "testChannelID",
+		Command:   "/" + constants.CommandTrigger + " " + constants.SubcommandHistory,
+	}
+}
+
+func TestExecute_History_ShowsPermalinksAndFailures(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectChannelLink(mocks)
+
+	sentAt := time.Date(2026, 2, 1, 9, 0, 0, 0, time.UTC)
+	mocks.history.EXPECT().List("testUserID").Return([]*types.HistoryEntry{
+		{MessageID: "m2", ChannelID: "c1", SentAt: sentAt, Timezone: "Asia/Seoul", Status: types.HistoryFailed, Error: "channel archived"},
+		{MessageID: "m1", ChannelID: "c1", PostID: "p1", SentAt: sentAt, Timezone: "UTC", Status: types.HistorySent},
+	}, nil)
+	mocks.history.EXPECT().Permalink("").Return("")
+	mocks.history.EXPECT().Permalink("p1").Return("https://chat.example.com/_redirect/pl/p1")
+
+	resp, appErr := handler.Execute(historyArgs())
+
+	require.Nil(t, appErr)
+	assert.Contains(t, resp.Text, constants.HistoryHeader)
+	assert.Contains(t, resp.Text, "Feb 1, 2026 6:00 PM** (Asia/Seoul) in channel: ~town-square: failed, channel archived")
+	assert.Contains(t, resp.Text, "Feb 1, 2026 9:00 AM** (UTC) in channel: ~town-square ([view message](https://chat.example.com/_redirect/pl/p1))")
+}
+
+func TestExecute_History_Truncates(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	expectChannelLink(mocks)
+
+	var entries []*types.HistoryEntry
+	for i := 0; i < constants.HistoryShownEntries+5; i++ {
+		entries = append(entries, &types.HistoryEntry{MessageID: fmt.Sprint(i), ChannelID: "c1", PostID: "p", Status: types.HistorySent})
+	}
+	mocks.history.EXPECT().List("testUserID").Return(entries, nil)
+	mocks.history.EXPECT().Permalink("p").Return("").Times(constants.HistoryShownEntries)
+
+	resp, _ := handler.Execute(historyArgs())
+
+	assert.Contains(t, resp.Text, fmt.Sprintf("Showing the %d most recent of %d", constants.HistoryShownEntries, len(entries)))
+}
+
+func TestExecute_History_Empty(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	mocks.history.EXPECT().List("testUserID").Return(nil, nil)
+
+	resp, _ := handler.Execute(historyArgs())
+
+	assert.Equal(t, constants.HistoryEmptyMessage, resp.Text)
+}
+
+func TestExecute_History_Error(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	mocks.history.EXPECT().List("testUserID").Return(nil, errors.New("boom"))
+	mocks.channel.EXPECT().GetInfoOrUnknown(gomock.Any()).Times(0)
+
+	resp, _ := handler.Execute(historyArgs())
+
+	assert.Contains(t, resp.Text, "Could not load your sent message history")
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func (h *Handler) handleList(args *model.CommandArgs, text string) *model.CommandResponse {
+	if text == "" {
+		return h.BuildEphemeralList(args)
+	}
+	tz := h.scheduleService.UserTimezone(args.UserId)
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		h.logger.Warn("Failed to load user timezone for list filters, using UTC", "user_id", args.UserId, "timezone", tz, "error", err)
+		loc = time.UTC
+	}
+	query, err := parseListQuery(args, text, loc)
+	if err != nil {
+		h.logger.Debug("Invalid list filters", "user_id", args.UserId, "text", text, "error", err)
+		return errorResponse(formatter.FormatListFilterError(err))
+	}
+	h.logger.Debug("Listing with filters", "user_id", args.UserId, "channel_count", len(query.ChannelIDs), "sort", query.Sort, "text", query.Text)
+	return h.listService.Build(query)
+}
+
+// parseListQuery turns the arguments of `/schedule list` into a query. Channels
+// are given as ~mentions or "here", dates are whole days in loc with an
+// inclusive "to:", and words that are not filters search the message text.
+func parseListQuery(args *model.CommandArgs, text string, loc *time.Location) (*types.MessageQuery, error) {
+	query := &types.MessageQuery{UserID: args.UserId}
+	var words []string
+	for _, field := range strings.Fields(text) {
+		lower := strings.ToLower(field)
+		switch {
+		case lower == constants.ListFilterHere:
+			query.ChannelIDs = append(query.ChannelIDs, args.ChannelId)
+		case strings.HasPrefix(field, "~"):
+			channelID, ok := args.ChannelMentions[strings.TrimPrefix(field, "~")]
+			if !ok {
+				return nil, fmt.Errorf("unknown channel %s", field)
+			}
+			query.ChannelIDs = append(query.ChannelIDs, channelID)
+		case strings.HasPrefix(lower, constants.ListFilterFrom):
+			day, err := parseListDate(field[len(constants.ListFilterFrom):], loc)
+			if err != nil {
+				return nil, err
+			}
+			query.From = day
+		case strings.HasPrefix(lower, constants.ListFilterTo):
+			day, err := parseListDate(field[len(constants.ListFilterTo):], loc)
+			if err != nil {
+				return nil, err
+			}
+			query.To = day.AddDate(0, 0, 1)
+		case strings.HasPrefix(lower, constants.ListFilterFiles):
+			switch lower[len(constants.ListFilterFiles):] {
+			case "yes":
+				query.HasFiles = model.NewPointer(true)
+			case "no":
+				query.HasFiles = model.NewPointer(false)
+			default:
+				return nil, fmt.Errorf("invalid files filter %q, use files:yes or files:no", field)
+			}
+		case strings.HasPrefix(lower, constants.ListFilterSort):
+			order := types.SortOrder(lower[len(constants.ListFilterSort):])
+			if order != types.SortAscending && order != types.SortDescending {
+				return nil, fmt.Errorf("invalid sort %q, use sort:asc or sort:desc", field)
+			}
+			query.Sort = order
+		case strings.HasPrefix(lower, constants.ListFilterCursor):
+			query.Cursor = field[len(constants.ListFilterCursor):]
+		default:
+			words = append(words, field)
+		}
+	}
+	query.Text = strings.Join(words, " ")
+	return query, nil
+}
+
+func parseListDate(value string, loc *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation(constants.DateParseLayoutYYYYMMDD, value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, use YYYY-MM-DD", value)
+	}
+	return day, nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func listArgs() *model.CommandArgs {
+	args := &model.CommandArgs{UserId: "user1", ChannelId: "current"}
+	args.AddChannelMention("town-square", "ts-id")
+	return args
+}
+
+func TestParseListQuery_AllFilters(t *testing.T) {
+	loc := testutil.MustLoadLocation(t, "America/New_York")
+
+	query, err := parseListQuery(listArgs(), "~town-square here from:2025-01-02 to:2025-01-03 files:yes sort:DESC cursor:AbC deploy window", loc)
+
+	require.NoError(t, err)
+	assert.Equal(t, "user1", query.UserID)
+	assert.Equal(t, []string{"ts-id", "current"}, query.ChannelIDs)
+	assert.True(t, query.From.Equal(time.Date(2025, 1, 2, 0, 0, 0, 0, loc)))
+	assert.True(t, query.To.Equal(time.Date(2025, 1, 4, 0, 0, 0, 0, loc)), "to: is inclusive of the whole day")
+	require.NotNil(t, query.HasFiles)
+	assert.True(t, *query.HasFiles)
+	assert.Equal(t, types.SortDescending, query.Sort)
+	assert.Equal(t, "AbC", query.Cursor, "cursor case must be preserved")
+	assert.Equal(t, "deploy window", query.Text)
+}
+
+func TestParseListQuery_Errors(t *testing.T) {
+	tests := []string{
+		"~unknown",
+		"from:tomorrow",
+		"to:2025-13-01",
+		"files:maybe",
+		"sort:sideways",
+	}
+	for _, text := range tests {
+		t.Run(text, func(t *testing.T) {
+			_, err := parseListQuery(listArgs(), text, time.UTC)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -2,7 +2,6 @@ package command
 
 import (
 	"fmt"
-	"sort"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -17,38 +16,51 @@ type ListService struct {
 	logger  ports.Logger
 	store   ports.Store
 	channel ports.ChannelService
+	prefs   ports.PreferenceStore
 }
 
-func NewListService(logger ports.Logger, store ports.Store, channel ports.ChannelService) *ListService {
+func NewListService(logger ports.Logger, store ports.Store, channel ports.ChannelService, prefs ports.PreferenceStore) *ListService {
 	logger.Debug("Creating new ListService")
 	return &ListService{
 		logger:  logger,
 		store:   store,
 		channel: channel,
+		prefs:   prefs,
 	}
 }
 
-func (l *ListService) Build(userID string) *model.CommandResponse {
-	l.logger.Info("Building scheduled message list for user", "user_id", userID)
-	msgs, err := l.loadMessages(userID)
+func (l *ListService) Build(query *types.MessageQuery) *model.CommandResponse {
+	l.logger.Info("Building scheduled message list for user", "user_id", query.UserID)
+	prefs := l.preferences(query.UserID)
+	if query.Limit == 0 {
+		query.Limit = prefs.ListPageSize
+	}
+	page, err := l.Query(query)
 	if err != nil {
-		l.logger.Error("Failed to load messages for user", "user_id", userID, "error", err)
+		l.logger.Error("Failed to load messages for user", "user_id", query.UserID, "error", err)
 		return errorResponse(fmt.Sprintf("%s Error retrieving message list: %v", constants.EmojiError, err))
 	}
-	if len(msgs) == 0 {
-		l.logger.Info("User has no scheduled messages", "user_id", userID)
+	if len(page.Messages) == 0 {
+		l.logger.Info("User has no scheduled messages", "user_id", query.UserID)
+		if isFiltered(query) {
+			return errorResponse(constants.EmptyFilteredListMessage)
+		}
 		return emptyResponse()
 	}
 
-	l.logger.Debug("Successfully loaded messages, building attachments", "user_id", userID, "count", len(msgs))
-	attachments := l.buildAttachments(msgs)
-	l.logger.Debug("Successfully built attachments for message list", "user_id", userID, "count", len(attachments))
+	l.logger.Debug("Successfully loaded messages, building attachments", "user_id", query.UserID, "count", len(page.Messages))
+	attachments := l.buildAttachments(page.Messages, formatter.TimeLayout(prefs.Use24Hour))
+	if page.NextCursor != "" {
+		attachments = append(attachments, &model.SlackAttachment{Text: formatter.FormatListMore(page.NextCursor)})
+	}
+	l.logger.Debug("Successfully built attachments for message list", "user_id", query.UserID, "count", len(attachments))
 	return successResponse(attachments)
 }
 
 func (l *ListService) BuildPost(userID string, channelID string) (*model.Post, error) {
 	l.logger.Info("Building scheduled message list for user", "user_id", userID)
-	msgs, err := l.loadMessages(userID)
+	prefs := l.preferences(userID)
+	messages, err := l.queryAll(userID)
 	if err != nil {
 		l.logger.Error("Failed to load messages for user", "user_id", userID, "error", err)
 		errMsg := fmt.Sprintf("%s Error retrieving message list: %v", constants.EmojiError, err)
@@ -59,7 +71,7 @@ func (l *ListService) BuildPost(userID string, channelID string) (*model.Post, e
 			Message:   errMsg,
 		}, err
 	}
-	if len(msgs) == 0 {
+	if len(messages) == 0 {
 		l.logger.Info("User has no scheduled messages", "user_id", userID)
 		return &model.Post{
 			UserId:    userID,
@@ -68,57 +80,79 @@ func (l *ListService) BuildPost(userID string, channelID string) (*model.Post, e
 		}, nil
 	}
 
-	l.logger.Debug("Successfully loaded messages, building attachments", "user_id", userID, "count", len(msgs))
-	attachments := l.buildAttachments(msgs)
+	l.logger.Debug("Successfully loaded messages, building attachments", "user_id", userID, "count", len(messages))
+	attachments := l.buildAttachments(messages, formatter.TimeLayout(prefs.Use24Hour))
 	l.logger.Debug("Successfully built attachments for message list", "user_id", userID, "count", len(attachments))
 	return buildSuccessPost(userID, channelID, attachments), nil
 }
 
-func (l *ListService) loadMessages(userID string) ([]*types.ScheduledMessage, error) {
-	l.logger.Debug("Loading scheduled message IDs for user", "user_id", userID)
-	ids, err := l.store.ListUserMessageIDs(userID)
-	if err != nil {
-		l.logger.Error("Failed to list user message IDs", "user_id", userID, "error", err)
-		return nil, err
-	}
-	l.logger.Debug("Found message IDs for user", "user_id", userID, "count", len(ids))
-
-	msgs := []*types.ScheduledMessage{}
-	for _, id := range ids {
-		l.logger.Debug("Loading scheduled message details", "user_id", userID, "message_id", id)
-		msg, err := l.store.GetScheduledMessage(id)
+// queryAll returns every scheduled message of the user, walking the pages so
+// the webapp, which has no way to follow a cursor, sees the whole list.
+func (l *ListService) queryAll(userID string) ([]*types.ScheduledMessage, error) {
+	var messages []*types.ScheduledMessage
+	query := &types.MessageQuery{UserID: userID, Limit: constants.MaxQueryLimit}
+	for {
+		page, err := l.Query(query)
 		if err != nil {
-			// Log the error but continue trying to load other messages
-			l.logger.Error("Failed to get scheduled message details", "user_id", userID, "message_id", id, "error", err)
-			continue
+			return nil, err
 		}
-		if msg == nil || msg.ID == "" {
-			l.logger.Warn("Scheduled message referenced in user index not found, cleaning up", "user_id", userID, "message_id", id)
-			// Attempt cleanup, log if cleanup fails but continue processing
-			if cleanupErr := l.store.CleanupMessageFromUserIndex(userID, id); cleanupErr != nil {
-				l.logger.Error("Failed to cleanup missing message from user index", "user_id", userID, "message_id", id, "error", cleanupErr)
-			}
-			continue
+		messages = append(messages, page.Messages...)
+		if page.NextCursor == "" {
+			return messages, nil
 		}
-		l.logger.Debug("Successfully loaded scheduled message", "user_id", userID, "message_id", msg.ID)
-
-		if len(msg.FileIDs) > 0 {
-			msg.MessageContent = fmt.Sprintf("\\+ %v files\n%s", len(msg.FileIDs), msg.MessageContent)
-		}
-
-		msgs = append(msgs, msg)
+		query = &types.MessageQuery{UserID: userID, Limit: constants.MaxQueryLimit, Cursor: page.NextCursor}
 	}
+}
 
-	l.logger.Debug("Sorting loaded messages by post time", "user_id", userID, "count", len(msgs))
-	sort.Slice(msgs, func(i, j int) bool {
-		return msgs[i].PostAt.Before(msgs[j].PostAt)
+// BuildDigest builds a digest of the messages matching query, with the same
+// attachments and Delete buttons as the list. It returns a nil post when
+// nothing matches, so no empty digest is sent.
+func (l *ListService) BuildDigest(query *types.MessageQuery, header string) (*model.Post, error) {
+	l.logger.Debug("Building scheduled message digest for user", "user_id", query.UserID, "from", query.From, "to", query.To)
+	page, err := l.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(page.Messages) == 0 {
+		l.logger.Debug("No messages for digest", "user_id", query.UserID)
+		return nil, nil
+	}
+	attachments := l.buildAttachments(page.Messages, formatter.TimeLayout(l.preferences(query.UserID).Use24Hour))
+	if page.NextCursor != "" {
+		attachments = append(attachments, &model.SlackAttachment{Text: formatter.FormatDigestMore()})
+	}
+	post := &model.Post{Message: header}
+	post.SetProps(map[string]any{
+		"attachments": attachments,
 	})
+	l.logger.Debug("Successfully built digest", "user_id", query.UserID, "count", len(page.Messages))
+	return post, nil
+}
+
+// Query returns one page of the user's scheduled messages.
+func (l *ListService) Query(query *types.MessageQuery) (*types.MessagePage, error) {
+	l.logger.Debug("Querying scheduled messages for user", "user_id", query.UserID)
+	page, err := l.store.QueryMessages(query)
+	if err != nil {
+		l.logger.Error("Failed to query scheduled messages", "user_id", query.UserID, "error", err)
+		return nil, err
+	}
+	l.logger.Debug("Finished querying messages for user", "user_id", query.UserID, "count", len(page.Messages), "has_more", page.NextCursor != "")
+	return page, nil
+}
 
-	l.logger.Debug("Finished loading and sorting messages for user", "user_id", userID, "count", len(msgs))
-	return msgs, nil
+// preferences returns the user's preferences, or the defaults when they
+// cannot be read.
+func (l *ListService) preferences(userID string) *types.UserPreferences {
+	prefs, err := l.prefs.GetPreferences(userID)
+	if err != nil {
+		l.logger.Warn("Failed to get user preferences, listing with defaults", "user_id", userID, "error", err)
+		return &types.UserPreferences{}
+	}
+	return prefs
 }
 
-func (l *ListService) buildAttachments(msgs []*types.ScheduledMessage) []*model.SlackAttachment {
+func (l *ListService) buildAttachments(msgs []*types.ScheduledMessage, layout string) []*model.SlackAttachment {
 	l.logger.Debug("Building attachments for scheduled messages", "count", len(msgs))
 	attachments := []*model.SlackAttachment{}
 	channelCache := make(map[string]*ports.ChannelInfo)
@@ -133,10 +167,15 @@ func (l *ListService) buildAttachments(msgs []*types.ScheduledMessage) []*model.
 		}
 		loc, _ := time.LoadLocation(m.Timezone)
 		localTime := m.PostAt.In(loc)
+		content := m.MessageContent
+		if len(m.FileIDs) > 0 {
+			content = fmt.Sprintf("\\+ %v files\n%s", len(m.FileIDs), content)
+		}
 		header := formatter.FormatListAttachmentHeader(
 			localTime,
+			layout,
 			l.channel.MakeChannelLink(channelCache[m.ChannelID]),
-			m.MessageContent,
+			content,
 		)
 		attachments = append(attachments, createAttachment(header, m.ID))
 		l.logger.Debug("Created attachment for message", "message_id", m.ID)
@@ -146,6 +185,10 @@ func (l *ListService) buildAttachments(msgs []*types.ScheduledMessage) []*model.
 	return attachments
 }
 
+func isFiltered(query *types.MessageQuery) bool {
+	return len(query.ChannelIDs) > 0 || !query.From.IsZero() || !query.To.IsZero() || query.HasFiles != nil || query.Text != ""
+}
+
 func buildSuccessPost(userID string, channelID string, atts []*model.SlackAttachment) *model.Post {
 	post := &model.Post{
 		UserId:    userID,
@@ -185,21 +228,47 @@ func successResponse(atts []*model.SlackAttachment) *model.CommandResponse {
 }
 
 func createAttachment(text string, messageID string) *model.SlackAttachment {
-	return &model.SlackAttachment{
-		Text: text,
-		Actions: []*model.PostAction{
-			{
-				Id:    "delete",
-				Name:  "Delete",
-				Style: "danger",
-				Integration: &model.PostActionIntegration{
-					URL: "/plugins/com.mattermost-plugin-schedule-message-gui/api/v1/delete",
-					Context: map[string]any{
-						"action": "delete",
-						"id":     messageID,
-					},
+	actions := []*model.PostAction{
+		{
+			Id:    "delete",
+			Name:  "Delete",
+			Style: "danger",
+			Integration: &model.PostActionIntegration{
+				URL: "/plugins/com.mattermost-plugin-schedule-message-gui/api/v1/delete",
+				Context: map[string]any{
+					"action": "delete",
+					"id":     messageID,
+				},
+			},
+		},
+	}
+	for _, option := range constants.ListPostponeOptions {
+		actions = append(actions, &model.PostAction{
+			Id:   constants.ListPostponeAction + option.Value,
+			Name: option.Text,
+			Integration: &model.PostActionIntegration{
+				URL: constants.ListPostponeActionURL,
+				Context: map[string]any{
+					"action": constants.ListPostponeAction,
+					"id":     messageID,
+					"option": option.Value,
 				},
 			},
+		})
+	}
+	actions = append(actions, &model.PostAction{
+		Id:   constants.ListDraftAction,
+		Name: "Move to draft",
+		Integration: &model.PostActionIntegration{
+			URL: constants.ListDraftActionURL,
+			Context: map[string]any{
+				"action": constants.ListDraftAction,
+				"id":     messageID,
+			},
 		},
+	})
+	return &model.SlackAttachment{
+		Text:    text,
+		Actions: actions,
 	}
 }
@@ -38,7 +38,7 @@ func TestNewListService_Success(t *testing.T) {
 	mockChannel := mock.NewMockChannelService(ctrl)
 	logger := testutil.FakeLogger{}
 
-	service := NewListService(logger, mockStore, mockChannel)
+	service := NewListService(logger, mockStore, mockChannel, &testutil.FakePreferences{})
 
 	require.NotNil(t, service)
 	assert.Equal(t, logger, service.logger)
@@ -53,13 +53,13 @@ func TestBuild_LoadMessagesError(t *testing.T) {
 	mockStore := mock.NewMockStore(ctrl)
 	mockChannel := mock.NewMockChannelService(ctrl)
 	logger := testutil.FakeLogger{}
-	service := NewListService(logger, mockStore, mockChannel)
-	userID := "user1"
+	service := NewListService(logger, mockStore, mockChannel, &testutil.FakePreferences{})
+	query := &types.MessageQuery{UserID: "user1"}
 	expectedErr := errors.New("store error")
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return(nil, expectedErr)
+	mockStore.EXPECT().QueryMessages(query).Return(nil, expectedErr)
 
-	response := service.Build(userID)
+	response := service.Build(query)
 
 	expectedResponse := errorResponse(fmt.Sprintf("%s Error retrieving message list: %v", constants.EmojiError, expectedErr))
 	assert.Equal(t, expectedResponse, response)
@@ -72,17 +72,32 @@ func TestBuild_NoMessages(t *testing.T) {
 	mockStore := mock.NewMockStore(ctrl)
 	mockChannel := mock.NewMockChannelService(ctrl)
 	logger := testutil.FakeLogger{}
-	service := NewListService(logger, mockStore, mockChannel)
-	userID := "user1"
+	service := NewListService(logger, mockStore, mockChannel, &testutil.FakePreferences{})
+	query := &types.MessageQuery{UserID: "user1"}
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{}, nil)
+	mockStore.EXPECT().QueryMessages(query).Return(&types.MessagePage{}, nil)
 
-	response := service.Build(userID)
+	response := service.Build(query)
 
 	expectedResponse := emptyResponse()
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestBuild_NoMatchingMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockStore(ctrl)
+	service := NewListService(testutil.FakeLogger{}, mockStore, mock.NewMockChannelService(ctrl), &testutil.FakePreferences{})
+	query := &types.MessageQuery{UserID: "user1", Text: "deploy"}
+
+	mockStore.EXPECT().QueryMessages(query).Return(&types.MessagePage{}, nil)
+
+	response := service.Build(query)
+
+	assert.Equal(t, constants.EmptyFilteredListMessage, response.Text)
+}
+
 func TestBuild_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -90,24 +105,23 @@ func TestBuild_Success(t *testing.T) {
 	mockStore := mock.NewMockStore(ctrl)
 	mockChannel := mock.NewMockChannelService(ctrl)
 	logger := testutil.FakeLogger{}
-	service := NewListService(logger, mockStore, mockChannel)
+	service := NewListService(logger, mockStore, mockChannel, &testutil.FakePreferences{})
 
 	userID := "user1"
+	query := &types.MessageQuery{UserID: userID}
 	now := time.Now()
 	msg1 := createTestMessage("id1", userID, "ch1", "msg content 1", "UTC", now.Add(1*time.Hour))
 	msg2 := createTestMessage("id2", userID, "ch2", "msg content 2", "UTC", now.Add(2*time.Hour))
 	info1 := &ports.ChannelInfo{ChannelID: "ch1", ChannelType: model.ChannelTypeOpen, ChannelLink: "~town-square", TeamName: "team1"}
 	info2 := &ports.ChannelInfo{ChannelID: "ch2", ChannelType: model.ChannelTypePrivate, ChannelLink: "~private-channel", TeamName: "team1"}
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{"id1", "id2"}, nil)
-	mockStore.EXPECT().GetScheduledMessage("id1").Return(msg1, nil)
-	mockStore.EXPECT().GetScheduledMessage("id2").Return(msg2, nil)
+	mockStore.EXPECT().QueryMessages(query).Return(&types.MessagePage{Messages: []*types.ScheduledMessage{msg1, msg2}}, nil)
 	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info1)
 	mockChannel.EXPECT().GetInfoOrUnknown("ch2").Return(info2)
 	mockChannel.EXPECT().MakeChannelLink(info1).Return("in channel: ~town-square")
 	mockChannel.EXPECT().MakeChannelLink(info2).Return("in channel: ~private-channel")
 
-	response := service.Build(userID)
+	response := service.Build(query)
 
 	require.NotNil(t, response)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, response.ResponseType)
@@ -118,8 +132,8 @@ func TestBuild_Success(t *testing.T) {
 	require.Len(t, attachments, 2)
 
 	loc, _ := time.LoadLocation("UTC")
-	expectedHeader1 := formatter.FormatListAttachmentHeader(msg1.PostAt.In(loc), "in channel: ~town-square", msg1.MessageContent)
-	expectedHeader2 := formatter.FormatListAttachmentHeader(msg2.PostAt.In(loc), "in channel: ~private-channel", msg2.MessageContent)
+	expectedHeader1 := formatter.FormatListAttachmentHeader(msg1.PostAt.In(loc), constants.TimeLayout, "in channel: ~town-square", msg1.MessageContent)
+	expectedHeader2 := formatter.FormatListAttachmentHeader(msg2.PostAt.In(loc), constants.TimeLayout, "in channel: ~private-channel", msg2.MessageContent)
 
 	assert.Equal(t, expectedHeader1, attachments[0].Text)
 	assert.Equal(t, "id1", attachments[0].Actions[0].Integration.Context["id"])
@@ -127,216 +141,177 @@ func TestBuild_Success(t *testing.T) {
 	assert.Equal(t, "id2", attachments[1].Actions[0].Integration.Context["id"])
 }
 
-func TestLoadMessages_ListIDsError(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	expectedErr := errors.New("list error")
-
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return(nil, expectedErr)
-
-	msgs, err := service.loadMessages(userID)
-
-	assert.Nil(t, msgs)
-	assert.Equal(t, expectedErr, err)
-}
-
-func TestLoadMessages_NoIDs(t *testing.T) {
+func TestBuild_MorePages(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
+	mockChannel := mock.NewMockChannelService(ctrl)
+	service := NewListService(testutil.FakeLogger{}, mockStore, mockChannel, &testutil.FakePreferences{})
+	query := &types.MessageQuery{UserID: "user1"}
+	msg := createTestMessage("id1", "user1", "ch1", "content", "UTC", time.Now())
+	info := &ports.ChannelInfo{ChannelID: "ch1"}
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{}, nil)
+	mockStore.EXPECT().QueryMessages(query).Return(&types.MessagePage{Messages: []*types.ScheduledMessage{msg}, NextCursor: "abc"}, nil)
+	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
+	mockChannel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
 
-	msgs, err := service.loadMessages(userID)
+	response := service.Build(query)
 
-	assert.NotNil(t, msgs)
-	assert.Empty(t, msgs)
-	assert.NoError(t, err)
+	attachments := response.Props["attachments"].([]*model.SlackAttachment)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, formatter.FormatListMore("abc"), attachments[1].Text)
+	assert.Empty(t, attachments[1].Actions)
 }
 
-func TestLoadMessages_GetMessageError(t *testing.T) {
+func TestBuild_UsesPreferences(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	msgID := "id1"
-	expectedErr := errors.New("get error")
+	mockChannel := mock.NewMockChannelService(ctrl)
+	prefs := &testutil.FakePreferences{Prefs: map[string]*types.UserPreferences{"user1": {ListPageSize: 5, Use24Hour: true}}}
+	service := NewListService(testutil.FakeLogger{}, mockStore, mockChannel, prefs)
+	postAt := time.Date(2025, time.March, 4, 18, 30, 0, 0, time.UTC)
+	msg := createTestMessage("id1", "user1", "ch1", "content", "UTC", postAt)
+	info := &ports.ChannelInfo{ChannelID: "ch1"}
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{msgID}, nil)
-	mockStore.EXPECT().GetScheduledMessage(msgID).Return(nil, expectedErr)
+	mockStore.EXPECT().QueryMessages(&types.MessageQuery{UserID: "user1", Limit: 5}).Return(&types.MessagePage{Messages: []*types.ScheduledMessage{msg}}, nil)
+	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
+	mockChannel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
 
-	msgs, err := service.loadMessages(userID)
+	response := service.Build(&types.MessageQuery{UserID: "user1"})
 
-	assert.NotNil(t, msgs)
-	assert.Empty(t, msgs)
-	assert.NoError(t, err)
+	attachments := response.Props["attachments"].([]*model.SlackAttachment)
+	require.Len(t, attachments, 1)
+	assert.Contains(t, attachments[0].Text, "Mar 4, 2025 18:30")
 }
 
-func TestLoadMessages_GetMessageNil_CleanupSuccess(t *testing.T) {
+func TestBuildPost_NoMessages(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	msgID := "id1"
+	service := NewListService(testutil.FakeLogger{}, mockStore, mock.NewMockChannelService(ctrl), &testutil.FakePreferences{})
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{msgID}, nil)
-	mockStore.EXPECT().GetScheduledMessage(msgID).Return(nil, nil)
-	mockStore.EXPECT().CleanupMessageFromUserIndex(userID, msgID).Return(nil)
+	mockStore.EXPECT().QueryMessages(&types.MessageQuery{UserID: "user1", Limit: constants.MaxQueryLimit}).Return(&types.MessagePage{}, nil)
 
-	msgs, err := service.loadMessages(userID)
+	post, err := service.BuildPost("user1", "ch1")
 
-	assert.NotNil(t, msgs)
-	assert.Empty(t, msgs)
-	assert.NoError(t, err)
+	require.NoError(t, err)
+	assert.Equal(t, constants.EmptyListMessage, post.Message)
+	assert.Equal(t, "ch1", post.ChannelId)
 }
 
-func TestLoadMessages_GetMessageEmptyID_CleanupSuccess(t *testing.T) {
+func TestBuildPost_ReturnsEveryPage(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	msgID := "id1"
-	emptyMsg := &types.ScheduledMessage{ID: ""}
-
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{msgID}, nil)
-	mockStore.EXPECT().GetScheduledMessage(msgID).Return(emptyMsg, nil)
-	mockStore.EXPECT().CleanupMessageFromUserIndex(userID, msgID).Return(nil)
+	mockChannel := mock.NewMockChannelService(ctrl)
+	prefs := &testutil.FakePreferences{Prefs: map[string]*types.UserPreferences{"user1": {ListPageSize: 1}}}
+	service := NewListService(testutil.FakeLogger{}, mockStore, mockChannel, prefs)
+	msg1 := createTestMessage("id1", "user1", "ch1", "first", "UTC", time.Now())
+	msg2 := createTestMessage("id2", "user1", "ch1", "second", "UTC", time.Now().Add(time.Hour))
+	info := &ports.ChannelInfo{ChannelID: "ch1"}
+
+	gomock.InOrder(
+		mockStore.EXPECT().QueryMessages(&types.MessageQuery{UserID: "user1", Limit: constants.MaxQueryLimit}).
+			Return(&types.MessagePage{Messages: []*types.ScheduledMessage{msg1}, NextCursor: "abc"}, nil),
+		mockStore.EXPECT().QueryMessages(&types.MessageQuery{UserID: "user1", Limit: constants.MaxQueryLimit, Cursor: "abc"}).
+			Return(&types.MessagePage{Messages: []*types.ScheduledMessage{msg2}}, nil),
+	)
+	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
+	mockChannel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square").Times(2)
 
-	msgs, err := service.loadMessages(userID)
+	post, err := service.BuildPost("user1", "ch1")
 
-	assert.NotNil(t, msgs)
-	assert.Empty(t, msgs)
-	assert.NoError(t, err)
+	require.NoError(t, err)
+	attachments := post.GetProps()["attachments"].([]*model.SlackAttachment)
+	require.Len(t, attachments, 2, "no cursor hint, every message listed")
+	assert.Equal(t, "id1", attachments[0].Actions[0].Integration.Context["id"])
+	assert.Equal(t, "id2", attachments[1].Actions[0].Integration.Context["id"])
 }
 
-func TestLoadMessages_GetMessageNil_CleanupError(t *testing.T) {
+func TestBuildPost_QueryError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	msgID := "id1"
-	cleanupErr := errors.New("cleanup failed")
+	service := NewListService(testutil.FakeLogger{}, mockStore, mock.NewMockChannelService(ctrl), &testutil.FakePreferences{})
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{msgID}, nil)
-	mockStore.EXPECT().GetScheduledMessage(msgID).Return(nil, nil)
-	mockStore.EXPECT().CleanupMessageFromUserIndex(userID, msgID).Return(cleanupErr)
+	mockStore.EXPECT().QueryMessages(gomock.Any()).Return(&types.MessagePage{NextCursor: "abc"}, nil)
+	mockStore.EXPECT().QueryMessages(gomock.Any()).Return(nil, errors.New("kv down"))
 
-	msgs, err := service.loadMessages(userID)
+	post, err := service.BuildPost("user1", "ch1")
 
-	assert.NotNil(t, msgs)
-	assert.Empty(t, msgs)
-	assert.NoError(t, err)
+	assert.ErrorContains(t, err, "kv down")
+	assert.Contains(t, post.Message, "Error retrieving message list: kv down")
 }
 
-func TestLoadMessages_Success_SingleMessage(t *testing.T) {
+func TestBuildDigest(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	msgID := "id1"
-	expectedMsg := createTestMessage(msgID, userID, "ch1", "content", "UTC", time.Now())
+	mockChannel := mock.NewMockChannelService(ctrl)
+	service := NewListService(testutil.FakeLogger{}, mockStore, mockChannel, &testutil.FakePreferences{})
+	query := &types.MessageQuery{UserID: "user1", Limit: constants.DigestMaxMessages}
+	msg := createTestMessage("id1", "user1", "ch1", "content", "UTC", time.Now())
+	info := &ports.ChannelInfo{ChannelID: "ch1"}
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{msgID}, nil)
-	mockStore.EXPECT().GetScheduledMessage(msgID).Return(expectedMsg, nil)
+	mockStore.EXPECT().QueryMessages(query).Return(&types.MessagePage{Messages: []*types.ScheduledMessage{msg}, NextCursor: "abc"}, nil)
+	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
+	mockChannel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
 
-	msgs, err := service.loadMessages(userID)
+	post, err := service.BuildDigest(query, "### Digest")
 
 	require.NoError(t, err)
-	require.NotNil(t, msgs)
-	require.Len(t, msgs, 1)
-	assert.Equal(t, expectedMsg, msgs[0])
+	assert.Equal(t, "### Digest", post.Message)
+	attachments := post.Attachments()
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "id1", attachments[0].Actions[0].Integration.Context["id"])
+	assert.Equal(t, "delete", attachments[0].Actions[0].Integration.Context["action"])
+	assert.Equal(t, formatter.FormatDigestMore(), attachments[1].Text)
 }
 
-func TestLoadMessages_Success_MultipleMessages_Sorted(t *testing.T) {
+func TestBuildDigest_NoMessages(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	now := time.Now()
-	msg1 := createTestMessage("id1", userID, "ch1", "content1", "UTC", now.Add(2*time.Hour))
-	msg2 := createTestMessage("id2", userID, "ch1", "content2", "UTC", now.Add(1*time.Hour))
-	msg3 := createTestMessage("id3", userID, "ch1", "content3", "UTC", now.Add(3*time.Hour))
+	service := NewListService(testutil.FakeLogger{}, mockStore, mock.NewMockChannelService(ctrl), &testutil.FakePreferences{})
+	query := &types.MessageQuery{UserID: "user1"}
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{"id1", "id2", "id3"}, nil)
-	mockStore.EXPECT().GetScheduledMessage("id1").Return(msg1, nil)
-	mockStore.EXPECT().GetScheduledMessage("id2").Return(msg2, nil)
-	mockStore.EXPECT().GetScheduledMessage("id3").Return(msg3, nil)
+	mockStore.EXPECT().QueryMessages(query).Return(&types.MessagePage{}, nil)
 
-	msgs, err := service.loadMessages(userID)
+	post, err := service.BuildDigest(query, "### Digest")
 
 	require.NoError(t, err)
-	require.NotNil(t, msgs)
-	require.Len(t, msgs, 3)
-	assert.Equal(t, msg2, msgs[0])
-	assert.Equal(t, msg1, msgs[1])
-	assert.Equal(t, msg3, msgs[2])
+	assert.Nil(t, post)
 }
 
-func TestLoadMessages_Success_MixedResults(t *testing.T) {
+func TestQuery_PassesThroughStoreError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mock.NewMockStore(ctrl)
-	logger := testutil.FakeLogger{}
-	service := &ListService{logger: logger, store: mockStore}
-	userID := "user1"
-	now := time.Now()
+	service := &ListService{logger: testutil.FakeLogger{}, store: mockStore}
+	query := &types.MessageQuery{UserID: "user1", Cursor: "bad"}
 
-	msgOK1 := createTestMessage("id_ok1", userID, "ch1", "content1", "UTC", now.Add(2*time.Hour))
-	msgOK2 := createTestMessage("id_ok2", userID, "ch1", "content2", "UTC", now.Add(1*time.Hour))
-	idErr := "id_err"
-	idNil := "id_nil"
-	getErr := errors.New("get error")
-	cleanupErr := errors.New("cleanup error")
+	mockStore.EXPECT().QueryMessages(query).Return(nil, types.ErrInvalidCursor)
 
-	mockStore.EXPECT().ListUserMessageIDs(userID).Return([]string{"id_ok1", idErr, idNil, "id_ok2"}, nil)
-	mockStore.EXPECT().GetScheduledMessage("id_ok1").Return(msgOK1, nil)
-	mockStore.EXPECT().GetScheduledMessage(idErr).Return(nil, getErr)
-	mockStore.EXPECT().GetScheduledMessage(idNil).Return(nil, nil)
-	mockStore.EXPECT().CleanupMessageFromUserIndex(userID, idNil).Return(cleanupErr)
-	mockStore.EXPECT().GetScheduledMessage("id_ok2").Return(msgOK2, nil)
+	page, err := service.Query(query)
 
-	msgs, err := service.loadMessages(userID)
-
-	require.NoError(t, err)
-	require.NotNil(t, msgs)
-	require.Len(t, msgs, 2)
-	assert.Equal(t, msgOK2, msgs[0])
-	assert.Equal(t, msgOK1, msgs[1])
+	assert.Nil(t, page)
+	assert.ErrorIs(t, err, types.ErrInvalidCursor)
 }
 
 func TestBuildAttachments_EmptyInput(t *testing.T) {
 	logger := testutil.FakeLogger{}
 	service := &ListService{logger: logger}
 
-	attachments := service.buildAttachments([]*types.ScheduledMessage{})
+	attachments := service.buildAttachments([]*types.ScheduledMessage{}, constants.TimeLayout)
 
 	assert.NotNil(t, attachments)
 	assert.Empty(t, attachments)
@@ -358,16 +333,16 @@ func TestBuildAttachments_SingleMessage(t *testing.T) {
 	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
 	mockChannel.EXPECT().MakeChannelLink(info).Return(channelLinkStr)
 
-	attachments := service.buildAttachments([]*types.ScheduledMessage{msg})
+	attachments := service.buildAttachments([]*types.ScheduledMessage{msg}, constants.TimeLayout)
 
 	require.Len(t, attachments, 1)
 	att := attachments[0]
 
 	loc, _ := time.LoadLocation("UTC")
-	expectedHeader := formatter.FormatListAttachmentHeader(now.In(loc), channelLinkStr, "Hello world")
+	expectedHeader := formatter.FormatListAttachmentHeader(now.In(loc), constants.TimeLayout, channelLinkStr, "Hello world")
 
 	assert.Equal(t, expectedHeader, att.Text)
-	require.Len(t, att.Actions, 1)
+	require.Len(t, att.Actions, 2+len(constants.ListPostponeOptions))
 	action := att.Actions[0]
 	assert.Equal(t, "delete", action.Id)
 	assert.Equal(t, "Delete", action.Name)
@@ -377,6 +352,40 @@ func TestBuildAttachments_SingleMessage(t *testing.T) {
 	require.NotNil(t, action.Integration.Context)
 	assert.Equal(t, "delete", action.Integration.Context["action"])
 	assert.Equal(t, "msg1", action.Integration.Context["id"])
+
+	postpone := att.Actions[1]
+	assert.Equal(t, "+15m", postpone.Name)
+	assert.Equal(t, constants.ListPostponeActionURL, postpone.Integration.URL)
+	assert.Equal(t, constants.ListPostponeAction, postpone.Integration.Context["action"])
+	assert.Equal(t, "msg1", postpone.Integration.Context["id"])
+	assert.Equal(t, constants.ListPostpone15m, postpone.Integration.Context["option"])
+	assert.Equal(t, constants.ListPostponeMonday, att.Actions[4].Integration.Context["option"])
+
+	draft := att.Actions[5]
+	assert.Equal(t, "Move to draft", draft.Name)
+	assert.Equal(t, constants.ListDraftActionURL, draft.Integration.URL)
+	assert.Equal(t, constants.ListDraftAction, draft.Integration.Context["action"])
+	assert.Equal(t, "msg1", draft.Integration.Context["id"])
+}
+
+func TestBuildAttachments_FileCount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockChannel := mock.NewMockChannelService(ctrl)
+	service := &ListService{logger: testutil.FakeLogger{}, channel: mockChannel}
+	msg := createTestMessage("msg1", "user1", "ch1", "Hello", "UTC", time.Now())
+	msg.FileIDs = []string{"f1", "f2"}
+	info := &ports.ChannelInfo{ChannelID: "ch1"}
+
+	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
+	mockChannel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
+
+	attachments := service.buildAttachments([]*types.ScheduledMessage{msg}, constants.TimeLayout)
+
+	require.Len(t, attachments, 1)
+	assert.Contains(t, attachments[0].Text, "\\+ 2 files\nHello")
+	assert.Equal(t, "Hello", msg.MessageContent, "the stored message must not be modified")
 }
 
 func TestBuildAttachments_MultipleMessages_SameChannel_CacheHit(t *testing.T) {
@@ -396,7 +405,7 @@ func TestBuildAttachments_MultipleMessages_SameChannel_CacheHit(t *testing.T) {
 	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info).Times(1)
 	mockChannel.EXPECT().MakeChannelLink(info).Return(channelLinkStr).Times(2)
 
-	attachments := service.buildAttachments([]*types.ScheduledMessage{msg1, msg2})
+	attachments := service.buildAttachments([]*types.ScheduledMessage{msg1, msg2}, constants.TimeLayout)
 
 	require.Len(t, attachments, 2)
 	assert.Contains(t, attachments[0].Text, "content1")
@@ -426,7 +435,7 @@ func TestBuildAttachments_MultipleMessages_DifferentChannels(t *testing.T) {
 	mockChannel.EXPECT().MakeChannelLink(info1).Return(linkStr1)
 	mockChannel.EXPECT().MakeChannelLink(info2).Return(linkStr2)
 
-	attachments := service.buildAttachments([]*types.ScheduledMessage{msg1, msg2})
+	attachments := service.buildAttachments([]*types.ScheduledMessage{msg1, msg2}, constants.TimeLayout)
 
 	require.Len(t, attachments, 2)
 	assert.Contains(t, attachments[0].Text, linkStr1)
@@ -455,7 +464,7 @@ func TestBuildAttachments_TimezoneHandling(t *testing.T) {
 	mockChannel.EXPECT().GetInfoOrUnknown("ch1").Return(info)
 	mockChannel.EXPECT().MakeChannelLink(info).Return(linkStr)
 
-	attachments := service.buildAttachments([]*types.ScheduledMessage{msg})
+	attachments := service.buildAttachments([]*types.ScheduledMessage{msg}, constants.TimeLayout)
 
 	require.Len(t, attachments, 1)
 	att := attachments[0]
@@ -465,7 +474,7 @@ func TestBuildAttachments_TimezoneHandling(t *testing.T) {
 	require.NoError(t, err)
 	expectedTimeStr := postAtUTC.In(locNY).Format(constants.TimeLayout) // Should be 10:00 AM
 
-	expectedHeader := formatter.FormatListAttachmentHeader(postAtUTC.In(locNY), linkStr, "Timezone test")
+	expectedHeader := formatter.FormatListAttachmentHeader(postAtUTC.In(locNY), constants.TimeLayout, linkStr, "Timezone test")
 	assert.Equal(t, expectedHeader, att.Text)
 	assert.Contains(t, att.Text, expectedTimeStr)
 	assert.Contains(t, att.Text, "10:00 AM")
@@ -510,7 +519,7 @@ func TestCreateAttachment(t *testing.T) {
 	att := createAttachment(text, messageID)
 
 	assert.Equal(t, text, att.Text)
-	require.Len(t, att.Actions, 1)
+	require.Len(t, att.Actions, 2+len(constants.ListPostponeOptions))
 	action := att.Actions[0]
 	assert.Equal(t, "delete", action.Id)
 	assert.Equal(t, "Delete", action.Name)
@@ -0,0 +1,56 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// checkMetadata validates the metadata a message will be posted with and
+// lower-cases its priority. Attachments may not carry buttons or menus, since
+// their actions would call back to URLs on behalf of the owner, and custom
+// props may not set the props Mattermost itself uses.
+func checkMetadata(meta *types.PostMetadata) error {
+	if meta.IsEmpty() {
+		return nil
+	}
+	meta.Priority = strings.ToLower(strings.TrimSpace(meta.Priority))
+	switch meta.Priority {
+	case "", types.PriorityImportant, types.PriorityUrgent:
+	default:
+		return errors.New(constants.ErrMetadataPriority)
+	}
+	if len(meta.Attachments) > constants.MaxMessageAttachments {
+		return fmt.Errorf(constants.ErrMetadataTooManyAttachments, constants.MaxMessageAttachments)
+	}
+	for _, attachment := range meta.Attachments {
+		if attachment == nil {
+			return errors.New(constants.ErrMetadataEmptyAttachment)
+		}
+		if len(attachment.Actions) > 0 {
+			return errors.New(constants.ErrMetadataAttachmentActions)
+		}
+	}
+	for _, key := range constants.ReservedPostProps {
+		if _, ok := meta.Props[key]; ok {
+			return fmt.Errorf(constants.ErrMetadataReservedProp, key)
+		}
+	}
+	props := model.StringInterface{}
+	for key, value := range meta.Props {
+		props[key] = value
+	}
+	if len(meta.Attachments) > 0 {
+		props[model.PostPropsAttachments] = meta.Attachments
+	}
+	if utf8.RuneCountInString(model.StringInterfaceToJSON(props)) > model.PostPropsMaxUserRunes {
+		return errors.New(constants.ErrMetadataTooLarge)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package command
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestCheckMetadata(t *testing.T) {
+	tooMany := make([]*model.SlackAttachment, constants.MaxMessageAttachments+1)
+	for i := range tooMany {
+		tooMany[i] = &model.SlackAttachment{Text: "x"}
+	}
+	tests := []struct {
+		name    string
+		meta    *types.PostMetadata
+		wantErr string
+	}{
+		{"nil", nil, ""},
+		{"urgent with acknowledgement", &types.PostMetadata{Priority: " Urgent ", RequestedAck: true}, ""},
+		{"attachments and props", &types.PostMetadata{Attachments: []*model.SlackAttachment{{Title: "Release", Color: "#00ff00"}}, Props: map[string]any{"release": "2.0"}}, ""},
+		{"unknown priority", &types.PostMetadata{Priority: "high"}, constants.ErrMetadataPriority},
+		{"too many attachments", &types.PostMetadata{Attachments: tooMany}, fmt.Sprintf(constants.ErrMetadataTooManyAttachments, constants.MaxMessageAttachments)},
+		{"empty attachment", &types.PostMetadata{Attachments: []*model.SlackAttachment{nil}}, constants.ErrMetadataEmptyAttachment},
+		{"attachment with buttons", &types.PostMetadata{Attachments: []*model.SlackAttachment{{Actions: []*model.PostAction{{Name: "Approve"}}}}}, constants.ErrMetadataAttachmentActions},
+		{"reserved prop", &types.PostMetadata{Props: map[string]any{model.PostPropsOverrideUsername: "ceo"}}, fmt.Sprintf(constants.ErrMetadataReservedProp, model.PostPropsOverrideUsername)},
+		{"too large", &types.PostMetadata{Props: map[string]any{"blob": strings.Repeat("x", model.PostPropsMaxUserRunes)}}, constants.ErrMetadataTooLarge},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkMetadata(tc.meta)
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tc.wantErr, err.Error())
+		})
+	}
+}
+
+func TestCheckMetadata_LowercasesPriority(t *testing.T) {
+	meta := &types.PostMetadata{Priority: "IMPORTANT"}
+	require.NoError(t, checkMetadata(meta))
+	assert.Equal(t, types.PriorityImportant, meta.Priority)
+}
@@ -22,7 +22,7 @@ const (
 )
 
 var (
-	regexFullCommand    = regexp.MustCompile(`(?i)^at[ \t]+([0-9]{1,2}(?::[0-9]{2})?[ \t]*(?:am|pm)?)(?:[ \t]+on[ \t]+((?:\d{4}-\d{2}-\d{2})|(?:\d{1,2}[a-z]{3})|(?:mon|tue|wed|thu|fri|sat|sun)|(?:monday|tuesday|wednesday|thursday|friday|saturday|sunday)))?[ \t]+message\s*([\s\S]*)$`)
+	regexFullCommand    = regexp.MustCompile(`(?i)^(?:at[ \t]+([0-9]{1,2}(?::[0-9]{2})?[ \t]*(?:am|pm)?)[ \t]+)?(?:on[ \t]+((?:\d{4}-\d{2}-\d{2})|(?:\d{1,2}[a-z]{3})|(?:mon|tue|wed|thu|fri|sat|sun)|(?:monday|tuesday|wednesday|thursday|friday|saturday|sunday))[ \t]+)?(?:remind[ \t]+([0-9]+|off)[ \t]+)?(?:as[ \t]+(bot|me)[ \t]+)?message\s*([\s\S]*)$`)
 	regexpYYYYMMDD      = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 	regexpShortDayMonth = regexp.MustCompile(`^(\d{1,2})([a-z]{3})$`)
 )
@@ -62,15 +62,23 @@ var (
 )
 
 type ParsedSchedule struct {
+	// TimeStr is empty when only a date was given, to use the user's default
+	// time.
 	TimeStr string
 	DateStr string
+	// Remind is the per-message reminder, in minutes or "off", or empty to
+	// use the user's default.
+	Remind string
+	// As is "bot" to post the message as the bot, otherwise empty or "me".
+	As      string
 	Message string
 }
 
 func parseScheduleInput(input string) (*ParsedSchedule, error) {
 	trimmedInput := strings.TrimSpace(input)
 	matches := regexFullCommand.FindStringSubmatch(trimmedInput)
-	if matches == nil {
+	// Either the time or the date may be left out, but not both.
+	if matches == nil || (matches[1] == "" && matches[2] == "") {
 		return nil, errors.New(constants.ParserErrInvalidFormat)
 	}
 	timeStr := strings.ToLower(strings.ReplaceAll(matches[1], " ", ""))
@@ -78,11 +86,15 @@ func parseScheduleInput(input string) (*ParsedSchedule, error) {
 		timeStr = timeStr[1:]
 	}
 	dateStr := strings.ToLower(matches[2])
-	message := strings.TrimSpace(matches[3])
+	remind := strings.ToLower(matches[3])
+	as := strings.ToLower(matches[4])
+	message := strings.TrimSpace(matches[5])
 
 	return &ParsedSchedule{
 		TimeStr: timeStr,
 		DateStr: dateStr,
+		Remind:  remind,
+		As:      as,
 		Message: message,
 	}, nil
 }
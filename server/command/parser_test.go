@@ -67,6 +67,31 @@ func TestParseScheduleInput(t *testing.T) {
 			input: "at 2pm message \n\n Content \n\n",
 			want:  &ParsedSchedule{TimeStr: "2pm", DateStr: "", Message: "Content"},
 		},
+		{
+			name:  "Reminder minutes",
+			input: "at 9am on fri remind 30 message Launch",
+			want:  &ParsedSchedule{TimeStr: "9am", DateStr: "fri", Remind: "30", Message: "Launch"},
+		},
+		{
+			name:  "Reminder turned off without date",
+			input: "at 9am REMIND off message Quiet",
+			want:  &ParsedSchedule{TimeStr: "9am", DateStr: "", Remind: "off", Message: "Quiet"},
+		},
+		{
+			name:  "Reminder words inside message are kept",
+			input: "at 9am message remind 30 people",
+			want:  &ParsedSchedule{TimeStr: "9am", DateStr: "", Message: "remind 30 people"},
+		},
+		{
+			name:  "Posted as the bot after a reminder",
+			input: "at 9am on mon remind 30 AS BOT message Release notes",
+			want:  &ParsedSchedule{TimeStr: "9am", DateStr: "mon", Remind: "30", As: "bot", Message: "Release notes"},
+		},
+		{
+			name:  "Posted as me",
+			input: "at 9am as me message Hi",
+			want:  &ParsedSchedule{TimeStr: "9am", DateStr: "", As: "me", Message: "Hi"},
+		},
 		{
 			name:  "Date included with multi-line message",
 			input: "at 6pm on 2024-08-15 message First line\nSecond line",
@@ -127,6 +152,17 @@ func main() {
 A [link](http://example.com) too.`,
 			},
 		},
+		{
+			name:  "Date without time uses the default time",
+			input: "on friday remind 15 message Standup notes",
+			want:  &ParsedSchedule{TimeStr: "", DateStr: "friday", Remind: "15", Message: "Standup notes"},
+		},
+		{
+			name:        "Neither time nor date",
+			input:       "message hello",
+			wantErr:     true,
+			errContains: constants.ParserErrInvalidFormat,
+		},
 		{
 			name:        "Missing 'message' keyword",
 			input:       "at 3pm on mon foo bar",
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func (h *Handler) handlePolicy(args *model.CommandArgs, text string) *model.CommandResponse {
+	fields := strings.Fields(strings.ToLower(text))
+	if len(fields) == 0 {
+		return h.showPolicy(args.ChannelId)
+	}
+
+	rule, err := h.policy.GetChannelPolicy(args.ChannelId)
+	if err != nil {
+		h.logger.Error("Failed to get channel policy", "user_id", args.UserId, "channel_id", args.ChannelId, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not load the rules for this channel: %v", constants.EmojiError, err))
+	}
+	updated := &types.ChannelPolicy{ChannelID: args.ChannelId}
+	if rule != nil {
+		updated.Disabled, updated.MaxPending = rule.Disabled, rule.MaxPending
+	}
+
+	switch {
+	case len(fields) == 1 && fields[0] == constants.PolicyEnable:
+		updated.Disabled = false
+	case len(fields) == 1 && fields[0] == constants.PolicyDisable:
+		updated.Disabled = true
+	case len(fields) == 2 && fields[0] == constants.PolicyCap:
+		limit, convErr := strconv.Atoi(fields[1])
+		if convErr != nil || limit < 0 {
+			return errorResponse(fmt.Sprintf("%s %s", constants.EmojiError, constants.ErrPolicyInvalidChannelCap))
+		}
+		updated.MaxPending = limit
+	default:
+		h.logger.Debug("Unknown policy subcommand", "user_id", args.UserId, "text", text)
+		return errorResponse(fmt.Sprintf("Usage: `/%s %s %s`", constants.CommandTrigger, constants.SubcommandPolicy, constants.AutocompletePolicyHint))
+	}
+
+	if err := h.policy.SetChannelPolicy(args.UserId, updated); err != nil {
+		h.logger.Warn("Failed to update channel policy", "user_id", args.UserId, "channel_id", args.ChannelId, "error", err)
+		return errorResponse(fmt.Sprintf("%s %v", constants.EmojiError, err))
+	}
+	return h.showPolicy(args.ChannelId)
+}
+
+func (h *Handler) showPolicy(channelID string) *model.CommandResponse {
+	h.logger.Debug("Showing channel policy", "channel_id", channelID)
+	rule, err := h.policy.GetChannelPolicy(channelID)
+	if err != nil {
+		h.logger.Error("Failed to get channel policy", "channel_id", channelID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not load the rules for this channel: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatChannelPolicy(rule, h.policy.CheckSchedule(channelID)),
+	}
+}
@@ -0,0 +1,65 @@
+package command_test
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func policyArgs(text string) *model.CommandArgs {
+	return &model.CommandArgs{
+		UserId:    "testUserID",
+		ChannelId: "testChannelID",
+		Command:   "/" + constants.CommandTrigger + " " + constants.SubcommandPolicy + text,
+	}
+}
+
+func TestExecute_Policy_Show(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	mocks.policy.ScheduleErr = &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelNotAllowed}
+
+	resp, appErr := handler.Execute(policyArgs(""))
+
+	require.Nil(t, appErr)
+	assert.Contains(t, resp.Text, constants.PolicyHeader)
+	assert.Contains(t, resp.Text, constants.ErrPolicyChannelNotAllowed)
+}
+
+func TestExecute_Policy_DisableKeepsCap(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	mocks.policy.Rules = map[string]*types.ChannelPolicy{"testChannelID": {ChannelID: "testChannelID", MaxPending: 4}}
+
+	resp, _ := handler.Execute(policyArgs(" disable"))
+
+	rule := mocks.policy.Rules["testChannelID"]
+	assert.True(t, rule.Disabled)
+	assert.Equal(t, 4, rule.MaxPending)
+	assert.Contains(t, resp.Text, "Scheduled messages: **off**")
+	assert.Contains(t, resp.Text, "4 pending messages")
+}
+
+func TestExecute_Policy_Cap(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	_, _ = handler.Execute(policyArgs(" cap 3"))
+
+	assert.Equal(t, 3, mocks.policy.Rules["testChannelID"].MaxPending)
+}
+
+func TestExecute_Policy_InvalidCap(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	resp, _ := handler.Execute(policyArgs(" cap lots"))
+
+	assert.Contains(t, resp.Text, constants.ErrPolicyInvalidChannelCap)
+	assert.Empty(t, mocks.policy.Rules)
+}
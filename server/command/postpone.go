@@ -0,0 +1,125 @@
+package command
+
+import (
+	"fmt"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Postpone moves one of the user's messages back by one of the list's
+// postpone options. Someone else's message is reported as not found.
+func (s *ScheduleService) Postpone(userID, msgID, option string) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Postponing scheduled message from list", "user_id", userID, "message_id", msgID, "option", option)
+	return s.reschedule(userID, msgID, func(postAt, now time.Time, loc *time.Location) (time.Time, error) {
+		return postponedTime(postAt, option, now, loc)
+	})
+}
+
+// PostponeBy moves one of the user's messages back by the given amount, as
+// picked from a reminder DM. If the new time leaves room for it, the owner is
+// reminded again. An overdue message is postponed from now, unless the
+// scheduler takes it for sending first, in which case ErrMessageNotFound is
+// returned and the sent message is left alone.
+func (s *ScheduleService) PostponeBy(userID, msgID string, by time.Duration) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Postponing scheduled message by duration", "user_id", userID, "message_id", msgID, "by", by)
+	if by <= 0 {
+		return nil, fmt.Errorf("cannot postpone by %s", by)
+	}
+	return s.reschedule(userID, msgID, func(postAt, now time.Time, _ *time.Location) (time.Time, error) {
+		if postAt.Before(now) {
+			postAt = now
+		}
+		return postAt.Add(by), nil
+	})
+}
+
+// reschedule moves one of the user's messages to the time next works out from
+// its current post time, then runs the same quiet hours and horizon checks as
+// scheduling a new message. The store only saves the change if the message is
+// unchanged since it was read, so a message the scheduler has taken for
+// sending meanwhile is reported as not found rather than written back, as is
+// someone else's message.
+func (s *ScheduleService) reschedule(userID, msgID string, next func(postAt, now time.Time, loc *time.Location) (time.Time, error)) (*types.ScheduledMessage, error) {
+	msg, err := s.store.UpdateScheduledMessage(userID, msgID, func(msg *types.ScheduledMessage) error {
+		if msg.UserID != userID {
+			s.logger.Warn("User tried to postpone someone else's message", "user_id", userID, "message_id", msgID, "owner_user_id", msg.UserID)
+			return types.ErrMessageNotFound
+		}
+		postAt, err := next(msg.PostAt, s.clock.Now().UTC(), messageLocation(msg))
+		if err != nil {
+			return err
+		}
+		return s.Retime(msg, msg.ChannelID, postAt)
+	})
+	if err != nil {
+		s.logger.Debug("Failed to postpone message", "user_id", userID, "message_id", msgID, "error", err)
+		return nil, err
+	}
+	s.logger.Info("Postponed scheduled message", "user_id", userID, "message_id", msgID, "post_at", msg.PostAt)
+	s.events.Notify(types.NewLifecycleEvent(types.EventEdited, msg, userID))
+	return msg, nil
+}
+
+// Retime points msg at channelID and postAt after the quiet hours and horizon
+// checks a new message there gets. A channel that shifts messages out of its
+// quiet hours moves postAt and records the time asked for in ShiftedFrom. If
+// the new time leaves room for it, the owner is reminded again. msg is not
+// saved.
+func (s *ScheduleService) Retime(msg *types.ScheduledMessage, channelID string, postAt time.Time) error {
+	loc := messageLocation(msg)
+	now := s.clock.Now().UTC()
+	postAt, shiftedFrom, err := s.applyQuietHours(msg.UserID, channelID, postAt.In(loc), loc)
+	if err != nil {
+		return err
+	}
+	if err := s.checkHorizon(msg.UserID, postAt, now); err != nil {
+		return err
+	}
+	msg.ChannelID = channelID
+	msg.PostAt = postAt.UTC()
+	msg.ShiftedFrom = shiftedFrom
+	if remindAt, ok := msg.ReminderAt(); ok && remindAt.After(now) {
+		msg.RemindedAt = nil
+	}
+	return nil
+}
+
+func messageLocation(msg *types.ScheduledMessage) *time.Location {
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// postponedTime works out the new post time for a postpone option. An overdue
+// message is postponed from now rather than from its old post time. Tomorrow
+// and next Monday are counted from the day the message is due and keep its
+// time of day in loc.
+func postponedTime(postAt time.Time, option string, now time.Time, loc *time.Location) (time.Time, error) {
+	if postAt.Before(now) {
+		postAt = now
+	}
+	local := postAt.In(loc)
+	atTimeOfDay := func(day time.Time) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), local.Hour(), local.Minute(), 0, 0, loc).UTC()
+	}
+	switch option {
+	case constants.ListPostpone15m:
+		return postAt.Add(15 * time.Minute).UTC(), nil
+	case constants.ListPostpone1h:
+		return postAt.Add(time.Hour).UTC(), nil
+	case constants.ListPostponeTomorrow:
+		return atTimeOfDay(local.AddDate(0, 0, 1)), nil
+	case constants.ListPostponeMonday:
+		days := (8 - int(local.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return atTimeOfDay(local.AddDate(0, 0, days)), nil
+	default:
+		return time.Time{}, fmt.Errorf("unknown postpone option %q", option)
+	}
+}
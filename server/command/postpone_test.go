@@ -0,0 +1,214 @@
+package command
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// expectUpdate has the store apply the update to stored, as it does when the
+// message is unchanged since it was read.
+func expectUpdate(mocks *testMocks, stored *types.ScheduledMessage) {
+	mocks.store.EXPECT().UpdateScheduledMessage(testUserID, testMsgID, gomock.Any()).
+		DoAndReturn(func(_, _ string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+			if err := update(stored); err != nil {
+				return nil, err
+			}
+			return stored, nil
+		})
+}
+
+func TestPostponedTime(t *testing.T) {
+	loc := testutil.MustLoadLocation(t, testTimezone)
+	now := time.Date(2024, 3, 8, 15, 0, 0, 0, time.UTC) // Friday 10:00 in New York
+	// Friday 17:30 in New York, two days before the switch to daylight saving.
+	postAt := time.Date(2024, 3, 8, 17, 30, 0, 0, loc)
+
+	tests := []struct {
+		name   string
+		postAt time.Time
+		option string
+		want   time.Time
+	}{
+		{"15 minutes", postAt, constants.ListPostpone15m, postAt.Add(15 * time.Minute)},
+		{"1 hour", postAt, constants.ListPostpone1h, postAt.Add(time.Hour)},
+		{"overdue message is postponed from now", now.Add(-time.Hour), constants.ListPostpone1h, now.Add(time.Hour)},
+		{"tomorrow keeps the time of day", postAt, constants.ListPostponeTomorrow, time.Date(2024, 3, 9, 17, 30, 0, 0, loc)},
+		{"next Monday across daylight saving", postAt, constants.ListPostponeMonday, time.Date(2024, 3, 11, 17, 30, 0, 0, loc)},
+		{"next Monday from a Monday is a week later", time.Date(2024, 3, 11, 9, 0, 0, 0, loc), constants.ListPostponeMonday, time.Date(2024, 3, 18, 9, 0, 0, 0, loc)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := postponedTime(tc.postAt.UTC(), tc.option, now, loc)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got), "got %s, want %s", got, tc.want)
+		})
+	}
+
+	_, err := postponedTime(postAt, "forever", now, loc)
+	assert.ErrorContains(t, err, "unknown postpone option")
+}
+
+func TestPostpone(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	reminded := testNow.Add(-time.Minute)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, PostAt: testNow.Add(10 * time.Minute), Timezone: testDefaultTZ, ReminderMinutes: 15, RemindedAt: &reminded}
+	expectUpdate(mocks, msg)
+
+	got, err := service.Postpone(testUserID, testMsgID, constants.ListPostpone1h)
+
+	require.NoError(t, err)
+	assert.Equal(t, testNow.Add(70*time.Minute), got.PostAt)
+	assert.Nil(t, got.RemindedAt)
+	events := mocks.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventEdited, events[0].Type)
+}
+
+func TestPostpone_SomeoneElsesMessage(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	expectUpdate(mocks, &types.ScheduledMessage{ID: testMsgID, UserID: "other"})
+
+	_, err := service.Postpone(testUserID, testMsgID, constants.ListPostpone15m)
+
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+}
+
+func TestPostpone_TakenForSending(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.store.EXPECT().UpdateScheduledMessage(testUserID, testMsgID, gomock.Any()).Return(nil, types.ErrMessageNotFound)
+
+	_, err := service.Postpone(testUserID, testMsgID, constants.ListPostpone15m)
+
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestPostpone_ShiftedOutOfQuietHours(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.Quiet = &types.QuietHours{Start: "22:00", End: "07:00", Action: types.QuietHoursShift}
+	postAt := time.Date(2024, 1, 15, 21, 30, 0, 0, time.UTC)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: postAt, Timezone: testDefaultTZ}
+	expectUpdate(mocks, msg)
+
+	got, err := service.Postpone(testUserID, testMsgID, constants.ListPostpone1h)
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 16, 7, 0, 0, 0, time.UTC), got.PostAt)
+	require.NotNil(t, got.ShiftedFrom)
+	assert.Equal(t, postAt.Add(time.Hour), *got.ShiftedFrom)
+}
+
+func TestPostpone_RejectedInQuietHours(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.Quiet = &types.QuietHours{Start: "22:00", End: "07:00", Action: types.QuietHoursReject}
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: time.Date(2024, 1, 15, 21, 30, 0, 0, time.UTC), Timezone: testDefaultTZ}
+	expectUpdate(mocks, msg)
+
+	_, err := service.Postpone(testUserID, testMsgID, constants.ListPostpone1h)
+
+	assert.ErrorContains(t, err, "within the quiet hours")
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestPostpone_BeyondMaxHorizon(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	limits := service.currentLimits()
+	limits.MaxHorizon = 24 * time.Hour
+	service.Configure(limits)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(20 * time.Hour), Timezone: testDefaultTZ}
+	expectUpdate(mocks, msg)
+
+	_, err := service.Postpone(testUserID, testMsgID, constants.ListPostponeTomorrow)
+
+	assert.ErrorContains(t, err, "at most 1 days ahead")
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestPostponeBy_RemindsAgainWhenThereIsRoom(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	reminded := testNow.Add(-5 * time.Minute)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(10 * time.Minute), ReminderMinutes: 15, RemindedAt: &reminded}
+	expectUpdate(mocks, msg)
+
+	got, err := service.PostponeBy(testUserID, testMsgID, time.Hour)
+
+	require.NoError(t, err)
+	assert.True(t, testNow.Add(70*time.Minute).Equal(got.PostAt))
+	assert.Nil(t, got.RemindedAt)
+	events := mocks.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventEdited, events[0].Type)
+}
+
+func TestPostponeBy_KeepsReminderWhenTooClose(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	reminded := testNow.Add(-5 * time.Minute)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(10 * time.Minute), ReminderMinutes: 15, RemindedAt: &reminded}
+	expectUpdate(mocks, msg)
+
+	got, err := service.PostponeBy(testUserID, testMsgID, time.Minute)
+
+	require.NoError(t, err)
+	assert.NotNil(t, got.RemindedAt)
+}
+
+func TestPostponeBy_FromNowWhenOverdue(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(-time.Minute)}
+	expectUpdate(mocks, msg)
+
+	got, err := service.PostponeBy(testUserID, testMsgID, 15*time.Minute)
+
+	require.NoError(t, err)
+	assert.True(t, testNow.Add(15*time.Minute).Equal(got.PostAt))
+}
+
+func TestPostponeBy_OverdueTakenForSending(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.store.EXPECT().UpdateScheduledMessage(testUserID, testMsgID, gomock.Any()).Return(nil, types.ErrMessageNotFound)
+
+	_, err := service.PostponeBy(testUserID, testMsgID, 15*time.Minute)
+
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestPostponeBy_RejectedInQuietHours(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.Quiet = &types.QuietHours{Start: "22:00", End: "07:00", Action: types.QuietHoursReject}
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: time.Date(2024, 1, 15, 21, 30, 0, 0, time.UTC), Timezone: testDefaultTZ}
+	expectUpdate(mocks, msg)
+
+	_, err := service.PostponeBy(testUserID, testMsgID, time.Hour)
+
+	assert.ErrorContains(t, err, "within the quiet hours")
+}
+
+func TestPostponeBy_BeyondMaxHorizon(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	limits := service.currentLimits()
+	limits.MaxHorizon = 24 * time.Hour
+	service.Configure(limits)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(23 * time.Hour), Timezone: testDefaultTZ}
+	expectUpdate(mocks, msg)
+
+	_, err := service.PostponeBy(testUserID, testMsgID, 4*time.Hour)
+
+	assert.ErrorContains(t, err, "at most 1 days ahead")
+}
+
+func TestPostponeBy_NotPositive(t *testing.T) {
+	service, _ := setupScheduleServiceTest(t)
+
+	_, err := service.PostponeBy(testUserID, testMsgID, 0)
+
+	assert.ErrorContains(t, err, "cannot postpone by 0s")
+}
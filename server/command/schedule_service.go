@@ -3,7 +3,9 @@ package command
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
@@ -11,16 +13,22 @@ import (
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/policy"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 type ScheduleService struct {
-	logger          ports.Logger
-	userAPI         ports.UserService
-	store           ports.Store
-	channel         ports.ChannelService
-	clock           ports.Clock
-	maxUserMessages int
+	logger  ports.Logger
+	userAPI ports.UserService
+	store   ports.Store
+	channel ports.ChannelService
+	clock   ports.Clock
+	events  ports.EventNotifier
+	policy  ports.PolicyService
+	pause   ports.PauseService
+	prefs   ports.PreferenceStore
+	mu      sync.RWMutex
+	limits  types.Limits
 }
 
 func NewScheduleService(
@@ -29,19 +37,42 @@ func NewScheduleService(
 	store ports.Store,
 	channel ports.ChannelService,
 	clk ports.Clock,
-	maxUserMessages int,
+	events ports.EventNotifier,
+	policy ports.PolicyService,
+	pause ports.PauseService,
+	prefs ports.PreferenceStore,
+	limits types.Limits,
 ) *ScheduleService {
 	logger.Debug("Creating new ScheduleService")
 	return &ScheduleService{
-		logger:          logger,
-		userAPI:         userAPI,
-		store:           store,
-		channel:         channel,
-		clock:           clk,
-		maxUserMessages: maxUserMessages,
+		logger:  logger,
+		userAPI: userAPI,
+		store:   store,
+		channel: channel,
+		clock:   clk,
+		events:  events,
+		policy:  policy,
+		pause:   pause,
+		prefs:   prefs,
+		limits:  limits,
 	}
 }
 
+// Configure replaces the scheduling limits. Requests already being validated
+// keep the limits they started with.
+func (s *ScheduleService) Configure(limits types.Limits) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limits = limits
+	s.logger.Debug("Schedule service limits configured", "max_user_messages", limits.MaxUserMessages, "max_horizon", limits.MaxHorizon)
+}
+
+func (s *ScheduleService) currentLimits() types.Limits {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.limits
+}
+
 func (s *ScheduleService) Build(args *model.CommandArgs, text string) *model.CommandResponse {
 	s.logger.Debug("Attempting to schedule message", "user_id", args.UserId, "channel_id", args.ChannelId, "text", text)
 
@@ -52,6 +83,10 @@ func (s *ScheduleService) Build(args *model.CommandArgs, text string) *model.Com
 	}
 	s.logger.Debug("Schedule request validated successfully", "user_id", args.UserId)
 
+	if err := s.checkPolicy(args.UserId, args.ChannelId); err != nil {
+		return s.errorResponse(formatter.FormatScheduleValidationError(err))
+	}
+
 	s.logger.Debug("Preparing schedule details", "user_id", args.UserId, "channel_id", args.ChannelId)
 	msg, loc, tz, err := s.prepareSchedule(args.UserId, args.ChannelId, text)
 	if err != nil {
@@ -75,61 +110,91 @@ func (s *ScheduleService) Build(args *model.CommandArgs, text string) *model.Com
 }
 
 func (s *ScheduleService) BuildPost(userID string, channelID string, fileIDs []string, text string) (*model.Post, error) {
-	s.logger.Debug("Attempting to schedule message", "user_id", userID, "channel_id", channelID, "text", text)
+	msg, err := s.ScheduleMessage(userID, channelID, fileIDs, nil, text)
+	if err != nil {
+		return &model.Post{
+			UserId:    userID,
+			ChannelId: channelID,
+			Message:   err.Error(),
+		}, err
+	}
+	return s.BuildConfirmationPost(msg), nil
+}
+
+// BuildConfirmationPost returns the ephemeral post telling the owner that msg
+// was scheduled.
+func (s *ScheduleService) BuildConfirmationPost(msg *types.ScheduledMessage) *model.Post {
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		s.logger.Warn("Failed to load message timezone for confirmation, using UTC", "message_id", msg.ID, "timezone", msg.Timezone, "error", err)
+		loc = time.UTC
+	}
+	return &model.Post{
+		UserId:    msg.UserID,
+		ChannelId: msg.ChannelID,
+		Message:   s.successResponse(msg, msg.PostAt.In(loc), msg.Timezone, msg.ChannelID).Text,
+	}
+}
+
+// ScheduleMessage validates and persists a message for the API, along with
+// the metadata to apply to its post, which may be nil. The returned error
+// text is suitable for showing to the requester.
+func (s *ScheduleService) ScheduleMessage(userID string, channelID string, fileIDs []string, meta *types.PostMetadata, text string) (*types.ScheduledMessage, error) {
+	return s.schedule(userID, "", channelID, fileIDs, meta, text)
+}
+
+// ScheduleIntegrationMessage schedules a message as the token's bot and
+// records the token on it, so only that token can act on it later.
+func (s *ScheduleService) ScheduleIntegrationMessage(token *types.IntegrationToken, channelID string, fileIDs []string, meta *types.PostMetadata, text string) (*types.ScheduledMessage, error) {
+	return s.schedule(token.BotUserID, token.ID, channelID, fileIDs, meta, text)
+}
+
+func (s *ScheduleService) schedule(userID, tokenID, channelID string, fileIDs []string, meta *types.PostMetadata, text string) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Attempting to schedule message", "user_id", userID, "channel_id", channelID, "token_id", tokenID, "text", text)
 
 	s.logger.Debug("Validating schedule request", "user_id", userID)
 	if resp := s.validateAPIRequest(userID, text, fileIDs); resp != nil {
 		s.logger.Error("Schedule request validation failed", "user_id", userID, "reason", resp.Text)
-
-		errMsg := resp.Text
-		return &model.Post{
-			UserId:    userID,
-			ChannelId: channelID,
-			Message:   errMsg,
-		}, errors.New(errMsg)
+		return nil, errors.New(resp.Text)
+	}
+	if err := checkMetadata(meta); err != nil {
+		s.logger.Debug("Schedule request has invalid post metadata", "user_id", userID, "error", err)
+		return nil, errors.New(formatter.FormatScheduleValidationError(err))
 	}
 	s.logger.Debug("Schedule request validated successfully", "user_id", userID)
 
-	s.logger.Debug("Preparing schedule details", "user_id", userID, "channel_id", channelID)
+	if err := s.checkPolicy(userID, channelID); err != nil {
+		return nil, errors.New(formatter.FormatScheduleValidationError(err))
+	}
 
+	s.logger.Debug("Preparing schedule details", "user_id", userID, "channel_id", channelID)
 	msg, loc, tz, err := s.prepareSchedule(userID, channelID, text)
 	if err != nil {
 		errMsg := fmt.Sprintf("Error preparing schedule: %v, Original input: `%v`", err, text)
 		s.logger.Error("Failed to prepare schedule", "user_id", userID, "channel_id", channelID, "error", err, "original_text", text)
-
-		return &model.Post{
-			UserId:    userID,
-			ChannelId: channelID,
-			Message:   errMsg,
-		}, errors.New(errMsg)
+		return nil, errors.New(errMsg)
 	}
 	localTime := msg.PostAt.In(loc)
 	msg.FileIDs = fileIDs
+	if !meta.IsEmpty() {
+		msg.Metadata = meta
+	}
+	msg.IntegrationTokenID = tokenID
 	s.logger.Debug("Schedule details prepared", "user_id", userID, "message_id", msg.ID, "post_at", localTime, "timezone", tz)
 
 	s.logger.Debug("Persisting scheduled message", "user_id", userID, "message_id", msg.ID)
 	if err := s.persist(userID, msg); err != nil {
 		channelLink := s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(channelID))
-		formatted := formatter.FormatScheduleError(localTime, tz, channelLink, err)
 		s.logger.Error("Failed to persist scheduled message", "user_id", userID, "message_id", msg.ID, "error", err)
-
-		return &model.Post{
-			UserId:    userID,
-			ChannelId: channelID,
-			Message:   formatted,
-		}, err
+		return nil, errors.New(formatter.FormatScheduleError(localTime, tz, channelLink, err))
 	}
 	s.logger.Info("Scheduled message persisted successfully", "user_id", userID, "message_id", msg.ID)
-
-	return &model.Post{
-		UserId:    userID,
-		ChannelId: channelID,
-		Message:   s.successResponse(msg, localTime, tz, channelID).Text,
-	}, nil
+	return msg, nil
 }
 
 func (s *ScheduleService) checkMaxUserMessages(userID string) error {
-	s.logger.Debug("Checking max user messages limit", "user_id", userID, "limit", s.maxUserMessages)
+	limit := s.currentLimits().MaxUserMessages
+	s.logger.Debug("Checking max user messages limit", "user_id", userID, "limit", limit)
 	ids, err := s.store.ListUserMessageIDs(userID)
 	if err != nil {
 		s.logger.Error("Failed to list user message IDs for count check", "user_id", userID, "error", err)
@@ -137,65 +202,73 @@ func (s *ScheduleService) checkMaxUserMessages(userID string) error {
 	}
 	count := len(ids)
 	s.logger.Debug("Current user message count", "user_id", userID, "count", count)
-	if count >= s.maxUserMessages {
-		err := fmt.Errorf("cannot schedule more than %d messages (current: %d)", s.maxUserMessages, count)
-		s.logger.Error("User message limit reached", "user_id", userID, "count", count, "limit", s.maxUserMessages)
+	if count >= limit {
+		err := fmt.Errorf("cannot schedule more than %d messages (current: %d)", limit, count)
+		s.logger.Error("User message limit reached", "user_id", userID, "count", count, "limit", limit)
 		return err
 	}
-	s.logger.Debug("User is under message limit", "user_id", userID, "count", count, "limit", s.maxUserMessages)
+	s.logger.Debug("User is under message limit", "user_id", userID, "count", count, "limit", limit)
 	return nil
 }
 
+func (s *ScheduleService) checkPolicy(userID, channelID string) error {
+	s.logger.Debug("Checking scheduling policy", "user_id", userID, "channel_id", channelID)
+	err := s.policy.CheckSchedule(channelID)
+	if errors.Is(err, types.ErrPolicyDenied) {
+		s.logger.Info("Scheduling policy denied message", "user_id", userID, "channel_id", channelID, "reason", err)
+	} else if err != nil {
+		s.logger.Error("Failed to evaluate scheduling policy", "user_id", userID, "channel_id", channelID, "error", err)
+	}
+	return err
+}
+
 func (s *ScheduleService) checkMaxMessageBytes(text string) error {
 	length := len(text)
-	s.logger.Debug("Checking max message bytes", "length", length, "limit", constants.MaxMessageBytes)
-	if length > constants.MaxMessageBytes {
-		kb := float64(constants.MaxMessageBytes) / 1024
+	limit := s.currentLimits().MaxMessageBytes
+	s.logger.Debug("Checking max message bytes", "length", length, "limit", limit)
+	if length > limit {
+		kb := float64(limit) / 1024
 		userKb := float64(length) / 1024
 		err := fmt.Errorf("message length %.2f KB exceeds limit %.2f KB", userKb, kb)
-		s.logger.Error("Message length exceeds limit", "length", length, "limit", constants.MaxMessageBytes)
+		s.logger.Error("Message length exceeds limit", "length", length, "limit", limit)
 		return err
 	}
-	s.logger.Debug("Message length is within limit", "length", length, "limit", constants.MaxMessageBytes)
+	s.logger.Debug("Message length is within limit", "length", length, "limit", limit)
 	return nil
 }
 
 func (s *ScheduleService) checkMaxFileIDs(fileIDs []string) error {
-	s.logger.Debug("Checking max FileIds", "fileIds", fileIDs)
+	limit := s.currentLimits().MaxFileCount
+	s.logger.Debug("Checking max FileIds", "fileIds", fileIDs, "limit", limit)
 	count := len(fileIDs)
-	if count > 10 {
-		err := errors.New("uploads limited to 10 files maximum. please use additional posts for more files")
+	if count > limit {
+		err := fmt.Errorf("uploads limited to %d files maximum. please use additional posts for more files", limit)
 		return err
 	}
 
 	return nil
 }
 
-func (s *ScheduleService) getUserTimezone(userID string) string {
-	s.logger.Debug("Attempting to get user timezone", "user_id", userID)
-	user, err := s.userAPI.Get(userID)
-	if err != nil {
-		s.logger.Warn("Failed to get user object, falling back to default timezone", "user_id", userID, "error", err, "default_timezone", constants.DefaultTimezone)
-		return constants.DefaultTimezone
+// UserTimezone returns the timezone the user picked in their preferences,
+// otherwise the one from their profile, or the configured default when the
+// user has none.
+func (s *ScheduleService) UserTimezone(userID string) string {
+	if prefs := s.preferences(userID); prefs.Timezone != "" {
+		s.logger.Debug("Using timezone from user preferences", "user_id", userID, "timezone", prefs.Timezone)
+		return prefs.Timezone
 	}
+	return userTimezone(s.logger, s.userAPI, userID, s.currentLimits().DefaultTimezone)
+}
 
-	tz := constants.DefaultTimezone
-	source := "default"
-
-	automaticTimezone, aok := user.Timezone["automaticTimezone"]
-	useAutomaticTimezone, uok := user.Timezone["useAutomaticTimezone"]
-	manualTimezone, mok := user.Timezone["manualTimezone"]
-
-	if aok && uok && automaticTimezone != "" && useAutomaticTimezone == "true" {
-		tz = automaticTimezone
-		source = "automatic"
-	} else if mok && manualTimezone != "" {
-		tz = manualTimezone
-		source = "manual"
+// preferences returns the user's preferences, or the defaults when they
+// cannot be read.
+func (s *ScheduleService) preferences(userID string) *types.UserPreferences {
+	prefs, err := s.prefs.GetPreferences(userID)
+	if err != nil {
+		s.logger.Warn("Failed to get user preferences, using defaults", "user_id", userID, "error", err)
+		return &types.UserPreferences{}
 	}
-
-	s.logger.Debug("Determined user timezone", "user_id", userID, "timezone", tz, "source", source)
-	return tz
+	return prefs
 }
 
 func (s *ScheduleService) validateAPIRequest(userID, text string, fileIDs []string) *model.CommandResponse {
@@ -242,6 +315,7 @@ func (s *ScheduleService) persist(userID string, msg *types.ScheduledMessage) er
 	err := s.store.SaveScheduledMessage(userID, msg)
 	if err == nil {
 		s.logger.Debug("Successfully saved scheduled message", "user_id", userID, "message_id", msg.ID)
+		s.events.Notify(types.NewLifecycleEvent(types.EventScheduled, msg, userID))
 	}
 	return err
 }
@@ -264,14 +338,34 @@ func (s *ScheduleService) prepareSchedule(userID, channelID, text string) (*type
 		return nil, nil, "", fmt.Errorf("failed to parse input: %w", parseErr)
 	}
 	s.logger.Debug("Parsed schedule input", "user_id", userID, "parsed_time", parsed.TimeStr, "parsed_date", parsed.DateStr, "message", parsed.Message)
+	if parsed.TimeStr == "" {
+		parsed.TimeStr = constants.DefaultScheduleTime
+		if defaultTime := s.preferences(userID).DefaultTime; defaultTime != "" {
+			parsed.TimeStr = defaultTime
+		}
+		s.logger.Debug("No time given, using default time", "user_id", userID, "default_time", parsed.TimeStr)
+	}
+
+	reminder, err := s.reminderMinutes(userID, parsed.Remind)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	asBot, err := s.deliverAsBot(userID, channelID, parsed.As)
+	if err != nil {
+		return nil, nil, "", err
+	}
 
-	tz := s.getUserTimezone(userID)
+	limits := s.currentLimits()
+	tz := s.UserTimezone(userID)
 	s.logger.Debug("Loading location based on timezone", "user_id", userID, "timezone", tz)
 	loc, locErr := time.LoadLocation(tz)
 	if locErr != nil {
-		s.logger.Warn("Failed to load timezone location, proceeding with UTC", "user_id", userID, "timezone", tz, "error", locErr)
-		loc, _ = time.LoadLocation(constants.DefaultTimezone)
-		tz = constants.DefaultTimezone
+		s.logger.Warn("Failed to load timezone location, proceeding with default", "user_id", userID, "timezone", tz, "default_timezone", limits.DefaultTimezone, "error", locErr)
+		tz = limits.DefaultTimezone
+		if loc, locErr = time.LoadLocation(tz); locErr != nil {
+			loc, tz = time.UTC, constants.DefaultTimezone
+		}
 	}
 
 	now := s.clock.Now().In(loc)
@@ -283,23 +377,154 @@ func (s *ScheduleService) prepareSchedule(userID, channelID, text string) (*type
 	}
 	s.logger.Debug("Resolved scheduled time", "user_id", userID, "scheduled_time_local", schedTime, "scheduled_time_utc", schedTime.UTC())
 
+	schedTime, shiftedFrom, quietErr := s.applyQuietHours(userID, channelID, schedTime, loc)
+	if quietErr != nil {
+		return nil, nil, "", quietErr
+	}
+
+	if err := s.checkHorizon(userID, schedTime, now); err != nil {
+		return nil, nil, "", err
+	}
+
 	msgID := s.store.GenerateMessageID()
 	msg := &types.ScheduledMessage{
-		ID:             msgID,
-		UserID:         userID,
-		ChannelID:      channelID,
-		PostAt:         schedTime.UTC(),
-		MessageContent: parsed.Message,
-		Timezone:       tz,
+		ID:              msgID,
+		UserID:          userID,
+		ChannelID:       channelID,
+		PostAt:          schedTime.UTC(),
+		MessageContent:  parsed.Message,
+		Timezone:        tz,
+		ShiftedFrom:     shiftedFrom,
+		ReminderMinutes: reminder,
+		AsBot:           asBot,
+	}
+	if remindAt, ok := msg.ReminderAt(); ok && !remindAt.After(now) {
+		s.logger.Debug("Dropping reminder that would already be due", "user_id", userID, "message_id", msg.ID, "reminder_minutes", reminder)
+		msg.ReminderMinutes = 0
 	}
 	s.logger.Debug("Prepared scheduled message object", "user_id", userID, "message_id", msg.ID, "channel_id", msg.ChannelID, "post_at_utc", msg.PostAt, "timezone", msg.Timezone)
 	return msg, loc, tz, nil
 }
 
+// reminderMinutes resolves the reminder for a new message: the one given in
+// the command if any, otherwise the user's default.
+func (s *ScheduleService) reminderMinutes(userID, remind string) (int, error) {
+	switch remind {
+	case constants.SettingsOff:
+		return 0, nil
+	case "":
+		prefs, err := s.prefs.GetPreferences(userID)
+		if err != nil {
+			s.logger.Warn("Failed to get user preferences, scheduling without a reminder", "user_id", userID, "error", err)
+			return 0, nil
+		}
+		return prefs.ReminderMinutes, nil
+	}
+	minutes, err := ParseReminderMinutes(remind)
+	if err != nil {
+		s.logger.Debug("Invalid reminder in schedule command", "user_id", userID, "remind", remind)
+		return 0, err
+	}
+	return minutes, nil
+}
+
+// deliverAsBot reports whether a new message should be posted as the bot. The
+// bot cannot join direct or group messages, so it cannot post in them.
+func (s *ScheduleService) deliverAsBot(userID, channelID, as string) (bool, error) {
+	if as != constants.DeliverAsBot {
+		return false, nil
+	}
+	if err := s.policy.CheckBotDelivery(); err != nil {
+		s.logger.Debug("Refusing to schedule message as the bot", "user_id", userID, "channel_id", channelID, "error", err)
+		return false, err
+	}
+	info := s.channel.GetInfoOrUnknown(channelID)
+	if info.ChannelType == model.ChannelTypeDirect || info.ChannelType == model.ChannelTypeGroup {
+		s.logger.Debug("Refusing to schedule message as the bot in a direct or group message", "user_id", userID, "channel_id", channelID)
+		return false, errors.New(constants.ErrBotDeliveryDirect)
+	}
+	return true, nil
+}
+
+// ParseReminderMinutes parses a reminder lead time given in minutes, where
+// "off" or 0 means no reminder.
+func ParseReminderMinutes(text string) (int, error) {
+	if strings.EqualFold(text, constants.SettingsOff) {
+		return 0, nil
+	}
+	minutes, err := strconv.Atoi(text)
+	if err != nil || minutes < 0 || minutes > constants.MaxReminderMinutes {
+		return 0, fmt.Errorf(constants.ErrReminderInvalid, constants.MaxReminderMinutes)
+	}
+	return minutes, nil
+}
+
+// checkHorizon refuses a post time further ahead of now than the configured
+// maximum horizon.
+func (s *ScheduleService) checkHorizon(userID string, schedTime, now time.Time) error {
+	limits := s.currentLimits()
+	if limits.MaxHorizon > 0 && schedTime.Sub(now) > limits.MaxHorizon {
+		days := int(limits.MaxHorizon.Hours() / 24)
+		s.logger.Error("Scheduled time is beyond the maximum horizon", "user_id", userID, "scheduled_time_utc", schedTime.UTC(), "max_horizon", limits.MaxHorizon)
+		return fmt.Errorf("messages can be scheduled at most %d days ahead", days)
+	}
+	return nil
+}
+
+// applyQuietHours checks schedTime against the channel's quiet hours. Depending
+// on the rule it either moves the message to the next allowed time, returning
+// the original time as well, or refuses it with a suggested time.
+func (s *ScheduleService) applyQuietHours(userID, channelID string, schedTime time.Time, loc *time.Location) (time.Time, *time.Time, error) {
+	rule, err := s.policy.QuietHours(channelID)
+	if err != nil {
+		s.logger.Error("Failed to get quiet hours", "user_id", userID, "channel_id", channelID, "error", err)
+		return time.Time{}, nil, err
+	}
+	if rule == nil {
+		return schedTime, nil, nil
+	}
+	next, err := policy.NextAllowed(rule, schedTime, loc)
+	if err != nil {
+		s.logger.Warn("Quiet hours leave no allowed time", "user_id", userID, "channel_id", channelID)
+		return time.Time{}, nil, err
+	}
+	if next.Equal(schedTime) {
+		return schedTime, nil, nil
+	}
+	next = next.In(loc)
+	if rule.Action == types.QuietHoursShift {
+		s.logger.Debug("Shifting message out of quiet hours", "user_id", userID, "channel_id", channelID, "requested_utc", schedTime.UTC(), "shifted_utc", next.UTC())
+		requested := schedTime.UTC()
+		return next, &requested, nil
+	}
+	s.logger.Debug("Rejecting message inside quiet hours", "user_id", userID, "channel_id", channelID, "requested_utc", schedTime.UTC(), "next_allowed_utc", next.UTC())
+	return time.Time{}, nil, fmt.Errorf("%s is within the quiet hours for this channel (%s). The next allowed time is %s -- try `at %s on %s`",
+		schedTime.Format(constants.TimeLayout), formatter.FormatQuietHours(rule), next.Format(constants.TimeLayout),
+		next.Format("3:04PM"), next.Format(constants.DateParseLayoutYYYYMMDD))
+}
+
 func (s *ScheduleService) successResponse(msg *types.ScheduledMessage, localTime time.Time, tz, channelID string) *model.CommandResponse {
 	s.logger.Debug("Formatting success response", "user_id", msg.UserID, "message_id", msg.ID, "channel_id", channelID, "timezone", tz)
 	channelLink := s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(channelID))
-	text := formatter.FormatScheduleSuccess(localTime, tz, channelLink)
+	layout := formatter.TimeLayout(s.preferences(msg.UserID).Use24Hour)
+	text := formatter.FormatScheduleSuccess(localTime, layout, tz, channelLink)
+	if msg.ShiftedFrom != nil {
+		text += ". " + formatter.FormatQuietHoursShifted(msg.ShiftedFrom.In(localTime.Location()))
+	}
+	if msg.ReminderMinutes > 0 {
+		text += ". " + formatter.FormatReminderNote(msg.ReminderMinutes)
+	}
+	if msg.AsBot {
+		if !strings.HasSuffix(text, ".") {
+			text += "."
+		}
+		text += " " + formatter.FormatBotDeliveryNote()
+	}
+	if state, err := s.pause.State(); err != nil {
+		s.logger.Warn("Failed to check delivery pause for confirmation", "user_id", msg.UserID, "message_id", msg.ID, "error", err)
+	} else if state != nil {
+		text += "\n\n" + formatter.FormatDeliveryPausedWarning(state.ResumeAt, localTime.Location())
+	}
 	s.logger.Debug("Formatted success response text", "user_id", msg.UserID, "message_id", msg.ID, "response_text", text)
 	return &model.CommandResponse{
 		ResponseType: model.CommandResponseTypeEphemeral,
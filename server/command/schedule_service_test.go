@@ -40,6 +40,10 @@ type testMocks struct {
 	store   *mock.MockStore
 	channel *mock.MockChannelService
 	clock   *testutil.FakeClock
+	events  *testutil.FakeNotifier
+	policy  *testutil.FakePolicy
+	pause   *testutil.FakePause
+	prefs   *testutil.FakePreferences
 	logger  *testutil.FakeLogger
 }
 
@@ -52,6 +56,10 @@ func setupScheduleServiceTest(t *testing.T) (*ScheduleService, *testMocks) {
 		store:   mock.NewMockStore(ctrl),
 		channel: mock.NewMockChannelService(ctrl),
 		clock:   &testutil.FakeClock{NowTime: testNow},
+		events:  &testutil.FakeNotifier{},
+		policy:  &testutil.FakePolicy{},
+		pause:   &testutil.FakePause{},
+		prefs:   &testutil.FakePreferences{},
 		logger:  &testutil.FakeLogger{},
 	}
 
@@ -61,7 +69,16 @@ func setupScheduleServiceTest(t *testing.T) (*ScheduleService, *testMocks) {
 		mocks.store,
 		mocks.channel,
 		mocks.clock,
-		testMaxUserMsgs,
+		mocks.events,
+		mocks.policy,
+		mocks.pause,
+		mocks.prefs,
+		types.Limits{
+			MaxUserMessages: testMaxUserMsgs,
+			MaxMessageBytes: constants.MaxMessageBytes,
+			MaxFileCount:    constants.MaxFileCount,
+			DefaultTimezone: testDefaultTZ,
+		},
 	)
 	require.NotNil(t, service)
 	return service, mocks
@@ -82,7 +99,8 @@ func TestNewScheduleService(t *testing.T) {
 	assert.Equal(t, mocks.store, service.store)
 	assert.Equal(t, mocks.channel, service.channel)
 	assert.Equal(t, mocks.clock, service.clock)
-	assert.Equal(t, testMaxUserMsgs, service.maxUserMessages)
+	assert.Equal(t, mocks.events, service.events)
+	assert.Equal(t, testMaxUserMsgs, service.limits.MaxUserMessages)
 }
 
 func TestBuild_HappyPath(t *testing.T) {
@@ -113,8 +131,13 @@ func TestBuild_HappyPath(t *testing.T) {
 
 	require.NotNil(t, resp)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
-	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, testTimezone, testFormattedLink)
+	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, constants.TimeLayout, testTimezone, testFormattedLink)
 	assert.Equal(t, expectedSuccessMsg, resp.Text)
+	events := mocks.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventScheduled, events[0].Type)
+	assert.Equal(t, testMsgID, events[0].MessageID)
+	assert.Equal(t, testUserID, events[0].ActorID)
 }
 
 func TestBuild_TimezoneLogic_DefaultUsed_NoSettings(t *testing.T) {
@@ -142,7 +165,7 @@ func TestBuild_TimezoneLogic_DefaultUsed_NoSettings(t *testing.T) {
 
 	require.NotNil(t, resp)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
-	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, testDefaultTZ, testFormattedLink)
+	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, constants.TimeLayout, testDefaultTZ, testFormattedLink)
 	assert.Equal(t, expectedSuccessMsg, resp.Text)
 }
 
@@ -177,7 +200,7 @@ func TestBuild_TimezoneLogic_ManualUsed(t *testing.T) {
 
 	require.NotNil(t, resp)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
-	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, manualTZ, testFormattedLink)
+	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, constants.TimeLayout, manualTZ, testFormattedLink)
 	assert.Equal(t, expectedSuccessMsg, resp.Text)
 }
 
@@ -220,6 +243,7 @@ func TestBuild_PersistenceFailure_StoreSaveError(t *testing.T) {
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
 	expectedFormattedErr := formatter.FormatScheduleError(expectedPostAtLocal, testTimezone, testFormattedLink, saveErr)
 	assert.Equal(t, expectedFormattedErr, resp.Text)
+	assert.Empty(t, mocks.events.Events())
 }
 
 func TestBuild_TimezoneLogic_AutomaticUsed(t *testing.T) {
@@ -253,7 +277,7 @@ func TestBuild_TimezoneLogic_AutomaticUsed(t *testing.T) {
 
 	require.NotNil(t, resp)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
-	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, autoTZ, testFormattedLink)
+	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, constants.TimeLayout, autoTZ, testFormattedLink)
 	assert.Equal(t, expectedSuccessMsg, resp.Text)
 }
 
@@ -319,7 +343,7 @@ func TestBuild_PreparationFailure_UserTimezoneFetchError(t *testing.T) {
 
 	require.NotNil(t, resp)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
-	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, testDefaultTZ, testFormattedLink)
+	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, constants.TimeLayout, testDefaultTZ, testFormattedLink)
 	assert.Equal(t, expectedSuccessMsg, resp.Text) // Response should show UTC
 }
 
@@ -349,7 +373,7 @@ func TestBuild_PreparationFailure_InvalidUserTimezone(t *testing.T) {
 
 	require.NotNil(t, resp)
 	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
-	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, testDefaultTZ, testFormattedLink) // Show UTC in response
+	expectedSuccessMsg := formatter.FormatScheduleSuccess(expectedPostAtLocal, constants.TimeLayout, testDefaultTZ, testFormattedLink) // Show UTC in response
 	assert.Equal(t, expectedSuccessMsg, resp.Text)
 }
 
@@ -403,3 +427,374 @@ func TestBuild_ValidationFailure_ErrorCheckingMaxUserMessages(t *testing.T) {
 	expectedFormattedErr := formatter.FormatScheduleValidationError(expectedErr)
 	assert.Equal(t, expectedFormattedErr, resp.Text)
 }
+
+func TestScheduleMessage_HappyPath(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	text := "at 3:00PM on 2024-01-16 message Deploy window opens"
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.AssignableToTypeOf(&types.ScheduledMessage{})).Return(nil)
+
+	msg, err := service.ScheduleMessage(testUserID, testChannelID, []string{"f1"}, nil, text)
+
+	require.NoError(t, err)
+	assert.Equal(t, testMsgID, msg.ID)
+	assert.Equal(t, testDefaultTZ, msg.Timezone)
+	assert.True(t, time.Date(2024, 1, 16, 15, 0, 0, 0, time.UTC).Equal(msg.PostAt))
+	assert.Equal(t, []string{"f1"}, msg.FileIDs)
+	require.Len(t, mocks.events.Events(), 1)
+}
+
+func TestScheduleIntegrationMessage_RecordsToken(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	token := &types.IntegrationToken{ID: "tok1", BotUserID: testUserID, ChannelIDs: []string{testChannelID}}
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.AssignableToTypeOf(&types.ScheduledMessage{})).
+		DoAndReturn(func(_ string, msg *types.ScheduledMessage) error {
+			assert.Equal(t, "tok1", msg.IntegrationTokenID)
+			return nil
+		})
+
+	msg, err := service.ScheduleIntegrationMessage(token, testChannelID, nil, nil, "at 3:00PM on 2024-01-16 message Deploy window opens")
+
+	require.NoError(t, err)
+	assert.Equal(t, testUserID, msg.UserID)
+	assert.Equal(t, "tok1", msg.IntegrationTokenID)
+}
+
+func TestScheduleMessage_ValidationFailure(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+
+	msg, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "   ")
+
+	require.Error(t, err)
+	assert.Nil(t, msg)
+	assert.Equal(t, formatter.FormatEmptyCommandError(), err.Error())
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestConfigure_AppliesNewLimits(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	service.Configure(types.Limits{
+		MaxUserMessages: testMaxUserMsgs,
+		MaxMessageBytes: 20,
+		MaxFileCount:    1,
+		DefaultTimezone: "Asia/Seoul",
+	})
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil).Times(2)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, []string{"f1", "f2"}, nil, "at 3pm message hi")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "uploads limited to 1 files maximum")
+
+	_, err = service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3pm message this is too long")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "exceeds limit 0.02 KB")
+
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+	assert.Equal(t, "Asia/Seoul", service.UserTimezone(testUserID))
+}
+
+func TestScheduleMessage_BeyondMaxHorizon(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	limits := service.currentLimits()
+	limits.MaxHorizon = 7 * 24 * time.Hour
+	service.Configure(limits)
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil).Times(2)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil).Times(2)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 9am on 2024-01-23 message too far")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at most 7 days ahead")
+
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.Any()).Return(nil)
+	msg, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 9am on 2024-01-22 message within range")
+	require.NoError(t, err)
+	assert.Equal(t, testMsgID, msg.ID)
+}
+
+func TestBuild_PolicyDenied(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.ScheduleErr = &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelNotAllowed}
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+
+	resp := service.Build(defaultArgs(), "at 3pm message hi")
+
+	assert.Equal(t, formatter.FormatScheduleValidationError(mocks.policy.ScheduleErr), resp.Text)
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestScheduleMessage_PolicyDenied(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.ScheduleErr = &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelDisabled}
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3pm message hi")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), constants.ErrPolicyChannelDisabled)
+}
+
+func TestScheduleMessage_QuietHoursShift(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.Quiet = &types.QuietHours{Start: "22:00", End: "07:00", Action: types.QuietHoursShift}
+	channelInfo := &ports.ChannelInfo{ChannelID: testChannelID}
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.Any()).Return(nil)
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(channelInfo)
+	mocks.channel.EXPECT().MakeChannelLink(channelInfo).Return(testFormattedLink)
+
+	msg, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3am on 2024-01-16 message hi")
+
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 16, 7, 0, 0, 0, time.UTC), msg.PostAt)
+	require.NotNil(t, msg.ShiftedFrom)
+	assert.Equal(t, time.Date(2024, 1, 16, 3, 0, 0, 0, time.UTC), *msg.ShiftedFrom)
+	post := service.BuildConfirmationPost(msg)
+	assert.Contains(t, post.Message, "moved from Jan 16, 2024 3:00 AM because of quiet hours")
+}
+
+func TestScheduleMessage_QuietHoursReject(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.Quiet = &types.QuietHours{Start: "22:00", End: "07:00", Action: types.QuietHoursReject}
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 11pm on 2024-01-16 message hi")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "quiet hours for this channel (22:00-07:00)")
+	assert.Contains(t, err.Error(), "`at 7:00AM on 2024-01-17`")
+	assert.Empty(t, mocks.events.Events())
+}
+
+func TestScheduleMessage_OutsideQuietHours(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.Quiet = &types.QuietHours{Start: "22:00", End: "07:00", Action: types.QuietHoursReject}
+
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.Any()).Return(nil)
+
+	msg, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 9am on 2024-01-16 message hi")
+
+	require.NoError(t, err)
+	assert.Nil(t, msg.ShiftedFrom)
+}
+
+func TestBuildConfirmationPost_WarnsWhenDeliveryPaused(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	resumeAt := time.Date(2024, 1, 16, 22, 0, 0, 0, time.UTC)
+	mocks.pause.Current = &types.PauseState{PausedBy: "admin", PausedAt: testNow, ResumeAt: &resumeAt}
+	channelInfo := &ports.ChannelInfo{ChannelID: testChannelID}
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(channelInfo)
+	mocks.channel.EXPECT().MakeChannelLink(channelInfo).Return(testFormattedLink)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: resumeAt.Add(-time.Hour), Timezone: testTimezone}
+
+	post := service.BuildConfirmationPost(msg)
+
+	loc := testutil.MustLoadLocation(t, testTimezone)
+	assert.Contains(t, post.Message, formatter.FormatScheduleSuccess(msg.PostAt.In(loc), constants.TimeLayout, testTimezone, testFormattedLink))
+	assert.Contains(t, post.Message, formatter.FormatDeliveryPausedWarning(&resumeAt, loc))
+}
+
+func expectScheduled(mocks *testMocks) *types.ScheduledMessage {
+	var saved types.ScheduledMessage
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.userAPI.EXPECT().Get(testUserID).Return(&model.User{}, nil)
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.Any()).DoAndReturn(func(_ string, msg *types.ScheduledMessage) error {
+		saved = *msg
+		return nil
+	})
+	return &saved
+}
+
+func TestScheduleMessage_ReminderFromUserDefault(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.prefs.Prefs = map[string]*types.UserPreferences{testUserID: {ReminderMinutes: 30}}
+	saved := expectScheduled(mocks)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM on 2024-01-16 message Launch")
+
+	require.NoError(t, err)
+	assert.Equal(t, 30, saved.ReminderMinutes)
+}
+
+func TestScheduleMessage_ReminderOverriddenPerMessage(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.prefs.Prefs = map[string]*types.UserPreferences{testUserID: {ReminderMinutes: 30}}
+	saved := expectScheduled(mocks)
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM on 2024-01-16 remind 5 message Launch")
+	require.NoError(t, err)
+	assert.Equal(t, 5, saved.ReminderMinutes)
+
+	saved = expectScheduled(mocks)
+	_, err = service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM on 2024-01-16 remind off message Launch")
+	require.NoError(t, err)
+	assert.Zero(t, saved.ReminderMinutes)
+}
+
+func TestScheduleMessage_ReminderAlreadyDueIsDropped(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	saved := expectScheduled(mocks)
+
+	// testNow is 10:00 UTC, so a 2 hour reminder for 11:00 would already be due.
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 11:00AM remind 120 message Standup")
+
+	require.NoError(t, err)
+	assert.Zero(t, saved.ReminderMinutes)
+}
+
+func TestScheduleMessage_ReminderInvalid(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM remind 99999 message Launch")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "number of minutes")
+}
+
+func TestScheduleMessage_Metadata(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	saved := expectScheduled(mocks)
+	meta := &types.PostMetadata{Priority: "urgent", RequestedAck: true, Props: map[string]any{"release": "2.0"}}
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, meta, "at 3:00PM on 2024-01-16 message Launch")
+
+	require.NoError(t, err)
+	assert.Equal(t, meta, saved.Metadata)
+
+	saved = expectScheduled(mocks)
+	_, err = service.ScheduleMessage(testUserID, testChannelID, nil, &types.PostMetadata{}, "at 3:00PM on 2024-01-16 message Launch")
+	require.NoError(t, err)
+	assert.Nil(t, saved.Metadata)
+}
+
+func TestScheduleMessage_InvalidMetadata(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, &types.PostMetadata{Priority: "high"}, "at 3:00PM message Launch")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), constants.ErrMetadataPriority)
+}
+
+func TestScheduleMessage_AsBot(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	saved := expectScheduled(mocks)
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(&ports.ChannelInfo{ChannelID: testChannelID, ChannelType: model.ChannelTypeOpen})
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM on 2024-01-16 as bot message Release notes")
+
+	require.NoError(t, err)
+	assert.True(t, saved.AsBot)
+	assert.Equal(t, "Release notes", saved.MessageContent)
+}
+
+func TestScheduleMessage_AsBotTurnedOff(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.policy.BotDeliveryErr = &types.PolicyDeniedError{Reason: constants.ErrPolicyBotDeliveryOff}
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM as bot message Release notes")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), constants.ErrPolicyBotDeliveryOff)
+}
+
+func TestScheduleMessage_AsBotInDirectMessage(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(&ports.ChannelInfo{ChannelID: testChannelID, ChannelType: model.ChannelTypeDirect})
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 3:00PM as bot message Hi")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), constants.ErrBotDeliveryDirect)
+}
+
+func TestBuildConfirmationPost_MentionsBotDelivery(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(&ports.ChannelInfo{})
+	mocks.channel.EXPECT().MakeChannelLink(gomock.Any()).Return(testFormattedLink)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(time.Hour), Timezone: testTimezone, AsBot: true}
+
+	post := service.BuildConfirmationPost(msg)
+
+	assert.True(t, strings.HasSuffix(post.Message, testFormattedLink+". "+formatter.FormatBotDeliveryNote()), post.Message)
+}
+
+func TestBuildConfirmationPost_MentionsReminder(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(&ports.ChannelInfo{})
+	mocks.channel.EXPECT().MakeChannelLink(gomock.Any()).Return(testFormattedLink)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: testNow.Add(time.Hour), Timezone: testTimezone, ReminderMinutes: 15}
+
+	post := service.BuildConfirmationPost(msg)
+
+	assert.Contains(t, post.Message, formatter.FormatReminderNote(15))
+}
+
+func TestScheduleMessage_DefaultTime(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	saved := expectScheduled(mocks)
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "on 2024-01-16 message Launch")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC), saved.PostAt)
+
+	mocks.prefs.Prefs = map[string]*types.UserPreferences{testUserID: {DefaultTime: "17:30"}}
+	saved = expectScheduled(mocks)
+	_, err = service.ScheduleMessage(testUserID, testChannelID, nil, nil, "on 2024-01-16 message Launch")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 16, 17, 30, 0, 0, time.UTC), saved.PostAt)
+}
+
+func TestScheduleMessage_TimezoneFromPreferences(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.prefs.Prefs = map[string]*types.UserPreferences{testUserID: {Timezone: "Asia/Seoul"}}
+	mocks.store.EXPECT().ListUserMessageIDs(testUserID).Return(nil, nil)
+	mocks.store.EXPECT().GenerateMessageID().Return(testMsgID)
+	var saved types.ScheduledMessage
+	mocks.store.EXPECT().SaveScheduledMessage(testUserID, gomock.Any()).DoAndReturn(func(_ string, msg *types.ScheduledMessage) error {
+		saved = *msg
+		return nil
+	})
+
+	_, err := service.ScheduleMessage(testUserID, testChannelID, nil, nil, "at 9:00AM on 2024-01-16 message Launch")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Seoul", saved.Timezone)
+	assert.Equal(t, time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), saved.PostAt)
+}
+
+func TestBuildConfirmationPost_24HourClock(t *testing.T) {
+	service, mocks := setupScheduleServiceTest(t)
+	mocks.prefs.Prefs = map[string]*types.UserPreferences{testUserID: {Use24Hour: true}}
+	mocks.channel.EXPECT().GetInfoOrUnknown(testChannelID).Return(&ports.ChannelInfo{})
+	mocks.channel.EXPECT().MakeChannelLink(gomock.Any()).Return(testFormattedLink)
+	msg := &types.ScheduledMessage{ID: testMsgID, UserID: testUserID, ChannelID: testChannelID, PostAt: time.Date(2024, 1, 16, 20, 0, 0, 0, time.UTC), Timezone: testDefaultTZ}
+
+	post := service.BuildConfirmationPost(msg)
+
+	assert.Contains(t, post.Message, "Jan 16, 2024 20:00")
+}
@@ -0,0 +1,217 @@
+package command
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func (h *Handler) handleSettings(args *model.CommandArgs, text string) *model.CommandResponse {
+	fields := strings.Fields(strings.ToLower(text))
+	switch {
+	case len(fields) == 0:
+		return h.openSettingsDialog(args)
+	case len(fields) == 1 && fields[0] == constants.SettingsFeed:
+		return h.showFeed(args.UserId)
+	case len(fields) == 2 && fields[0] == constants.SettingsFeed && fields[1] == constants.SettingsFeedRotate:
+		return h.rotateFeed(args.UserId)
+	case len(fields) == 1 && fields[0] == constants.SettingsReminder:
+		return h.showReminder(args.UserId)
+	case len(fields) == 2 && fields[0] == constants.SettingsReminder:
+		return h.setReminder(args.UserId, fields[1])
+	case len(fields) == 1 && fields[0] == constants.SettingsConfirmations:
+		return h.showConfirmations(args.UserId)
+	case len(fields) == 2 && fields[0] == constants.SettingsConfirmations && (fields[1] == constants.SettingsOn || fields[1] == constants.SettingsOff):
+		return h.setConfirmations(args.UserId, fields[1] == constants.SettingsOn)
+	case len(fields) == 1 && fields[0] == constants.SettingsDigest:
+		return h.showDigest(args.UserId)
+	case len(fields) == 2 && fields[0] == constants.SettingsDigest && isDigestFrequency(fields[1]):
+		return h.setDigest(args.UserId, fields[1])
+	default:
+		h.logger.Debug("Unknown settings subcommand", "user_id", args.UserId, "text", text)
+		return errorResponse(formatter.FormatUnknownSettingsCommand(text))
+	}
+}
+
+// openSettingsDialog opens the settings dialog, or lists the user's settings
+// when there is no dialog to open, as when the command comes from outside the
+// webapp.
+func (h *Handler) openSettingsDialog(args *model.CommandArgs) *model.CommandResponse {
+	h.logger.Debug("Opening settings dialog", "user_id", args.UserId)
+	if args.TriggerId != "" {
+		dialog, err := h.preferences.Dialog(args.UserId)
+		if err == nil {
+			err = h.dialogs.OpenInteractiveDialog(model.OpenDialogRequest{
+				TriggerId: args.TriggerId,
+				URL:       constants.PreferencesDialogURL,
+				Dialog:    *dialog,
+			})
+		}
+		if err == nil {
+			return &model.CommandResponse{}
+		}
+		h.logger.Warn("Failed to open settings dialog, listing settings instead", "user_id", args.UserId, "error", err)
+	}
+	prefs, err := h.preferences.Get(args.UserId)
+	if err != nil {
+		h.logger.Error("Failed to get user preferences", "user_id", args.UserId, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not get your settings: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatPreferences(prefs, false),
+	}
+}
+
+func (h *Handler) showFeed(userID string) *model.CommandResponse {
+	h.logger.Debug("Showing calendar feed URL", "user_id", userID)
+	url, err := h.feed.URL(userID)
+	if err != nil {
+		h.logger.Error("Failed to get calendar feed URL", "user_id", userID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not get your calendar feed: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatFeedURL(url, false),
+	}
+}
+
+func (h *Handler) rotateFeed(userID string) *model.CommandResponse {
+	h.logger.Debug("Rotating calendar feed URL", "user_id", userID)
+	url, err := h.feed.Rotate(userID)
+	if err != nil {
+		h.logger.Error("Failed to rotate calendar feed URL", "user_id", userID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not replace your calendar feed link: %v", constants.EmojiError, err))
+	}
+	h.logger.Info("User rotated calendar feed URL", "user_id", userID)
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatFeedURL(url, true),
+	}
+}
+
+func (h *Handler) showReminder(userID string) *model.CommandResponse {
+	h.logger.Debug("Showing default reminder", "user_id", userID)
+	prefs, err := h.preferences.Get(userID)
+	if err != nil {
+		h.logger.Error("Failed to get user preferences", "user_id", userID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not get your reminder setting: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatReminderSetting(prefs.ReminderMinutes, false),
+	}
+}
+
+func (h *Handler) setReminder(userID, value string) *model.CommandResponse {
+	h.logger.Debug("Setting default reminder", "user_id", userID, "value", value)
+	minutes, err := ParseReminderMinutes(value)
+	if err != nil {
+		return errorResponse(fmt.Sprintf("%s %v", constants.EmojiError, err))
+	}
+	prefs, err := h.updatePreferences(userID, func(prefs *types.UserPreferences) {
+		prefs.ReminderMinutes = minutes
+	})
+	if err != nil {
+		return h.preferenceErrorResponse(userID, "reminder", err)
+	}
+	h.logger.Info("User changed default reminder", "user_id", userID, "reminder_minutes", prefs.ReminderMinutes)
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatReminderSetting(prefs.ReminderMinutes, true),
+	}
+}
+
+func (h *Handler) showConfirmations(userID string) *model.CommandResponse {
+	h.logger.Debug("Showing delivery confirmation setting", "user_id", userID)
+	prefs, err := h.preferences.Get(userID)
+	if err != nil {
+		h.logger.Error("Failed to get user preferences", "user_id", userID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not get your confirmation setting: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatConfirmationSetting(prefs.ConfirmDelivery, false),
+	}
+}
+
+func (h *Handler) setConfirmations(userID string, enabled bool) *model.CommandResponse {
+	h.logger.Debug("Setting delivery confirmations", "user_id", userID, "enabled", enabled)
+	prefs, err := h.updatePreferences(userID, func(prefs *types.UserPreferences) {
+		prefs.ConfirmDelivery = enabled
+	})
+	if err != nil {
+		return h.preferenceErrorResponse(userID, "confirmation", err)
+	}
+	h.logger.Info("User changed delivery confirmations", "user_id", userID, "enabled", prefs.ConfirmDelivery)
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatConfirmationSetting(prefs.ConfirmDelivery, true),
+	}
+}
+
+func isDigestFrequency(value string) bool {
+	return value == string(types.DigestDaily) || value == string(types.DigestWeekly) || value == constants.SettingsOff
+}
+
+func (h *Handler) showDigest(userID string) *model.CommandResponse {
+	h.logger.Debug("Showing digest setting", "user_id", userID)
+	prefs, err := h.preferences.Get(userID)
+	if err != nil {
+		h.logger.Error("Failed to get user preferences", "user_id", userID, "error", err)
+		return errorResponse(fmt.Sprintf("%s Could not get your digest setting: %v", constants.EmojiError, err))
+	}
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatDigestSetting(prefs.Digest, false),
+	}
+}
+
+func (h *Handler) setDigest(userID string, value string) *model.CommandResponse {
+	frequency := types.DigestFrequency(value)
+	if value == constants.SettingsOff {
+		frequency = types.DigestOff
+	}
+	h.logger.Debug("Setting digest frequency", "user_id", userID, "frequency", frequency)
+	prefs, err := h.updatePreferences(userID, func(prefs *types.UserPreferences) {
+		prefs.Digest = frequency
+	})
+	if err != nil {
+		return h.preferenceErrorResponse(userID, "digest", err)
+	}
+	h.logger.Info("User changed digest frequency", "user_id", userID, "frequency", prefs.Digest)
+	return &model.CommandResponse{
+		ResponseType: model.CommandResponseTypeEphemeral,
+		Text:         formatter.FormatDigestSetting(prefs.Digest, true),
+	}
+}
+
+// updatePreferences applies change to the user's current preferences and
+// saves them through the preference service, so a setting changed here is
+// checked the same way as from the settings dialog or the API.
+func (h *Handler) updatePreferences(userID string, change func(prefs *types.UserPreferences)) (*types.UserPreferences, error) {
+	prefs, err := h.preferences.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+	change(prefs)
+	return h.preferences.Update(userID, prefs)
+}
+
+// preferenceErrorResponse reports a failed settings change. An invalid value
+// is shown as is; anything else is logged.
+func (h *Handler) preferenceErrorResponse(userID, setting string, err error) *model.CommandResponse {
+	var prefErr *types.PreferenceError
+	if errors.As(err, &prefErr) {
+		h.logger.Debug("Rejected invalid setting", "user_id", userID, "setting", setting, "error", err)
+		return errorResponse(fmt.Sprintf("%s %s", constants.EmojiError, prefErr.Message))
+	}
+	h.logger.Error("Failed to save user preferences", "user_id", userID, "setting", setting, "error", err)
+	return errorResponse(fmt.Sprintf("%s Could not save your %s setting: %v", constants.EmojiError, setting, err))
+}
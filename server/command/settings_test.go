@@ -0,0 +1,247 @@
+package command_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func settingsArgs(text string) *model.CommandArgs {
+	return &model.CommandArgs{
+		UserId:    "testUserID",
+		ChannelId: "testChannelID",
+		Command:   "/" + constants.CommandTrigger + " " + constants.SubcommandSettings + text,
+	}
+}
+
+// storedPreferences stands in for the preference service, keeping the user's
+// preferences in the returned value.
+func storedPreferences(mocks *testMocks) *types.UserPreferences {
+	stored := &types.UserPreferences{}
+	mocks.preferences.EXPECT().Get("testUserID").DoAndReturn(func(string) (*types.UserPreferences, error) {
+		current := *stored
+		return &current, nil
+	}).AnyTimes()
+	mocks.preferences.EXPECT().Update("testUserID", gomock.Any()).DoAndReturn(func(_ string, prefs *types.UserPreferences) (*types.UserPreferences, error) {
+		*stored = *prefs
+		return prefs, nil
+	}).AnyTimes()
+	return stored
+}
+
+func TestExecute_Settings_ShowsFeedURL(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.feed.EXPECT().URL("testUserID").Return("https://chat/feed/abc.ics", nil)
+
+	resp, appErr := handler.Execute(settingsArgs(" feed"))
+
+	require.Nil(t, appErr)
+	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
+	assert.Contains(t, resp.Text, "https://chat/feed/abc.ics")
+	assert.Contains(t, resp.Text, "settings feed rotate")
+}
+
+func TestExecute_Settings_OpensDialog(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	dialog := &model.Dialog{Title: constants.PreferencesDialogTitle}
+	mocks.preferences.EXPECT().Dialog("testUserID").Return(dialog, nil)
+	mocks.dialogs.EXPECT().OpenInteractiveDialog(model.OpenDialogRequest{
+		TriggerId: "trigger",
+		URL:       constants.PreferencesDialogURL,
+		Dialog:    *dialog,
+	}).Return(nil)
+
+	args := settingsArgs("")
+	args.TriggerId = "trigger"
+	resp, appErr := handler.Execute(args)
+
+	require.Nil(t, appErr)
+	assert.Empty(t, resp.Text)
+}
+
+func TestExecute_Settings_ListsWhenDialogCannotOpen(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.preferences.EXPECT().Dialog("testUserID").Return(&model.Dialog{}, nil)
+	mocks.dialogs.EXPECT().OpenInteractiveDialog(gomock.Any()).Return(errors.New("expired trigger"))
+	mocks.preferences.EXPECT().Get("testUserID").Return(&types.UserPreferences{Use24Hour: true}, nil)
+
+	args := settingsArgs("")
+	args.TriggerId = "trigger"
+	resp, _ := handler.Execute(args)
+
+	assert.Equal(t, model.CommandResponseTypeEphemeral, resp.ResponseType)
+	assert.Contains(t, resp.Text, "24-hour")
+}
+
+func TestExecute_Settings_ListsWithoutTrigger(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.preferences.EXPECT().Get("testUserID").Return(&types.UserPreferences{Timezone: "Asia/Seoul"}, nil)
+
+	resp, _ := handler.Execute(settingsArgs(""))
+
+	assert.Contains(t, resp.Text, "Asia/Seoul")
+}
+
+func TestExecute_Settings_FeedSubcommand(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.feed.EXPECT().URL("testUserID").Return("https://chat/feed/abc.ics", nil)
+
+	resp, _ := handler.Execute(settingsArgs(" feed"))
+
+	assert.Contains(t, resp.Text, "https://chat/feed/abc.ics")
+}
+
+func TestExecute_Settings_RotateFeed(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.feed.EXPECT().Rotate("testUserID").Return("https://chat/feed/new.ics", nil)
+
+	resp, _ := handler.Execute(settingsArgs(" feed rotate"))
+
+	assert.Contains(t, resp.Text, "https://chat/feed/new.ics")
+	assert.Contains(t, resp.Text, "old link no longer works")
+}
+
+func TestExecute_Settings_FeedError(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.feed.EXPECT().URL("testUserID").Return("", errors.New("no site url"))
+
+	resp, _ := handler.Execute(settingsArgs(" feed"))
+
+	assert.Contains(t, resp.Text, constants.EmojiError)
+	assert.Contains(t, resp.Text, "no site url")
+}
+
+func TestExecute_Settings_Unknown(t *testing.T) {
+	handler, _, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	resp, _ := handler.Execute(settingsArgs(" bogus"))
+
+	assert.Contains(t, resp.Text, "Unknown settings option `bogus`")
+}
+
+func TestExecute_Settings_Reminder(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	stored := storedPreferences(mocks)
+
+	resp, _ := handler.Execute(settingsArgs(" reminder"))
+	assert.Contains(t, resp.Text, "have no reminder")
+
+	resp, _ = handler.Execute(settingsArgs(" reminder 90"))
+	assert.Contains(t, resp.Text, "1 hour 30 minutes")
+	assert.Equal(t, 90, stored.ReminderMinutes)
+
+	resp, _ = handler.Execute(settingsArgs(" reminder off"))
+	assert.Contains(t, resp.Text, "have no reminder")
+	assert.Equal(t, 0, stored.ReminderMinutes)
+}
+
+func TestExecute_Settings_ReminderInvalid(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	stored := storedPreferences(mocks)
+
+	resp, _ := handler.Execute(settingsArgs(" reminder soon"))
+
+	assert.Contains(t, resp.Text, "number of minutes")
+	assert.Equal(t, &types.UserPreferences{}, stored)
+}
+
+func TestExecute_Settings_Confirmations(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	stored := storedPreferences(mocks)
+
+	resp, _ := handler.Execute(settingsArgs(" confirmations"))
+	assert.Contains(t, resp.Text, "do not get a DM")
+
+	resp, _ = handler.Execute(settingsArgs(" confirmations on"))
+	assert.Contains(t, resp.Text, "Saved. You will get a DM")
+	assert.True(t, stored.ConfirmDelivery)
+
+	resp, _ = handler.Execute(settingsArgs(" confirmations off"))
+	assert.Contains(t, resp.Text, "do not get a DM")
+	assert.False(t, stored.ConfirmDelivery)
+}
+
+func TestExecute_Settings_ConfirmationsInvalid(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	stored := storedPreferences(mocks)
+
+	resp, _ := handler.Execute(settingsArgs(" confirmations maybe"))
+
+	assert.Contains(t, resp.Text, "Unknown settings option")
+	assert.Equal(t, &types.UserPreferences{}, stored)
+}
+
+func TestExecute_Settings_Digest(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+	stored := storedPreferences(mocks)
+
+	resp, _ := handler.Execute(settingsArgs(" digest"))
+	assert.Contains(t, resp.Text, "do not get a digest")
+
+	resp, _ = handler.Execute(settingsArgs(" digest weekly"))
+	assert.Contains(t, resp.Text, "every Monday")
+	assert.Equal(t, types.DigestWeekly, stored.Digest)
+
+	resp, _ = handler.Execute(settingsArgs(" digest daily"))
+	assert.Contains(t, resp.Text, "every weekday")
+	assert.Equal(t, types.DigestDaily, stored.Digest)
+
+	resp, _ = handler.Execute(settingsArgs(" digest off"))
+	assert.Contains(t, resp.Text, "do not get a digest")
+	assert.Equal(t, types.DigestOff, stored.Digest)
+
+	resp, _ = handler.Execute(settingsArgs(" digest hourly"))
+	assert.Contains(t, resp.Text, "Unknown settings option")
+}
+
+func TestExecute_Settings_RejectedByPreferenceService(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.preferences.EXPECT().Get("testUserID").Return(&types.UserPreferences{Timezone: "Mars/Olympus"}, nil)
+	mocks.preferences.EXPECT().Update("testUserID", &types.UserPreferences{Timezone: "Mars/Olympus", Digest: types.DigestDaily}).
+		Return(nil, &types.PreferenceError{Field: constants.PreferenceTimezone, Message: "unknown timezone Mars/Olympus"})
+
+	resp, _ := handler.Execute(settingsArgs(" digest daily"))
+
+	assert.Equal(t, constants.EmojiError+" unknown timezone Mars/Olympus", resp.Text)
+}
+
+func TestExecute_Settings_SaveFails(t *testing.T) {
+	handler, mocks, ctrl := setup(t)
+	defer ctrl.Finish()
+
+	mocks.preferences.EXPECT().Get("testUserID").Return(&types.UserPreferences{}, nil)
+	mocks.preferences.EXPECT().Update("testUserID", gomock.Any()).Return(nil, errors.New("kv down"))
+
+	resp, _ := handler.Execute(settingsArgs(" confirmations on"))
+
+	assert.Contains(t, resp.Text, "Could not save your confirmation setting: kv down")
+}
@@ -0,0 +1,32 @@
+package command
+
+import "lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+
+// userTimezone returns the user's effective timezone name, falling back to
+// defaultTZ when the user cannot be loaded or has none set.
+func userTimezone(logger ports.Logger, userAPI ports.UserService, userID string, defaultTZ string) string {
+	logger.Debug("Attempting to get user timezone", "user_id", userID)
+	user, err := userAPI.Get(userID)
+	if err != nil {
+		logger.Warn("Failed to get user object, falling back to default timezone", "user_id", userID, "error", err, "default_timezone", defaultTZ)
+		return defaultTZ
+	}
+
+	tz := defaultTZ
+	source := "default"
+
+	automaticTimezone, aok := user.Timezone["automaticTimezone"]
+	useAutomaticTimezone, uok := user.Timezone["useAutomaticTimezone"]
+	manualTimezone, mok := user.Timezone["manualTimezone"]
+
+	if aok && uok && automaticTimezone != "" && useAutomaticTimezone == "true" {
+		tz = automaticTimezone
+		source = "automatic"
+	} else if mok && manualTimezone != "" {
+		tz = manualTimezone
+		source = "manual"
+	}
+
+	logger.Debug("Determined user timezone", "user_id", userID, "timezone", tz, "source", source)
+	return tz
+}
@@ -1,9 +1,17 @@
 package main
 
 import (
+	"net/url"
 	"reflect"
+	"strings"
+	"time"
 
+	"github.com/mattermost/mattermost/server/public/model"
 	"github.com/pkg/errors"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/policy"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 // configuration captures the plugin's external configuration as exposed in the Mattermost server
@@ -18,6 +26,46 @@ import (
 // If you add non-reference types to your configuration struct, be sure to rewrite Clone as a deep
 // copy appropriate for your types.
 type configuration struct {
+	// WebhookURLs lists the outgoing webhook endpoints, one per line.
+	WebhookURLs string
+	// WebhookSecret keys the HMAC-SHA256 signature sent with every webhook.
+	WebhookSecret string
+	// MaxUserMessages caps how many pending messages one user may have.
+	MaxUserMessages int
+	// MaxMessageBytes caps the size of a scheduled message's text.
+	MaxMessageBytes int
+	// MaxFileCount caps the number of files attached to a scheduled message.
+	MaxFileCount int
+	// DefaultTimezone is used for users who have no timezone set.
+	DefaultTimezone string
+	// MaxHorizonDays caps how far ahead messages may be scheduled. Zero means no limit.
+	MaxHorizonDays int
+	// AllowedChannelIDs, when set, restricts scheduling to these channels.
+	AllowedChannelIDs string
+	// DeniedChannelIDs lists channels where messages may not be scheduled.
+	DeniedChannelIDs string
+	// AllowedTeamIDs, when set, restricts scheduling to channels in these teams.
+	AllowedTeamIDs string
+	// DeniedTeamIDs lists teams where messages may not be scheduled.
+	DeniedTeamIDs string
+	// MaxChannelMessages caps the pending messages in one channel. Zero means no limit.
+	MaxChannelMessages int
+	// MaxTeamMessages caps the pending messages across a team's channels. Zero means no limit.
+	MaxTeamMessages int
+	// ChannelAdminRules lets channel admins turn off or cap scheduling in their channels.
+	ChannelAdminRules bool
+	// AllowBotDelivery lets users have messages posted by the bot instead of as themselves.
+	AllowBotDelivery bool
+	// QuietHours holds one quiet hours rule per line, for a team or channel ID.
+	QuietHours string
+	// Holidays lists whole days that are quiet wherever quiet hours apply.
+	Holidays string
+	// HistoryRetentionDays is how long each user's sent message history is kept. Zero keeps it until the per-user cap is reached.
+	HistoryRetentionDays int
+	// AuditRetentionDays is how long audit entries are kept. Zero keeps them forever.
+	AuditRetentionDays int
+	// DeactivatedUserMessages is "hold" or "cancel": what happens to a user's pending messages when they are deactivated.
+	DeactivatedUserMessages string
 }
 
 // Clone shallow copies the configuration. Your implementation may require a deep copy if
@@ -27,6 +75,154 @@ func (c *configuration) Clone() *configuration {
 	return &clone
 }
 
+// webhookURLs returns the configured webhook endpoints, skipping blank lines.
+func (c *configuration) webhookURLs() []string {
+	var urls []string
+	for _, line := range strings.Split(c.WebhookURLs, "\n") {
+		if trimmed := strings.TrimSpace(line); trimmed != "" {
+			urls = append(urls, trimmed)
+		}
+	}
+	return urls
+}
+
+// limits returns the scheduling limits, using the built-in defaults for
+// settings that have never been saved.
+func (c *configuration) limits() types.Limits {
+	limits := types.Limits{
+		MaxUserMessages: constants.MaxUserMessages,
+		MaxMessageBytes: constants.MaxMessageBytes,
+		MaxFileCount:    constants.MaxFileCount,
+		DefaultTimezone: constants.DefaultTimezone,
+		MaxHorizon:      time.Duration(c.MaxHorizonDays) * 24 * time.Hour,
+	}
+	if c.MaxUserMessages > 0 {
+		limits.MaxUserMessages = c.MaxUserMessages
+	}
+	if c.MaxMessageBytes > 0 {
+		limits.MaxMessageBytes = c.MaxMessageBytes
+	}
+	if c.MaxFileCount > 0 {
+		limits.MaxFileCount = c.MaxFileCount
+	}
+	if tz := strings.TrimSpace(c.DefaultTimezone); tz != "" {
+		limits.DefaultTimezone = tz
+	}
+	return limits
+}
+
+// policy returns the scheduling policy set in the System Console.
+func (c *configuration) policy() types.Policy {
+	return types.Policy{
+		AllowedChannelIDs:  splitIDs(c.AllowedChannelIDs),
+		DeniedChannelIDs:   splitIDs(c.DeniedChannelIDs),
+		AllowedTeamIDs:     splitIDs(c.AllowedTeamIDs),
+		DeniedTeamIDs:      splitIDs(c.DeniedTeamIDs),
+		MaxChannelMessages: c.MaxChannelMessages,
+		MaxTeamMessages:    c.MaxTeamMessages,
+		ChannelAdminRules:  c.ChannelAdminRules,
+		QuietHours:         c.quietHours(),
+		Holidays:           splitIDs(c.Holidays),
+		BotDelivery:        c.AllowBotDelivery,
+	}
+}
+
+// quietHours parses the quiet hours rules, skipping lines IsValid would reject.
+func (c *configuration) quietHours() map[string]types.QuietHours {
+	rules := map[string]types.QuietHours{}
+	for _, line := range strings.Split(c.QuietHours, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if id, rule, err := policy.ParseQuietHours(line); err == nil {
+			rules[id] = rule
+		}
+	}
+	return rules
+}
+
+// deactivationMode returns how deactivated users' messages are handled,
+// holding them when the setting is unset or invalid.
+func (c *configuration) deactivationMode() types.DeactivationMode {
+	mode, err := types.ParseDeactivationMode(c.DeactivatedUserMessages)
+	if err != nil {
+		return types.DeactivationHold
+	}
+	return mode
+}
+
+// splitIDs splits a list of IDs separated by commas, spaces or newlines.
+func splitIDs(raw string) []string {
+	return strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\n' || r == '\r' || r == '\t'
+	})
+}
+
+// IsValid checks the configuration for values the plugin cannot work with.
+func (c *configuration) IsValid() error {
+	for _, raw := range c.webhookURLs() {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("webhook URL %q must be an absolute http or https URL", raw)
+		}
+	}
+	if c.MaxUserMessages < 0 {
+		return errors.New("maximum messages per user must not be negative")
+	}
+	if c.MaxMessageBytes < 0 || c.MaxMessageBytes > model.PostMessageMaxBytesV2 {
+		return errors.Errorf("maximum message size must be between 1 and %d bytes", model.PostMessageMaxBytesV2)
+	}
+	if c.MaxFileCount < 0 || c.MaxFileCount > constants.MaxFileCountLimit {
+		return errors.Errorf("maximum file count must be between 1 and %d", constants.MaxFileCountLimit)
+	}
+	if c.MaxHorizonDays < 0 {
+		return errors.New("maximum scheduling horizon must not be negative")
+	}
+	if tz := strings.TrimSpace(c.DefaultTimezone); tz != "" {
+		if _, err := time.LoadLocation(tz); err != nil {
+			return errors.Errorf("default timezone %q is not a valid IANA timezone", tz)
+		}
+	}
+	if c.AuditRetentionDays < 0 {
+		return errors.New("audit log retention must not be negative")
+	}
+	if _, err := types.ParseDeactivationMode(c.DeactivatedUserMessages); err != nil {
+		return errors.Errorf("deactivated user handling must be %q or %q", types.DeactivationHold, types.DeactivationCancel)
+	}
+	if c.HistoryRetentionDays < 0 {
+		return errors.New("sent message history retention must not be negative")
+	}
+	if c.MaxChannelMessages < 0 || c.MaxTeamMessages < 0 {
+		return errors.New("pending message limits for channels and teams must not be negative")
+	}
+	rules := c.policy()
+	for _, ids := range [][]string{rules.AllowedChannelIDs, rules.DeniedChannelIDs, rules.AllowedTeamIDs, rules.DeniedTeamIDs} {
+		for _, id := range ids {
+			if !model.IsValidId(id) {
+				return errors.Errorf("%q is not a valid channel or team ID", id)
+			}
+		}
+	}
+	for _, line := range strings.Split(c.QuietHours, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		id, _, err := policy.ParseQuietHours(line)
+		if err != nil {
+			return err
+		}
+		if !model.IsValidId(id) {
+			return errors.Errorf("%q is not a valid channel or team ID", id)
+		}
+	}
+	for _, day := range splitIDs(c.Holidays) {
+		if _, err := time.Parse(constants.DateParseLayoutYYYYMMDD, day); err != nil {
+			return errors.Errorf("holiday %q must be a date like 2026-12-25", day)
+		}
+	}
+	return nil
+}
+
 // getConfiguration retrieves the active configuration under lock, making it safe to use
 // concurrently. The active configuration may change underneath the client of this method, but
 // the struct returned by this API call is considered immutable.
@@ -77,7 +273,39 @@ func (p *Plugin) OnConfigurationChange() error {
 		return errors.Wrap(err, "failed to load plugin configuration")
 	}
 
+	if err := configuration.IsValid(); err != nil {
+		return errors.Wrap(err, "invalid plugin configuration")
+	}
+
 	p.setConfiguration(configuration)
+	p.applyConfiguration(configuration)
 
 	return nil
 }
+
+// applyConfiguration pushes configuration values into the running components.
+// It is a no-op until the plugin has been activated.
+func (p *Plugin) applyConfiguration(configuration *configuration) {
+	if p.webhooks != nil {
+		p.webhooks.Configure(configuration.webhookURLs(), configuration.WebhookSecret)
+	}
+	limits := configuration.limits()
+	if p.Store != nil {
+		p.Store.SetMaxUserMessages(limits.MaxUserMessages)
+	}
+	if p.scheduleService != nil {
+		p.scheduleService.Configure(limits)
+	}
+	if p.policy != nil {
+		p.policy.Configure(configuration.policy())
+	}
+	if p.audit != nil {
+		p.audit.Configure(configuration.AuditRetentionDays)
+	}
+	if p.history != nil {
+		p.history.Configure(configuration.HistoryRetentionDays)
+	}
+	if p.deactivation != nil {
+		p.deactivation.Configure(configuration.deactivationMode())
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestConfigurationWebhookURLs(t *testing.T) {
+	c := &configuration{WebhookURLs: "https://a.example.com/hook\n\n  http://b.example.com/x  \n"}
+	assert.Equal(t, []string{"https://a.example.com/hook", "http://b.example.com/x"}, c.webhookURLs())
+	assert.Empty(t, (&configuration{}).webhookURLs())
+}
+
+func TestConfigurationIsValid(t *testing.T) {
+	require.NoError(t, (&configuration{}).IsValid())
+	require.NoError(t, (&configuration{WebhookURLs: "https://a.example.com/hook"}).IsValid())
+
+	for _, bad := range []string{"ftp://a.example.com", "/relative", "https://"} {
+		err := (&configuration{WebhookURLs: bad}).IsValid()
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestConfigurationLimits(t *testing.T) {
+	defaults := (&configuration{}).limits()
+	assert.Equal(t, constants.MaxUserMessages, defaults.MaxUserMessages)
+	assert.Equal(t, constants.MaxMessageBytes, defaults.MaxMessageBytes)
+	assert.Equal(t, constants.MaxFileCount, defaults.MaxFileCount)
+	assert.Equal(t, constants.DefaultTimezone, defaults.DefaultTimezone)
+	assert.Zero(t, defaults.MaxHorizon)
+
+	c := &configuration{MaxUserMessages: 5, MaxMessageBytes: 1024, MaxFileCount: 3, DefaultTimezone: " Asia/Seoul ", MaxHorizonDays: 30}
+	limits := c.limits()
+	assert.Equal(t, 5, limits.MaxUserMessages)
+	assert.Equal(t, 1024, limits.MaxMessageBytes)
+	assert.Equal(t, 3, limits.MaxFileCount)
+	assert.Equal(t, "Asia/Seoul", limits.DefaultTimezone)
+	assert.Equal(t, 30*24*time.Hour, limits.MaxHorizon)
+}
+
+func TestConfigurationIsValid_Limits(t *testing.T) {
+	require.NoError(t, (&configuration{MaxUserMessages: 10, MaxMessageBytes: 2048, MaxFileCount: 10, DefaultTimezone: "Europe/Berlin", MaxHorizonDays: 365}).IsValid())
+
+	for name, c := range map[string]*configuration{
+		"negative messages":             {MaxUserMessages: -1},
+		"message too large":             {MaxMessageBytes: 70000},
+		"too many files":                {MaxFileCount: 11},
+		"negative horizon":              {MaxHorizonDays: -1},
+		"unknown timezone":              {DefaultTimezone: "Mars/Olympus"},
+		"negative audit":                {AuditRetentionDays: -1},
+		"negative history":              {HistoryRetentionDays: -1},
+		"unknown deactivation handling": {DeactivatedUserMessages: "archive"},
+	} {
+		assert.Error(t, c.IsValid(), name)
+	}
+}
+
+func TestConfigurationPolicy(t *testing.T) {
+	c := &configuration{
+		DeniedChannelIDs:  "aaaaaaaaaaaaaaaaaaaaaaaaaa, bbbbbbbbbbbbbbbbbbbbbbbbbb\ncccccccccccccccccccccccccc",
+		AllowedTeamIDs:    " dddddddddddddddddddddddddd ",
+		MaxTeamMessages:   50,
+		ChannelAdminRules: true,
+		AllowBotDelivery:  true,
+	}
+	policy := c.policy()
+
+	assert.Equal(t, []string{"aaaaaaaaaaaaaaaaaaaaaaaaaa", "bbbbbbbbbbbbbbbbbbbbbbbbbb", "cccccccccccccccccccccccccc"}, policy.DeniedChannelIDs)
+	assert.Equal(t, []string{"dddddddddddddddddddddddddd"}, policy.AllowedTeamIDs)
+	assert.Empty(t, policy.AllowedChannelIDs)
+	assert.Equal(t, 50, policy.MaxTeamMessages)
+	assert.True(t, policy.ChannelAdminRules)
+	assert.True(t, policy.BotDelivery)
+	require.NoError(t, c.IsValid())
+}
+
+func TestConfigurationIsValid_Policy(t *testing.T) {
+	for name, c := range map[string]*configuration{
+		"negative channel cap": {MaxChannelMessages: -1},
+		"negative team cap":    {MaxTeamMessages: -1},
+		"malformed channel ID": {DeniedChannelIDs: "town-square"},
+		"malformed team ID":    {AllowedTeamIDs: "my-team"},
+	} {
+		assert.Error(t, c.IsValid(), name)
+	}
+}
+
+func TestConfigurationQuietHours(t *testing.T) {
+	c := &configuration{
+		QuietHours: "aaaaaaaaaaaaaaaaaaaaaaaaaa 22:00-07:00 shift\n\nbbbbbbbbbbbbbbbbbbbbbbbbbb weekends tz:Europe/Berlin\n",
+		Holidays:   "2026-12-25, 2026-12-26",
+	}
+	require.NoError(t, c.IsValid())
+
+	policy := c.policy()
+	require.Len(t, policy.QuietHours, 2)
+	assert.Equal(t, types.QuietHoursShift, policy.QuietHours["aaaaaaaaaaaaaaaaaaaaaaaaaa"].Action)
+	assert.True(t, policy.QuietHours["bbbbbbbbbbbbbbbbbbbbbbbbbb"].Weekends)
+	assert.Equal(t, []string{"2026-12-25", "2026-12-26"}, policy.Holidays)
+}
+
+func TestConfigurationIsValid_QuietHours(t *testing.T) {
+	for name, c := range map[string]*configuration{
+		"missing hours":  {QuietHours: "aaaaaaaaaaaaaaaaaaaaaaaaaa"},
+		"malformed ID":   {QuietHours: "my-team 22:00-07:00"},
+		"bad clock time": {QuietHours: "aaaaaaaaaaaaaaaaaaaaaaaaaa 10pm-7am"},
+		"bad holiday":    {Holidays: "Dec 25"},
+	} {
+		assert.Error(t, c.IsValid(), name)
+	}
+}
@@ -0,0 +1,107 @@
+package confirmation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service DMs owners who asked for it a confirmation once their messages have
+// been posted. It listens for sent events and waits a short window before
+// sending, so messages that go out together are confirmed in one DM.
+type Service struct {
+	logger  ports.Logger
+	prefs   ports.PreferenceStore
+	poster  ports.PostService
+	channel ports.ChannelService
+	links   ports.HistoryService
+	botID   string
+	window  time.Duration
+
+	mu      sync.Mutex
+	pending map[string][]*types.LifecycleEvent
+	timer   *time.Timer
+}
+
+func New(
+	logger ports.Logger,
+	prefs ports.PreferenceStore,
+	poster ports.PostService,
+	channel ports.ChannelService,
+	links ports.HistoryService,
+	botID string,
+	window time.Duration,
+) *Service {
+	logger.Debug("Creating new confirmation Service", "window", window)
+	return &Service{
+		logger:  logger,
+		prefs:   prefs,
+		poster:  poster,
+		channel: channel,
+		links:   links,
+		botID:   botID,
+		window:  window,
+		pending: map[string][]*types.LifecycleEvent{},
+	}
+}
+
+// Notify queues sent messages for confirmation. The owner's preference is
+// only looked up when the batch is sent.
+func (s *Service) Notify(event *types.LifecycleEvent) {
+	if event.Type != types.EventSent {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[event.UserID] = append(s.pending[event.UserID], event)
+	if s.timer == nil {
+		s.timer = time.AfterFunc(s.window, s.Flush)
+	}
+}
+
+// Flush sends the queued confirmations now.
+func (s *Service) Flush() {
+	s.mu.Lock()
+	batch := s.pending
+	s.pending = map[string][]*types.LifecycleEvent{}
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	s.mu.Unlock()
+
+	for userID, events := range batch {
+		s.confirm(userID, events)
+	}
+}
+
+// Close sends anything still queued, so confirmations are not lost when the
+// plugin stops.
+func (s *Service) Close() {
+	s.Flush()
+}
+
+func (s *Service) confirm(userID string, events []*types.LifecycleEvent) {
+	prefs, err := s.prefs.GetPreferences(userID)
+	if err != nil {
+		s.logger.Warn("Failed to get user preferences for delivery confirmation", "user_id", userID, "error", err)
+		return
+	}
+	if !prefs.ConfirmDelivery {
+		return
+	}
+	lines := make([]string, 0, len(events))
+	for _, event := range events {
+		channelLink := s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(event.ChannelID))
+		lines = append(lines, formatter.FormatDeliveryConfirmationLine(channelLink, s.links.Permalink(event.PostID)))
+	}
+	s.logger.Debug("Sending delivery confirmation", "user_id", userID, "count", len(events))
+	if err := s.poster.DM(s.botID, userID, &model.Post{Message: formatter.FormatDeliveryConfirmation(lines)}); err != nil {
+		s.logger.Error("Failed to send delivery confirmation DM", "user_id", userID, "count", len(events), "error", err)
+	}
+}
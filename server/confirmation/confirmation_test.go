@@ -0,0 +1,150 @@
+package confirmation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type mocks struct {
+	prefs   *testutil.FakePreferences
+	poster  *mock.MockPostService
+	channel *mock.MockChannelService
+	links   *mock.MockHistoryService
+}
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		prefs:   &testutil.FakePreferences{},
+		poster:  mock.NewMockPostService(ctrl),
+		channel: mock.NewMockChannelService(ctrl),
+		links:   mock.NewMockHistoryService(ctrl),
+	}
+	// A long window keeps the timer from firing; tests call Flush directly.
+	return New(testutil.FakeLogger{}, m.prefs, m.poster, m.channel, m.links, "bot", time.Hour), m
+}
+
+func sentEvent(userID, channelID, postID string) *types.LifecycleEvent {
+	return &types.LifecycleEvent{Type: types.EventSent, UserID: userID, ChannelID: channelID, PostID: postID}
+}
+
+func expectChannel(m *mocks, channelID, link string) {
+	info := &ports.ChannelInfo{ChannelID: channelID}
+	m.channel.EXPECT().GetInfoOrUnknown(channelID).Return(info).AnyTimes()
+	m.channel.EXPECT().MakeChannelLink(info).Return(link).AnyTimes()
+}
+
+func TestFlush_SingleMessage(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {ConfirmDelivery: true}}
+	expectChannel(m, "c1", "in channel: ~town-square")
+	m.links.EXPECT().Permalink("p1").Return("https://chat.example.com/_redirect/pl/p1")
+
+	var sent *model.Post
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		sent = post
+		return nil
+	})
+
+	svc.Notify(sentEvent("u1", "c1", "p1"))
+	svc.Flush()
+
+	require.NotNil(t, sent)
+	assert.Contains(t, sent.Message, "Your scheduled message was posted in channel: ~town-square")
+	assert.Contains(t, sent.Message, "(https://chat.example.com/_redirect/pl/p1)")
+}
+
+func TestFlush_BatchesMessagesPerUser(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {ConfirmDelivery: true}}
+	expectChannel(m, "c1", "in channel: ~town-square")
+	m.links.EXPECT().Permalink(gomock.Any()).DoAndReturn(func(postID string) string {
+		return "https://chat.example.com/_redirect/pl/" + postID
+	}).Times(3)
+
+	var posts []*model.Post
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		posts = append(posts, post)
+		return nil
+	}).Times(1)
+
+	for _, postID := range []string{"p1", "p2", "p3"} {
+		svc.Notify(sentEvent("u1", "c1", postID))
+	}
+	svc.Flush()
+
+	require.Len(t, posts, 1)
+	assert.Contains(t, posts[0].Message, "3 of your scheduled messages were posted")
+	for _, postID := range []string{"p1", "p2", "p3"} {
+		assert.Contains(t, posts[0].Message, "/_redirect/pl/"+postID)
+	}
+
+	// The batch is cleared once it has been sent.
+	svc.Flush()
+	assert.Len(t, posts, 1)
+}
+
+func TestFlush_SkipsUsersWhoDidNotOptIn(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u2": {ReminderMinutes: 15}}
+
+	svc.Notify(sentEvent("u1", "c1", "p1"))
+	svc.Notify(sentEvent("u2", "c1", "p2"))
+	svc.Flush()
+}
+
+func TestNotify_IgnoresOtherEvents(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {ConfirmDelivery: true}}
+
+	svc.Notify(&types.LifecycleEvent{Type: types.EventFailed, UserID: "u1", ChannelID: "c1"})
+	svc.Notify(&types.LifecycleEvent{Type: types.EventScheduled, UserID: "u1", ChannelID: "c1"})
+	svc.Flush()
+
+	assert.Nil(t, svc.timer)
+}
+
+func TestFlush_DMErrorIsLogged(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {ConfirmDelivery: true}}
+	expectChannel(m, "c1", "in channel: ~town-square")
+	m.links.EXPECT().Permalink("p1").Return("")
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).Return(errors.New("boom"))
+
+	svc.Notify(sentEvent("u1", "c1", "p1"))
+	assert.NotPanics(t, svc.Flush)
+}
+
+func TestNotify_TimerFlushes(t *testing.T) {
+	_, m := setupService(t)
+	svc := New(testutil.FakeLogger{}, m.prefs, m.poster, m.channel, m.links, "bot", time.Millisecond)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {ConfirmDelivery: true}}
+	expectChannel(m, "c1", "in channel: ~town-square")
+	m.links.EXPECT().Permalink("p1").Return("")
+
+	done := make(chan struct{})
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).DoAndReturn(func(_, _ string, _ *model.Post) error {
+		close(done)
+		return nil
+	})
+
+	svc.Notify(sentEvent("u1", "c1", "p1"))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("confirmation was not sent")
+	}
+}
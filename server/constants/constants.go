@@ -1,15 +1,63 @@
 package constants
 
+import (
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
 const (
 	// SchedPrefix is the prefix used for scheduled message keys in the KV store.
 	SchedPrefix = "schedmsg:"
 	// UserIndexPrefix is the prefix used for user message index keys in the KV store.
 	UserIndexPrefix = "user_sched_index:"
+	// FeedTokenPrefix is the prefix used for a user's calendar feed token in the KV store.
+	FeedTokenPrefix = "feed_token:"
+	// WebhookDeliveriesKey is the KV key holding the recent webhook delivery log.
+	WebhookDeliveriesKey = "webhook_deliveries"
+	// IntegrationTokensKey is the KV key holding the admin-issued integration tokens.
+	IntegrationTokensKey = "integration_tokens"
+	// FeedOwnerPrefix is the prefix used to map a calendar feed token back to its owner in the KV store.
+	FeedOwnerPrefix = "feed_owner:"
+	// IdempotencyPrefix is the prefix used for remembered idempotency keys in the KV store.
+	IdempotencyPrefix = "idempotency:"
+	// ChannelPolicyPrefix is the prefix used for per-channel scheduling rules in the KV store.
+	ChannelPolicyPrefix = "channel_policy:"
+	// HistoryPrefix is the prefix used for a user's sent message history in the KV store.
+	HistoryPrefix = "history:"
+	// AuditPrefix is the prefix used for audit log entries in the KV store.
+	AuditPrefix = "audit:"
+	// DeliveryPauseKey is the KV key holding the admin's delivery pause, if any.
+	DeliveryPauseKey = "delivery_pause"
+	// HeldPrefix is the prefix used for a deactivated user's held messages in the KV store.
+	HeldPrefix = "held:"
+	// PreferencesPrefix is the prefix used for a user's scheduling preferences in the KV store.
+	PreferencesPrefix = "prefs:"
+	// DigestSentPrefix is the prefix used for the date a user's last digest was sent on in the KV store.
+	DigestSentPrefix = "digest_sent:"
+	// FailedPrefix is the prefix used for messages that could not be posted in the KV store.
+	FailedPrefix = "failed:"
+	// ChannelIndexPrefix is the prefix used for a channel's pending message index in the KV store.
+	ChannelIndexPrefix = "channel_sched_index:"
+	// TeamIndexPrefix is the prefix used for a team's pending message index in the KV store.
+	TeamIndexPrefix = "team_sched_index:"
+	// ScopeIndexVersionKey is the KV key recording that the channel and team indexes have been built.
+	ScopeIndexVersionKey = "scope_index_version"
+	// ScopeIndexVersion is bumped whenever the channel and team indexes need rebuilding.
+	ScopeIndexVersion = 1
+	// ScopeIndexRetries is how many times a channel or team index update is tried when other writes keep beating it.
+	ScopeIndexRetries = 5
 	// MaxUserMessages is a common limit used in tests involving user message counts.
 	MaxUserMessages = 1000
 	// MaxMessageBytes the maximium length a single message can be.
 	MaxMessageBytes = 50 * 1024
 	AssetsDir       = "assets"
+	// PluginID is the plugin identifier from plugin.json, used to build plugin URLs.
+	PluginID = "com.mattermost-plugin-schedule-message-gui"
+	// MaxFileCount is the default number of files a scheduled message may carry.
+	MaxFileCount = 10
+	// MaxFileCountLimit is the most files Mattermost accepts on a single post.
+	MaxFileCountLimit = 10
 
 	// Bot Configuration
 	ProfileImageFilename = "profile.png"
@@ -21,9 +69,27 @@ const (
 	SubcommandHelp            = "help"
 	SubcommandList            = "list"
 	SubcommandAt              = "at"
+	SubcommandSettings        = "settings"
+	SubcommandAdmin           = "admin"
+	AdminList                 = "list"
+	AdminCancel               = "cancel"
+	AdminStatus               = "status"
+	SubcommandPolicy          = "policy"
+	SubcommandHistory         = "history"
+	SubcommandDraft           = "draft"
+	PolicyEnable              = "enable"
+	PolicyDisable             = "disable"
+	PolicyCap                 = "cap"
+	SettingsFeed              = "feed"
+	SettingsFeedRotate        = "rotate"
+	SettingsReminder          = "reminder"
+	SettingsConfirmations     = "confirmations"
+	SettingsDigest            = "digest"
+	SettingsOn                = "on"
+	SettingsOff               = "off"
 	AutocompleteDesc          = "Schedule messages to be sent later"
 	AutocompleteHint          = "[subcommand]"
-	AutocompleteAtHint        = "<time> [on <date>] message <text>"
+	AutocompleteAtHint        = "<time> [on <date>] [remind <minutes>] [as bot] message <text>"
 	AutocompleteAtDesc        = "Schedule a new message"
 	AutocompleteAtArgTimeName = "Time"
 	AutocompleteAtArgTimeHint = "Time to send the message, e.g. 3:15PM, 3pm"
@@ -31,32 +97,257 @@ const (
 	AutocompleteAtArgDateHint = "(Optional) Date to send the message, e.g. 2026-01-01"
 	AutocompleteAtArgMsgName  = "Message"
 	AutocompleteAtArgMsgHint  = "The message content"
-	AutocompleteListHint      = ""
+	AutocompleteListHint      = "[~channel] [from:YYYY-MM-DD] [to:YYYY-MM-DD] [files:yes|no] [sort:asc|desc] [text]"
 	AutocompleteListDesc      = "List your scheduled messages"
 	AutocompleteHelpHint      = ""
 	AutocompleteHelpDesc      = "Show help text"
+	AutocompleteSettingsHint  = "[feed [rotate]|reminder [<minutes>|off]|confirmations [on|off]|digest [daily|weekly|off]]"
+	AutocompleteSettingsDesc  = "Open your settings, or show your calendar feed link"
+	AutocompleteFeedHint      = "[rotate]"
+	AutocompleteFeedDesc      = "Show your calendar feed link"
+	AutocompleteRotateHint    = ""
+	AutocompleteRotateDesc    = "Replace your calendar feed link with a new one"
+	AutocompleteReminderHint  = "[<minutes>|off]"
+	AutocompleteReminderDesc  = "Show or set how long before each new message you are reminded"
+	AutocompleteConfirmHint   = "[on|off]"
+	AutocompleteConfirmDesc   = "Show or change whether you get a DM when your messages are posted"
+	AutocompleteDigestHint    = "[daily|weekly|off]"
+	AutocompleteDigestDesc    = "Show or change how often you get a list of your upcoming messages"
+	AutocompleteAdminHint     = "[list|cancel|status|pause|resume]"
+	AutocompleteAdminDesc     = "System admin tools for everyone's scheduled messages"
+	AutocompleteAdminListHint = "[@user] [~channel]"
+	AutocompleteAdminListDesc = "List everyone's scheduled messages"
+	AutocompleteAdminCanHint  = "<id>"
+	AutocompleteAdminCanDesc  = "Cancel any scheduled message and notify its owner"
+	AutocompleteAdminStatDesc = "Show scheduler health and delivery backlog"
+	AutocompletePolicyHint    = "[enable|disable|cap <n>]"
+	AutocompletePolicyDesc    = "Show or change the scheduling rules for this channel"
+	AutocompletePolicyOnDesc  = "Allow scheduled messages in this channel"
+	AutocompletePolicyOffDesc = "Turn off scheduled messages in this channel"
+	AutocompletePolicyCapHint = "<n>"
+	AutocompletePolicyCapDesc = "Limit pending messages in this channel (0 for no limit)"
+	AutocompleteHistoryHint   = ""
+	AutocompleteHistoryDesc   = "Show your recently delivered and failed messages"
+	AutocompleteDraftHint     = ""
+	AutocompleteDraftDesc     = "Move your next scheduled message in this channel back to your drafts"
 	EmptyScheduleMessage      = "Trying to schedule a message? Use %s for instructions."
 
+	// List Filters
+	ListFilterHere   = "here"
+	ListFilterFrom   = "from:"
+	ListFilterTo     = "to:"
+	ListFilterFiles  = "files:"
+	ListFilterSort   = "sort:"
+	ListFilterCursor = "cursor:"
+
 	// Parser Errors
-	ParserErrInvalidFormat     = "invalid format. Use: `at <time> [on <date>] message <your message text>`"
+	ParserErrInvalidFormat     = "invalid format. Use: `at <time> [on <date>] [remind <minutes>] [as bot] message <your message text>`, or leave out `at <time>` to use your default time with `on <date>`"
 	ParserErrInvalidDateFormat = "invalid date format specified: '%s'. Use YYYY-MM-DD, day name (e.g., 'tuesday', 'fri'), or short date (e.g., '3jan', '25dec')"
 	ParserErrUnknownDateFormat = "unknown date format detected"
 
 	// API & HTTP
 	HTTPHeaderMattermostUserID = "Mattermost-User-ID"
+	HTTPHeaderIntegrationToken = "X-Scheduled-Messages-Token"
+	HTTPHeaderIdempotencyKey   = "Idempotency-Key"
+	HTTPHeaderIdempotentReplay = "Idempotent-Replayed"
+	HTTPHeaderWebhookEvent     = "X-Scheduled-Messages-Event"
+	HTTPHeaderWebhookDelivery  = "X-Scheduled-Messages-Delivery"
+	HTTPHeaderWebhookSignature = "X-Scheduled-Messages-Signature"
+	WebhookSignaturePrefix     = "sha256="
 
 	// Formatting & Display Strings
 	TimeLayout                = "Jan 2, 2006 3:04 PM"
+	TimeLayout24Hour          = "Jan 2, 2006 15:04"
 	EmojiSuccess              = "✅"
 	EmojiError                = "❌"
+	EmojiWarning              = "⚠️"
 	UnknownChannelPlaceholder = "N/A"
 	EmptyListMessage          = "You have no scheduled messages."
+	EmptyFilteredListMessage  = "No scheduled messages match your filters."
 	ListHeader                = "### Scheduled Messages"
+	FeedHeader                = "### Calendar Feed"
+
+	// Admin
+	AdminListHeader         = "### All Scheduled Messages"
+	AdminListMaxEntries     = 50
+	AdminListExcerptRunes   = 80
+	AdminPermissionDenied   = "Only System Admins can use this command."
+	AdminEmptyListMessage   = "There are no scheduled messages matching your filters."
+	AdminUnknownUserMessage = "unknown user %s"
+	AdminStatusHeader       = "### Scheduler Status"
+	AdminNotPausedMessage   = "Delivery is not paused."
+
+	// Left Channels
+	LeftChannelPath         = "/left-channel"
+	LeftChannelActionURL    = "/plugins/" + PluginID + "/api/v1" + LeftChannelPath
+	LeftChannelActionMove   = "move"
+	LeftChannelActionSelf   = "self"
+	LeftChannelActionCancel = "cancel"
+
+	// Reminders
+	ReminderPath           = "/reminder"
+	ReminderActionURL      = "/plugins/" + PluginID + "/api/v1" + ReminderPath
+	ReminderActionSend     = "send"
+	ReminderActionPostpone = "postpone"
+	ReminderActionCancel   = "cancel"
+	MaxReminderMinutes     = 7 * 24 * 60
+	ErrReminderInvalid     = "the reminder must be a number of minutes from 1 to %d, or off"
+
+	// Postponing Listed Messages
+	ListPostponePath      = "/postpone"
+	ListPostponeActionURL = "/plugins/" + PluginID + "/api/v1" + ListPostponePath
+	ListPostponeAction    = "postpone"
+	ListPostpone15m       = "15m"
+	ListPostpone1h        = "1h"
+	ListPostponeTomorrow  = "tomorrow"
+	ListPostponeMonday    = "monday"
+
+	// Moving Messages to Drafts
+	ListDraftPath          = "/draft"
+	ListDraftActionURL     = "/plugins/" + PluginID + "/api/v1" + ListDraftPath
+	ListDraftAction        = "draft"
+	DraftWebSocketEvent    = "move_to_draft"
+	DraftNoMessagesMessage = "You have no scheduled messages in this channel."
+
+	// Posting as the Bot
+	DeliverAsBot            = "bot"
+	DeliverAsMe             = "me"
+	BotAttribution          = "_Posted on behalf of @%s_"
+	ErrPolicyBotDeliveryOff = "posting scheduled messages as the bot is turned off"
+	ErrBotDeliveryDirect    = "the bot cannot post in direct or group messages, so this message can only be posted as you"
+
+	// Post Metadata
+	MaxMessageAttachments         = 20
+	ErrMetadataPriority           = "priority must be important or urgent"
+	ErrMetadataTooManyAttachments = "a scheduled message can have at most %d attachments"
+	ErrMetadataEmptyAttachment    = "message attachments cannot be empty"
+	ErrMetadataAttachmentActions  = "message attachments cannot have buttons or menus"
+	ErrMetadataReservedProp       = "the %q prop is set by Mattermost and cannot be scheduled"
+	ErrMetadataTooLarge           = "the attachments and props are larger than Mattermost allows"
+
+	// Failed Messages
+	FailurePath              = "/failure"
+	FailureActionURL         = "/plugins/" + PluginID + "/api/v1" + FailurePath
+	FailureActionRetry       = "retry"
+	FailureActionReschedule  = "reschedule"
+	FailureActionRetarget    = "retarget"
+	FailureActionDraft       = "draft"
+	FailedMessageTTL         = 7 * 24 * time.Hour
+	FailureAppErrTooLong     = "model.post.is_valid.message_length.app_error"
+	FailureAppErrFileIDs     = "model.post.is_valid.file_ids.app_error"
+	FailureAppErrArchived    = "api.post.create_post.can_not_post_to_deleted.error"
+	FailureAppErrPermissions = "api.context.permissions.app_error"
+
+	// Delivery Confirmations
+	DeliveryConfirmationWindow = 5 * time.Second
+
+	// Digests
+	DigestHour         = 8
+	DigestDailyWindow  = 24 * time.Hour
+	DigestWeeklyWindow = 7 * 24 * time.Hour
+	DigestDateLayout   = "2006-01-02"
+	DigestMaxMessages  = 25
+
+	// Preferences
+	PreferencesPath          = "/preferences"
+	PreferencesDialogPath    = PreferencesPath + "/dialog"
+	PreferencesDialogURL     = "/plugins/" + PluginID + "/api/v1" + PreferencesDialogPath
+	PreferencesDialogTitle   = "Scheduled Message Settings"
+	PreferencesDialogSubmit  = "Save"
+	PreferencesClockLayout   = "15:04"
+	DefaultScheduleTime      = "9:00"
+	PreferenceDefaultTime    = "default_time"
+	PreferenceClock          = "clock"
+	PreferenceTimezone       = "timezone"
+	PreferenceListPageSize   = "list_page_size"
+	PreferenceReminder       = "reminder_minutes"
+	PreferenceConfirmations  = "confirm_delivery"
+	PreferenceDigest         = "digest"
+	PreferenceClock12Hour    = "12"
+	PreferenceClock24Hour    = "24"
+	ErrPreferenceDefaultTime = "the default time must be a time of day like 09:00 or 17:30"
+	ErrPreferenceTimezone    = "unknown timezone %q, use a name like Europe/Berlin"
+	ErrPreferencePageSize    = "the list page size must be a number from 1 to %d, or empty for the default"
+	ErrPreferenceDigest      = "the digest must be daily, weekly or off"
+
+	// Deactivated Users
+	ReactivationCheckInterval = 5 * time.Minute
+	SystemAdminsPerPage       = 100
+
+	// Scheduler Status
+	SchedulerRecentErrors = 20
+	SchedulerOverdueAfter = 2 * time.Minute
+	SchedulerStallAfter   = 3 * time.Minute
+
+	// Policies
+	PolicyHeader               = "### Scheduling Rules for This Channel"
+	PolicyPermissionDenied     = "Only channel admins can change the scheduling rules for this channel."
+	ErrPolicyChannelNotAllowed = "scheduled messages are not allowed in this channel"
+	ErrPolicyTeamNotAllowed    = "scheduled messages are not allowed in this team"
+	ErrPolicyChannelDisabled   = "a channel admin has turned off scheduled messages in this channel"
+	ErrPolicyChannelCapReached = "this channel already has %d pending scheduled messages, the most allowed"
+	ErrPolicyTeamCapReached    = "this team already has %d pending scheduled messages, the most allowed"
+	ErrPolicyChannelAdminsOff  = "channel rules can only be changed by System Admins"
+	ErrPolicyInvalidChannelCap = "the channel limit must be a whole number of 0 or more"
+
+	// Quiet Hours
+	QuietHoursClockLayout    = "15:04"
+	QuietHoursWeekends       = "weekends"
+	QuietHoursTimezonePrefix = "tz:"
 
 	// Time & Scheduling
 	DefaultTimezone         = "UTC"
 	DateParseLayoutYYYYMMDD = "2006-01-02"
 
+	// Calendar Feed
+	FeedPathPrefix     = "/feed/"
+	FeedFileExtension  = ".ics"
+	FeedTokenBytes     = 32
+	FeedExcerptRunes   = 200
+	FeedCalendarName   = "Scheduled Messages"
+	FeedProductID      = "-//Mattermost//Scheduled Messages//EN"
+	FeedContentType    = "text/calendar; charset=utf-8"
+	FeedEventDuration  = 15 * time.Minute
+	ErrFeedNoSiteURL   = "the Mattermost Site URL is not configured -- ask your System Admin to set it"
+	ErrFeedInvalidLink = "calendar feed not found"
+
+	// Webhooks
+	WebhookMaxAttempts     = 5
+	WebhookInitialBackoff  = 2 * time.Second
+	WebhookRequestTimeout  = 10 * time.Second
+	WebhookDeliveryLogSize = 100
+
+	// Integration Tokens
+	IntegrationTokenBytes       = 32
+	ErrIntegrationInvalidToken  = "invalid integration token"
+	ErrIntegrationChannelDenied = "integration token is not allowed to use this channel"
+	ErrIntegrationNoChannels    = "at least one channel ID is required"
+	ErrIntegrationNotBot        = "user %s is not a bot account"
+
+	// Idempotency Keys
+	IdempotencyKeyTTL         = 24 * time.Hour
+	MaxIdempotencyKeyLength   = 255
+	ErrIdempotencyKeyTooLong  = "idempotency key must be at most %d characters"
+	ErrIdempotencyKeyReused   = "idempotency key was already used for a different request"
+	ErrIdempotencyKeyInFlight = "a request with this idempotency key is still being processed"
+
+	// Sent History
+	HistoryHeader       = "### Recently Delivered Messages"
+	HistoryEmptyMessage = "None of your scheduled messages have been delivered yet."
+	HistoryMaxEntries   = 100
+	HistoryShownEntries = 20
+	HistoryPermalink    = "%s/_redirect/pl/%s"
+
+	// Metrics
+	MetricsPath        = "/metrics"
+	MetricsContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	// Audit Log
+	AuditPruneInterval     = 24 * time.Hour
+	DefaultAuditQueryLimit = 100
+	MaxAuditQueryLimit     = 10000
+	AuditCSVFilename       = "scheduled-messages-audit.csv"
+
 	// File Paths
 	HelpFilename = "help.md"
 
@@ -64,7 +355,77 @@ const (
 	DefaultPage                  = 0
 	DefaultChannelMembersPerPage = 100
 	MaxFetchScheduledMessages    = 10000
+	KVKeysPerPage                = 1000
+	DefaultQueryLimit            = 20
+	MaxQueryLimit                = 100
+)
+
+// Admin delivery pause subcommands and autocomplete.
+const (
+	AdminPause                 = "pause"
+	AdminResume                = "resume"
+	AutocompleteAdminPauseHint = "[<duration>] [send|skip]"
+	AutocompleteAdminPauseDesc = "Pause delivery of all scheduled messages, optionally resuming after a duration such as 2h"
+	AutocompleteAdminResHint   = "[send|skip]"
+	AutocompleteAdminResDesc   = "Resume delivery, sending or skipping messages that came due while paused"
 )
 
+// StatusKeyPrefixes are the KV key prefixes counted in the scheduler status.
+var StatusKeyPrefixes = []string{
+	SchedPrefix,
+	UserIndexPrefix,
+	HistoryPrefix,
+	AuditPrefix,
+	HeldPrefix,
+	PreferencesPrefix,
+	DigestSentPrefix,
+	FailedPrefix,
+	IdempotencyPrefix,
+	ChannelPolicyPrefix,
+	FeedTokenPrefix,
+	FeedOwnerPrefix,
+}
+
+// ReminderPostponeOptions are the choices offered for postponing a message
+// from its reminder, as time.ParseDuration strings.
+var ReminderPostponeOptions = []struct{ Text, Value string }{
+	{"15 minutes", "15m"},
+	{"1 hour", "1h"},
+	{"3 hours", "3h"},
+	{"1 day", "24h"},
+}
+
+// ListPostponeOptions are the postpone buttons on each message in the list.
+// The minute and hour options move the message back from its post time, the
+// others move it to the same time of day tomorrow or next Monday.
+var ListPostponeOptions = []struct{ Text, Value string }{
+	{"+15m", ListPostpone15m},
+	{"+1h", ListPostpone1h},
+	{"Tomorrow", ListPostponeTomorrow},
+	{"Next Monday", ListPostponeMonday},
+}
+
+// ReservedPostProps are post props Mattermost or this plugin set, which a
+// scheduled message's custom props may not include. Attachments have their
+// own field.
+var ReservedPostProps = []string{
+	model.PostPropsAttachments,
+	model.PostPropsFromBot,
+	model.PostPropsFromWebhook,
+	model.PostPropsFromPlugin,
+	model.PostPropsFromOAuthApp,
+	model.PostPropsOverrideUsername,
+	model.PostPropsOverrideIconURL,
+	model.PostPropsOverrideIconEmoji,
+	model.PostPropsWebhookDisplayName,
+	model.PostPropsAddedUserId,
+	model.PostPropsDeleteBy,
+	model.PostPropsForceNotification,
+	model.PostPropsPreviewedPost,
+	model.PostPropsChannelMentions,
+	model.PostPropsUnsafeLinks,
+	model.PropsAddChannelMember,
+}
+
 // TimeParseLayouts defines the acceptable formats for parsing time strings.
 var TimeParseLayouts = []string{"15:04", "3:04pm", "3:04PM", "3pm", "3PM"}
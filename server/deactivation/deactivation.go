@@ -0,0 +1,273 @@
+package deactivation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service looks after the pending messages of deactivated users so they are
+// never posted under a deactivated account. Depending on the configured mode
+// it holds or cancels them, and System Admins get a summary by DM. Mattermost
+// has no reactivation hook, so users with held messages are checked every
+// ReactivationCheckInterval and their messages restored once they are active.
+type Service struct {
+	logger  ports.Logger
+	store   ports.Store
+	held    ports.HeldMessageStore
+	users   ports.UserDirectory
+	poster  ports.PostService
+	channel ports.ChannelService
+	events  ports.EventNotifier
+	botID   string
+	clock   ports.Clock
+	// mu serializes holding and restoring so a user is never both at once.
+	mu     sync.Mutex
+	modeMu sync.RWMutex
+	mode   types.DeactivationMode
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func New(
+	logger ports.Logger,
+	store ports.Store,
+	held ports.HeldMessageStore,
+	users ports.UserDirectory,
+	poster ports.PostService,
+	channel ports.ChannelService,
+	events ports.EventNotifier,
+	botID string,
+	clk ports.Clock,
+	mode types.DeactivationMode,
+) *Service {
+	logger.Debug("Creating new deactivation Service", "mode", mode)
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Service{
+		logger:  logger,
+		store:   store,
+		held:    held,
+		users:   users,
+		poster:  poster,
+		channel: channel,
+		events:  events,
+		botID:   botID,
+		clock:   clk,
+		mode:    mode,
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+}
+
+// Configure changes what happens to messages of users deactivated from now on.
+func (s *Service) Configure(mode types.DeactivationMode) {
+	s.modeMu.Lock()
+	defer s.modeMu.Unlock()
+	s.mode = mode
+	s.logger.Debug("Deactivation service configured", "mode", mode)
+}
+
+func (s *Service) currentMode() types.DeactivationMode {
+	s.modeMu.RLock()
+	defer s.modeMu.RUnlock()
+	return s.mode
+}
+
+// Start begins checking for reactivated users in the background.
+func (s *Service) Start() {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(constants.ReactivationCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				s.CheckReactivated()
+			}
+		}
+	}()
+}
+
+// Close stops the reactivation check and waits for a running one to finish.
+func (s *Service) Close() {
+	s.cancel()
+	s.wg.Wait()
+}
+
+// UserDeactivated holds or cancels the user's pending messages and tells
+// System Admins what was done.
+func (s *Service) UserDeactivated(user *model.User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msgs := s.userMessages(user.Id)
+	if len(msgs) == 0 {
+		s.logger.Debug("Deactivated user has no scheduled messages", "user_id", user.Id)
+		return
+	}
+
+	mode := s.currentMode()
+	var handled int
+	if mode == types.DeactivationCancel {
+		handled = s.cancelMessages(user.Id, msgs)
+	} else {
+		handled = s.holdMessages(user.Id, msgs)
+	}
+	s.logger.Info("Handled scheduled messages of deactivated user", "user_id", user.Id, "mode", mode, "count", handled)
+	if handled > 0 {
+		s.notifyAdmins(formatter.FormatDeactivationSummary(user.Username, handled, mode))
+	}
+}
+
+// CheckReactivated restores the held messages of every user who is active
+// again.
+func (s *Service) CheckReactivated() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	userIDs, err := s.held.ListHeldUserIDs()
+	if err != nil {
+		s.logger.Error("Failed to list users with held messages", "error", err)
+		return
+	}
+	s.logger.Debug("Checking users with held messages for reactivation", "count", len(userIDs))
+	for _, userID := range userIDs {
+		user, err := s.users.Get(userID)
+		if err != nil {
+			s.logger.Warn("Failed to look up user with held messages", "user_id", userID, "error", err)
+			continue
+		}
+		if user.DeleteAt != 0 {
+			continue
+		}
+		s.restoreMessages(user)
+	}
+}
+
+func (s *Service) userMessages(userID string) []*types.ScheduledMessage {
+	msgs, err := s.store.ListUserMessages(userID)
+	if err != nil {
+		s.logger.Error("Failed to list scheduled messages of deactivated user", "user_id", userID, "error", err)
+		return nil
+	}
+	return msgs
+}
+
+// holdMessages moves msgs from the schedule to the user's held messages. The
+// held list is saved before anything is deleted, then trimmed to the messages
+// that were actually removed so none can be both sent and restored.
+func (s *Service) holdMessages(userID string, msgs []*types.ScheduledMessage) int {
+	existing, err := s.held.GetHeldMessages(userID)
+	if err != nil {
+		s.logger.Error("Failed to load held messages of deactivated user", "user_id", userID, "error", err)
+		return 0
+	}
+	all := append(append([]*types.ScheduledMessage{}, existing...), msgs...)
+	if err := s.held.SaveHeldMessages(userID, all); err != nil {
+		s.logger.Error("Failed to hold scheduled messages of deactivated user", "user_id", userID, "count", len(msgs), "error", err)
+		return 0
+	}
+	held := existing
+	for _, msg := range msgs {
+		if err := s.store.DeleteScheduledMessage(userID, msg.ID); err != nil {
+			s.logger.Error("Failed to remove scheduled message of deactivated user", "user_id", userID, "message_id", msg.ID, "error", err)
+			continue
+		}
+		held = append(held, msg)
+	}
+	if len(held) != len(all) {
+		if err := s.held.SaveHeldMessages(userID, held); err != nil {
+			s.logger.Error("Failed to trim held messages", "user_id", userID, "error", err)
+		}
+	}
+	return len(held) - len(existing)
+}
+
+func (s *Service) cancelMessages(userID string, msgs []*types.ScheduledMessage) int {
+	cancelled := 0
+	for _, msg := range msgs {
+		if err := s.store.DeleteScheduledMessage(userID, msg.ID); err != nil {
+			s.logger.Error("Failed to cancel scheduled message of deactivated user", "user_id", userID, "message_id", msg.ID, "error", err)
+			continue
+		}
+		cancelled++
+		s.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, s.botID))
+	}
+	return cancelled
+}
+
+// restoreMessages puts the user's held messages back on the schedule. Ones
+// whose time has passed are returned to the user by DM instead of being
+// posted late.
+func (s *Service) restoreMessages(user *model.User) {
+	msgs, err := s.held.GetHeldMessages(user.Id)
+	if err != nil {
+		return
+	}
+	now := s.clock.Now()
+	restored, returned := 0, 0
+	for _, msg := range msgs {
+		if msg.PostAt.After(now) {
+			if err := s.store.SaveScheduledMessage(user.Id, msg); err == nil {
+				restored++
+				continue
+			}
+			s.logger.Error("Failed to restore held message, returning it to its owner", "user_id", user.Id, "message_id", msg.ID, "error", err)
+		}
+		s.returnToOwner(msg)
+		returned++
+	}
+	if err := s.held.DeleteHeldMessages(user.Id); err != nil {
+		s.logger.Error("Failed to clear restored held messages", "user_id", user.Id, "error", err)
+	}
+	s.logger.Info("Restored held messages of reactivated user", "user_id", user.Id, "restored", restored, "returned", returned)
+	if restored+returned > 0 {
+		s.notifyAdmins(formatter.FormatReactivationSummary(user.Username, restored, returned))
+	}
+}
+
+func (s *Service) returnToOwner(msg *types.ScheduledMessage) {
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	channelLink := s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(msg.ChannelID))
+	post := &model.Post{
+		Message: formatter.FormatHeldMessageReturned(msg.PostAt.In(loc), loc.String(), channelLink, msg.MessageContent),
+		FileIds: msg.FileIDs,
+	}
+	if err := s.poster.DM(s.botID, msg.UserID, post); err != nil {
+		s.logger.Error("Failed to return held message to its owner", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+	}
+}
+
+// notifyAdmins DMs message to every active System Admin.
+func (s *Service) notifyAdmins(message string) {
+	for page := 0; ; page++ {
+		admins, err := s.users.List(&model.UserGetOptions{Role: model.SystemAdminRoleId, Active: true, Page: page, PerPage: constants.SystemAdminsPerPage})
+		if err != nil {
+			s.logger.Error("Failed to list System Admins to notify", "page", page, "error", err)
+			return
+		}
+		for _, admin := range admins {
+			if admin.IsBot {
+				continue
+			}
+			if err := s.poster.DM(s.botID, admin.Id, &model.Post{Message: message}); err != nil {
+				s.logger.Error("Failed to DM System Admin", "admin_id", admin.Id, "error", err)
+			}
+		}
+		if len(admins) < constants.SystemAdminsPerPage {
+			return
+		}
+	}
+}
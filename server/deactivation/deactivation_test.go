@@ -0,0 +1,147 @@
+package deactivation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	store   *mock.MockStore
+	held    *mock.MockHeldMessageStore
+	users   *mock.MockUserDirectory
+	poster  *mock.MockPostService
+	channel *mock.MockChannelService
+	events  *testutil.FakeNotifier
+}
+
+func setupService(t *testing.T, mode types.DeactivationMode) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		store:   mock.NewMockStore(ctrl),
+		held:    mock.NewMockHeldMessageStore(ctrl),
+		users:   mock.NewMockUserDirectory(ctrl),
+		poster:  mock.NewMockPostService(ctrl),
+		channel: mock.NewMockChannelService(ctrl),
+		events:  &testutil.FakeNotifier{},
+	}
+	clk := testutil.FakeClock{NowTime: testNow}
+	return New(testutil.FakeLogger{}, m.store, m.held, m.users, m.poster, m.channel, m.events, "bot", clk, mode), m
+}
+
+func expectUserMessages(m *mocks, msgs ...*types.ScheduledMessage) {
+	m.store.EXPECT().ListUserMessages("u1").Return(msgs, nil)
+}
+
+// expectAdminDM expects the summary to be sent to the one System Admin and
+// returns the text it was sent.
+func expectAdminDM(t *testing.T, m *mocks) *string {
+	var text string
+	m.users.EXPECT().List(gomock.Any()).DoAndReturn(func(opts *model.UserGetOptions) ([]*model.User, error) {
+		assert.Equal(t, model.SystemAdminRoleId, opts.Role)
+		assert.True(t, opts.Active)
+		return []*model.User{{Id: "admin"}, {Id: "otherbot", IsBot: true}}, nil
+	})
+	m.poster.EXPECT().DM("bot", "admin", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		text = post.Message
+		return nil
+	})
+	return &text
+}
+
+func TestUserDeactivated_HoldsMessages(t *testing.T) {
+	svc, m := setupService(t, types.DeactivationHold)
+	m1 := &types.ScheduledMessage{ID: "m1", UserID: "u1"}
+	m2 := &types.ScheduledMessage{ID: "m2", UserID: "u1"}
+	expectUserMessages(m, m1, m2)
+	old := &types.ScheduledMessage{ID: "old", UserID: "u1"}
+	m.held.EXPECT().GetHeldMessages("u1").Return([]*types.ScheduledMessage{old}, nil)
+	gomock.InOrder(
+		m.held.EXPECT().SaveHeldMessages("u1", []*types.ScheduledMessage{old, m1, m2}).Return(nil),
+		m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil),
+		m.store.EXPECT().DeleteScheduledMessage("u1", "m2").Return(errors.New("boom")),
+		m.held.EXPECT().SaveHeldMessages("u1", []*types.ScheduledMessage{old, m1}).Return(nil),
+	)
+	summary := expectAdminDM(t, m)
+
+	svc.UserDeactivated(&model.User{Id: "u1", Username: "alice"})
+
+	assert.Contains(t, *summary, "@alice was deactivated. Their 1 pending scheduled messages are held")
+	assert.Empty(t, m.events.Events())
+}
+
+func TestUserDeactivated_CancelsMessages(t *testing.T) {
+	svc, m := setupService(t, types.DeactivationHold)
+	svc.Configure(types.DeactivationCancel)
+	m1 := &types.ScheduledMessage{ID: "m1", UserID: "u1"}
+	expectUserMessages(m, m1)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil)
+	summary := expectAdminDM(t, m)
+
+	svc.UserDeactivated(&model.User{Id: "u1", Username: "alice"})
+
+	assert.Contains(t, *summary, "were cancelled")
+	got := m.events.Events()
+	require.Len(t, got, 1)
+	assert.Equal(t, types.EventCancelled, got[0].Type)
+	assert.Equal(t, "bot", got[0].ActorID)
+}
+
+func TestUserDeactivated_NoMessages(t *testing.T) {
+	svc, m := setupService(t, types.DeactivationHold)
+	m.store.EXPECT().ListUserMessages("u1").Return(nil, nil)
+
+	svc.UserDeactivated(&model.User{Id: "u1", Username: "alice"})
+}
+
+func TestCheckReactivated_RestoresHeldMessages(t *testing.T) {
+	svc, m := setupService(t, types.DeactivationHold)
+	future := &types.ScheduledMessage{ID: "future", UserID: "u1", PostAt: testNow.Add(time.Hour)}
+	past := &types.ScheduledMessage{ID: "past", UserID: "u1", ChannelID: "c1", PostAt: testNow.Add(-time.Hour), MessageContent: "hello", Timezone: "UTC"}
+	m.held.EXPECT().ListHeldUserIDs().Return([]string{"u1", "u2", "u3"}, nil)
+	m.users.EXPECT().Get("u1").Return(&model.User{Id: "u1", Username: "alice"}, nil)
+	m.users.EXPECT().Get("u2").Return(&model.User{Id: "u2", DeleteAt: 1}, nil)
+	m.users.EXPECT().Get("u3").Return(nil, errors.New("gone"))
+	m.held.EXPECT().GetHeldMessages("u1").Return([]*types.ScheduledMessage{future, past}, nil)
+	m.store.EXPECT().SaveScheduledMessage("u1", future).Return(nil)
+	info := &ports.ChannelInfo{ChannelID: "c1"}
+	m.channel.EXPECT().GetInfoOrUnknown("c1").Return(info)
+	m.channel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		assert.Contains(t, post.Message, "your account was deactivated")
+		assert.Contains(t, post.Message, "hello")
+		return nil
+	})
+	m.held.EXPECT().DeleteHeldMessages("u1").Return(nil)
+	summary := expectAdminDM(t, m)
+
+	svc.CheckReactivated()
+
+	assert.Contains(t, *summary, "@alice was reactivated. 1 held scheduled messages were restored. 1 could not be restored")
+}
+
+func TestCheckReactivated_ListError(t *testing.T) {
+	svc, m := setupService(t, types.DeactivationHold)
+	m.held.EXPECT().ListHeldUserIDs().Return(nil, errors.New("boom"))
+
+	svc.CheckReactivated()
+}
+
+func TestStartAndClose(t *testing.T) {
+	svc, _ := setupService(t, types.DeactivationHold)
+	svc.Start()
+	svc.Close()
+}
@@ -0,0 +1,108 @@
+package digest
+
+import (
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service DMs users who opted in a list of their upcoming messages. Daily
+// digests go out on weekdays and weekly ones on Mondays, during the digest
+// hour in the user's timezone.
+type Service struct {
+	logger    ports.Logger
+	prefs     ports.PreferenceStore
+	list      ports.ListService
+	timezones ports.TimezoneService
+	poster    ports.PostService
+	botID     string
+}
+
+func New(
+	logger ports.Logger,
+	prefs ports.PreferenceStore,
+	list ports.ListService,
+	timezones ports.TimezoneService,
+	poster ports.PostService,
+	botID string,
+) *Service {
+	logger.Debug("Creating new digest Service")
+	return &Service{
+		logger:    logger,
+		prefs:     prefs,
+		list:      list,
+		timezones: timezones,
+		poster:    poster,
+		botID:     botID,
+	}
+}
+
+// SendDue sends a digest to each of the users whose digest is due at now.
+func (s *Service) SendDue(userIDs []string, now time.Time) {
+	for _, userID := range userIDs {
+		s.sendIfDue(userID, now)
+	}
+}
+
+// sendIfDue marks the digest as sent before sending it, so a failed save
+// never leads to a digest every minute of the digest hour. The mark is kept
+// apart from the preferences, so it cannot undo a settings change made
+// meanwhile.
+func (s *Service) sendIfDue(userID string, now time.Time) {
+	prefs, err := s.prefs.GetPreferences(userID)
+	if err != nil {
+		s.logger.Warn("Failed to get user preferences for digest", "user_id", userID, "error", err)
+		return
+	}
+	if prefs.Digest == types.DigestOff {
+		return
+	}
+	tz := s.timezones.UserTimezone(userID)
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		s.logger.Warn("Failed to load user timezone for digest, using UTC", "user_id", userID, "timezone", tz, "error", err)
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	today := local.Format(constants.DigestDateLayout)
+	if !isDigestDay(prefs.Digest, local.Weekday()) || local.Hour() != constants.DigestHour {
+		return
+	}
+	marked, err := s.prefs.MarkDigestSent(userID, today)
+	if err != nil {
+		s.logger.Error("Failed to mark digest as sent", "user_id", userID, "error", err)
+		return
+	}
+	if !marked {
+		return
+	}
+
+	window := constants.DigestDailyWindow
+	if prefs.Digest == types.DigestWeekly {
+		window = constants.DigestWeeklyWindow
+	}
+	query := &types.MessageQuery{UserID: userID, From: now, To: now.Add(window), Limit: constants.DigestMaxMessages}
+	post, err := s.list.BuildDigest(query, formatter.FormatDigestHeader(prefs.Digest))
+	if err != nil {
+		s.logger.Error("Failed to build digest", "user_id", userID, "error", err)
+		return
+	}
+	if post == nil {
+		s.logger.Debug("Skipping empty digest", "user_id", userID, "frequency", prefs.Digest)
+		return
+	}
+	s.logger.Debug("Sending digest", "user_id", userID, "frequency", prefs.Digest)
+	if err := s.poster.DM(s.botID, userID, post); err != nil {
+		s.logger.Error("Failed to send digest DM", "user_id", userID, "error", err)
+	}
+}
+
+func isDigestDay(frequency types.DigestFrequency, day time.Weekday) bool {
+	if frequency == types.DigestWeekly {
+		return day == time.Monday
+	}
+	return day != time.Saturday && day != time.Sunday
+}
@@ -0,0 +1,143 @@
+package digest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type mocks struct {
+	prefs     *testutil.FakePreferences
+	list      *mock.MockListService
+	timezones *mock.MockTimezoneService
+	poster    *mock.MockPostService
+}
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		prefs:     &testutil.FakePreferences{},
+		list:      mock.NewMockListService(ctrl),
+		timezones: mock.NewMockTimezoneService(ctrl),
+		poster:    mock.NewMockPostService(ctrl),
+	}
+	return New(testutil.FakeLogger{}, m.prefs, m.list, m.timezones, m.poster, "bot"), m
+}
+
+// monday8am is 8:00 on a Monday in Seoul.
+var monday8am = time.Date(2025, time.January, 6, 8, 0, 0, 0, time.FixedZone("KST", 9*60*60))
+
+func TestSendDue_Daily(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {Digest: types.DigestDaily}}
+	m.timezones.EXPECT().UserTimezone("u1").Return("Asia/Seoul")
+	now := monday8am.UTC()
+	post := &model.Post{Message: "digest"}
+	m.list.EXPECT().BuildDigest(&types.MessageQuery{
+		UserID: "u1",
+		From:   now,
+		To:     now.Add(constants.DigestDailyWindow),
+		Limit:  constants.DigestMaxMessages,
+	}, "### Your scheduled messages for the next day").Return(post, nil)
+	m.poster.EXPECT().DM("bot", "u1", post).Return(nil)
+
+	svc.SendDue([]string{"u1"}, now)
+
+	assert.Equal(t, "2025-01-06", m.prefs.DigestSentOn["u1"])
+	assert.Equal(t, &types.UserPreferences{Digest: types.DigestDaily}, m.prefs.Prefs["u1"])
+}
+
+func TestSendDue_WeeklyUsesWeekWindow(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {Digest: types.DigestWeekly}}
+	m.timezones.EXPECT().UserTimezone("u1").Return("Asia/Seoul")
+	now := monday8am.Add(30 * time.Minute)
+	m.list.EXPECT().BuildDigest(gomock.Any(), "### Your scheduled messages for the next week").DoAndReturn(
+		func(query *types.MessageQuery, _ string) (*model.Post, error) {
+			assert.Equal(t, now.Add(constants.DigestWeeklyWindow), query.To)
+			return &model.Post{}, nil
+		})
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).Return(nil)
+
+	svc.SendDue([]string{"u1"}, now)
+}
+
+func TestSendDue_NotDue(t *testing.T) {
+	tests := []struct {
+		name      string
+		frequency types.DigestFrequency
+		sentOn    string
+		now       time.Time
+	}{
+		{"before digest hour", types.DigestDaily, "", monday8am.Add(-time.Minute)},
+		{"after digest hour", types.DigestDaily, "", monday8am.Add(time.Hour)},
+		{"already sent today", types.DigestDaily, "2025-01-06", monday8am.Add(time.Minute)},
+		{"daily on a weekend", types.DigestDaily, "", monday8am.AddDate(0, 0, -1)},
+		{"weekly on a tuesday", types.DigestWeekly, "", monday8am.AddDate(0, 0, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, m := setupService(t)
+			m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {Digest: tt.frequency}}
+			m.prefs.DigestSentOn = map[string]string{"u1": tt.sentOn}
+			m.timezones.EXPECT().UserTimezone("u1").Return("Asia/Seoul")
+
+			svc.SendDue([]string{"u1"}, tt.now)
+
+			assert.Equal(t, tt.sentOn, m.prefs.DigestSentOn["u1"])
+		})
+	}
+}
+
+func TestSendDue_OptedOut(t *testing.T) {
+	svc, m := setupService(t)
+
+	svc.SendDue([]string{"u1"}, monday8am)
+
+	assert.Empty(t, m.prefs.Prefs)
+	assert.Empty(t, m.prefs.DigestSentOn)
+}
+
+func TestSendDue_MarkFailsSkipsDigest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	prefs := mock.NewMockPreferenceStore(ctrl)
+	timezones := mock.NewMockTimezoneService(ctrl)
+	svc := New(testutil.FakeLogger{}, prefs, mock.NewMockListService(ctrl), timezones, mock.NewMockPostService(ctrl), "bot")
+	prefs.EXPECT().GetPreferences("u1").Return(&types.UserPreferences{Digest: types.DigestDaily}, nil)
+	timezones.EXPECT().UserTimezone("u1").Return("Asia/Seoul")
+	prefs.EXPECT().MarkDigestSent("u1", "2025-01-06").Return(false, errors.New("kv down"))
+
+	svc.SendDue([]string{"u1"}, monday8am)
+}
+
+func TestSendDue_EmptyDigestNotSent(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {Digest: types.DigestDaily}}
+	m.timezones.EXPECT().UserTimezone("u1").Return("Asia/Seoul")
+	m.list.EXPECT().BuildDigest(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	svc.SendDue([]string{"u1"}, monday8am)
+
+	assert.Equal(t, "2025-01-06", m.prefs.DigestSentOn["u1"])
+}
+
+func TestSendDue_InvalidTimezoneFallsBackToUTC(t *testing.T) {
+	svc, m := setupService(t)
+	m.prefs.Prefs = map[string]*types.UserPreferences{"u1": {Digest: types.DigestDaily}}
+	m.timezones.EXPECT().UserTimezone("u1").Return("Not/AZone")
+	m.list.EXPECT().BuildDigest(gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+
+	svc.SendDue([]string{"u1"}, time.Date(2025, time.January, 6, 8, 15, 0, 0, time.UTC))
+
+	assert.Equal(t, "2025-01-06", m.prefs.DigestSentOn["u1"])
+}
@@ -0,0 +1,82 @@
+package draft
+
+import (
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service moves scheduled messages back to their owner's drafts. The plugin
+// API cannot write drafts, so the message is cancelled here and sent to the
+// owner's webapp over the websocket, which saves it as a draft in the
+// message's channel.
+type Service struct {
+	logger   ports.Logger
+	store    ports.Store
+	frontend ports.WebSocketService
+	events   ports.EventNotifier
+}
+
+func New(logger ports.Logger, store ports.Store, frontend ports.WebSocketService, events ports.EventNotifier) *Service {
+	logger.Debug("Creating new draft Service")
+	return &Service{logger: logger, store: store, frontend: frontend, events: events}
+}
+
+// MoveToDraft cancels one of the user's messages and sends it to their
+// webapp as a draft. Someone else's message is reported as not found.
+func (s *Service) MoveToDraft(userID, msgID string) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Moving scheduled message to drafts", "user_id", userID, "message_id", msgID)
+	msg, err := s.store.GetScheduledMessage(msgID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.UserID != userID {
+		s.logger.Warn("User tried to move someone else's message to drafts", "user_id", userID, "message_id", msgID, "owner_user_id", msg.UserID)
+		return nil, types.ErrMessageNotFound
+	}
+	return s.move(msg)
+}
+
+// MoveNextToDraft moves the user's next message due in channelID. It returns
+// nil when the user has nothing scheduled there.
+func (s *Service) MoveNextToDraft(userID, channelID string) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Moving next scheduled message in channel to drafts", "user_id", userID, "channel_id", channelID)
+	page, err := s.store.QueryMessages(&types.MessageQuery{UserID: userID, ChannelIDs: []string{channelID}, Limit: 1})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find message: %w", err)
+	}
+	if len(page.Messages) == 0 {
+		s.logger.Debug("No scheduled messages in channel to move to drafts", "user_id", userID, "channel_id", channelID)
+		return nil, nil
+	}
+	return s.move(page.Messages[0])
+}
+
+func (s *Service) move(msg *types.ScheduledMessage) (*types.ScheduledMessage, error) {
+	if err := s.store.DeleteScheduledMessage(msg.UserID, msg.ID); err != nil {
+		return nil, fmt.Errorf("failed to cancel message: %w", err)
+	}
+	s.logger.Info("Moved scheduled message to drafts", "user_id", msg.UserID, "message_id", msg.ID, "channel_id", msg.ChannelID)
+	s.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, msg.UserID))
+	s.SendToDrafts(msg)
+	return msg, nil
+}
+
+// SendToDrafts hands msg to its owner's webapp to be saved as a draft in the
+// message's channel. It does not touch the stored message.
+func (s *Service) SendToDrafts(msg *types.ScheduledMessage) {
+	fileIDs := msg.FileIDs
+	if fileIDs == nil {
+		fileIDs = []string{}
+	}
+	s.frontend.PublishWebSocketEvent(constants.DraftWebSocketEvent, map[string]any{
+		"user_id":    msg.UserID,
+		"channel_id": msg.ChannelID,
+		"message":    msg.MessageContent,
+		"file_ids":   fileIDs,
+	}, &model.WebsocketBroadcast{UserId: msg.UserID})
+}
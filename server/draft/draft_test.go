@@ -0,0 +1,124 @@
+package draft
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type mocks struct {
+	store    *mock.MockStore
+	frontend *mock.MockWebSocketService
+	events   *testutil.FakeNotifier
+}
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		store:    mock.NewMockStore(ctrl),
+		frontend: mock.NewMockWebSocketService(ctrl),
+		events:   &testutil.FakeNotifier{},
+	}
+	return New(testutil.FakeLogger{}, m.store, m.frontend, m.events), m
+}
+
+func testMessage() *types.ScheduledMessage {
+	return &types.ScheduledMessage{ID: "m1", UserID: "u1", ChannelID: "c1", MessageContent: "hello", FileIDs: []string{"f1", "f2"}}
+}
+
+func TestMoveToDraft(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil)
+	m.frontend.EXPECT().PublishWebSocketEvent(constants.DraftWebSocketEvent, map[string]any{
+		"user_id":    "u1",
+		"channel_id": "c1",
+		"message":    "hello",
+		"file_ids":   []string{"f1", "f2"},
+	}, &model.WebsocketBroadcast{UserId: "u1"})
+
+	msg, err := svc.MoveToDraft("u1", "m1")
+	require.NoError(t, err)
+	assert.Equal(t, "m1", msg.ID)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventCancelled, events[0].Type)
+}
+
+func TestMoveToDraft_NoFiles(t *testing.T) {
+	svc, m := setupService(t)
+	msg := testMessage()
+	msg.FileIDs = nil
+	m.store.EXPECT().GetScheduledMessage("m1").Return(msg, nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil)
+	m.frontend.EXPECT().PublishWebSocketEvent(constants.DraftWebSocketEvent, gomock.Any(), gomock.Any()).
+		Do(func(_ string, payload map[string]any, _ *model.WebsocketBroadcast) {
+			assert.Equal(t, []string{}, payload["file_ids"])
+		})
+
+	_, err := svc.MoveToDraft("u1", "m1")
+	require.NoError(t, err)
+}
+
+func TestMoveToDraft_NotOwner(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+
+	_, err := svc.MoveToDraft("u2", "m1")
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Empty(t, m.events.Events())
+}
+
+func TestMoveToDraft_DeleteFails(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(errors.New("kv down"))
+
+	_, err := svc.MoveToDraft("u1", "m1")
+	assert.ErrorContains(t, err, "kv down")
+	assert.Empty(t, m.events.Events())
+}
+
+func TestSendToDrafts_LeavesStoreAlone(t *testing.T) {
+	svc, m := setupService(t)
+	m.frontend.EXPECT().PublishWebSocketEvent(constants.DraftWebSocketEvent, map[string]any{
+		"user_id":    "u1",
+		"channel_id": "c1",
+		"message":    "hello",
+		"file_ids":   []string{"f1", "f2"},
+	}, &model.WebsocketBroadcast{UserId: "u1"})
+
+	svc.SendToDrafts(testMessage())
+	assert.Empty(t, m.events.Events())
+}
+
+func TestMoveNextToDraft(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().QueryMessages(&types.MessageQuery{UserID: "u1", ChannelIDs: []string{"c1"}, Limit: 1}).
+		Return(&types.MessagePage{Messages: []*types.ScheduledMessage{testMessage()}}, nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil)
+	m.frontend.EXPECT().PublishWebSocketEvent(constants.DraftWebSocketEvent, gomock.Any(), &model.WebsocketBroadcast{UserId: "u1"})
+
+	msg, err := svc.MoveNextToDraft("u1", "c1")
+	require.NoError(t, err)
+	assert.Equal(t, "m1", msg.ID)
+}
+
+func TestMoveNextToDraft_NothingScheduled(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().QueryMessages(gomock.Any()).Return(&types.MessagePage{}, nil)
+
+	msg, err := svc.MoveNextToDraft("u1", "c1")
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+}
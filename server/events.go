@@ -0,0 +1,16 @@
+package main
+
+import (
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// eventFanout delivers each lifecycle event to every notifier in order. The
+// audit log comes first so it stamps the timestamp every later notifier sees.
+type eventFanout []ports.EventNotifier
+
+func (f eventFanout) Notify(event *types.LifecycleEvent) {
+	for _, n := range f {
+		n.Notify(event)
+	}
+}
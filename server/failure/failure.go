@@ -0,0 +1,278 @@
+package failure
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// ErrCannotPost is returned when the owner may not post in the channel they
+// picked.
+var ErrCannotPost = errors.New("you cannot post in that channel")
+
+// Service carries out what the owner picks from a failure DM. The failure DMs
+// themselves are sent by the scheduler, which keeps the failed message so it
+// can be recovered here.
+type Service struct {
+	logger   ports.Logger
+	store    ports.Store
+	failed   ports.FailedMessageStore
+	user     ports.UserService
+	sender   ports.MessageSender
+	policy   ports.PolicyService
+	schedule ports.ScheduleService
+	drafts   ports.DraftService
+	clock    ports.Clock
+	events   ports.EventNotifier
+}
+
+func New(
+	logger ports.Logger,
+	store ports.Store,
+	failed ports.FailedMessageStore,
+	user ports.UserService,
+	sender ports.MessageSender,
+	policy ports.PolicyService,
+	schedule ports.ScheduleService,
+	drafts ports.DraftService,
+	clk ports.Clock,
+	events ports.EventNotifier,
+) *Service {
+	logger.Debug("Creating new failure Service")
+	return &Service{
+		logger:   logger,
+		store:    store,
+		failed:   failed,
+		user:     user,
+		sender:   sender,
+		policy:   policy,
+		schedule: schedule,
+		drafts:   drafts,
+		clock:    clk,
+		events:   events,
+	}
+}
+
+// Retry tries to post the message again straight away. If it fails again the
+// owner gets a new failure DM.
+func (s *Service) Retry(userID, msgID string) (*types.ScheduledMessage, error) {
+	failed, err := s.ownedFailure(userID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Owner asked to retry failed message", "user_id", userID, "message_id", msgID)
+	return s.sendAgain(failed.Message)
+}
+
+// Reschedule puts the message back in the queue, the given amount from now,
+// after the checks a new message in its channel gets.
+func (s *Service) Reschedule(userID, msgID string, by time.Duration) (*types.ScheduledMessage, error) {
+	if by <= 0 {
+		return nil, fmt.Errorf("cannot reschedule by %s", by)
+	}
+	failed, err := s.ownedFailure(userID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	msg := failed.Message
+	if err := s.policy.CheckSchedule(msg.ChannelID); err != nil {
+		return nil, err
+	}
+	if err := s.schedule.Retime(msg, msg.ChannelID, s.clock.Now().UTC().Add(by)); err != nil {
+		return nil, err
+	}
+	return s.requeue(msg)
+}
+
+// Retarget posts the message in another channel straight away, after the
+// checks a new message there gets. If the channel's quiet hours shift it, it
+// is queued for the shifted time instead; check ShiftedFrom.
+func (s *Service) Retarget(userID, msgID, channelID string) (*types.ScheduledMessage, error) {
+	failed, err := s.ownedFailure(userID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.user.HasPermissionToChannel(userID, channelID, model.PermissionCreatePost) {
+		return nil, ErrCannotPost
+	}
+	if err := s.policy.CheckSchedule(channelID); err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Owner asked to post failed message in another channel", "user_id", userID, "message_id", msgID, "channel_id", channelID)
+	msg := failed.Message
+	if err := s.schedule.Retime(msg, channelID, s.clock.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if msg.ShiftedFrom != nil {
+		return s.requeue(msg)
+	}
+	return s.sendAgain(msg)
+}
+
+// Draft moves the message to the owner's drafts in its channel, with its
+// files unless they were what stopped it, and forgets it.
+func (s *Service) Draft(userID, msgID string) (*types.ScheduledMessage, error) {
+	failed, err := s.ownedFailure(userID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.failed.DeleteFailedMessage(msgID); err != nil {
+		return nil, err
+	}
+	msg := failed.Message
+	if failed.Reason == types.FailureFilesMissing {
+		msg.FileIDs = nil
+	}
+	s.drafts.SendToDrafts(msg)
+	s.logger.Info("Moved failed message to owner's drafts", "user_id", userID, "message_id", msgID, "channel_id", msg.ChannelID)
+	return msg, nil
+}
+
+// sendAgain queues msg to be posted now and asks the scheduler to post it.
+// While delivery is paused the message stays queued and ErrDeliveryPaused is
+// returned.
+func (s *Service) sendAgain(msg *types.ScheduledMessage) (*types.ScheduledMessage, error) {
+	msg.PostAt = s.clock.Now().UTC()
+	msg.RemindedAt = nil
+	if err := s.restore(msg); err != nil {
+		return nil, err
+	}
+	if _, err := s.sender.SendNow(msg.ID); err != nil {
+		return msg, err
+	}
+	s.logger.Info("Posted failed message", "user_id", msg.UserID, "message_id", msg.ID, "channel_id", msg.ChannelID)
+	return msg, nil
+}
+
+// requeue saves msg back in the queue for its PostAt.
+func (s *Service) requeue(msg *types.ScheduledMessage) (*types.ScheduledMessage, error) {
+	if err := s.restore(msg); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Rescheduled failed message", "user_id", msg.UserID, "message_id", msg.ID, "channel_id", msg.ChannelID, "post_at", msg.PostAt)
+	s.events.Notify(types.NewLifecycleEvent(types.EventScheduled, msg, msg.UserID))
+	return msg, nil
+}
+
+func (s *Service) restore(msg *types.ScheduledMessage) error {
+	if err := s.store.SaveScheduledMessage(msg.UserID, msg); err != nil {
+		return fmt.Errorf("failed to restore message: %w", err)
+	}
+	if err := s.failed.DeleteFailedMessage(msg.ID); err != nil {
+		s.logger.Warn("Failed to forget restored message", "user_id", msg.UserID, "message_id", msg.ID, "error", err)
+	}
+	return nil
+}
+
+// ownedFailure loads the failed message msgID, reporting someone else's as
+// not found.
+func (s *Service) ownedFailure(userID, msgID string) (*types.FailedMessage, error) {
+	failed, err := s.failed.GetFailedMessage(msgID)
+	if err != nil {
+		return nil, err
+	}
+	if failed.Message.UserID != userID {
+		s.logger.Warn("User tried to act on someone else's failed message", "user_id", userID, "message_id", msgID, "owner_user_id", failed.Message.UserID)
+		return nil, types.ErrMessageNotFound
+	}
+	return failed, nil
+}
+
+// Classify works out why a message could not be posted from the error the
+// policy or the server returned.
+func Classify(err error) types.FailureReason {
+	if errors.Is(err, types.ErrPolicyDenied) {
+		return types.FailureNoPermission
+	}
+	var appErr *model.AppError
+	if !errors.As(err, &appErr) {
+		return types.FailureServerError
+	}
+	switch {
+	case appErr.Id == constants.FailureAppErrArchived || strings.Contains(appErr.Id, "archived"):
+		return types.FailureChannelArchived
+	case appErr.Id == constants.FailureAppErrTooLong:
+		return types.FailureTooLong
+	case appErr.Id == constants.FailureAppErrFileIDs || strings.Contains(appErr.Id, "file"):
+		return types.FailureFilesMissing
+	case appErr.Id == constants.FailureAppErrPermissions || appErr.StatusCode == http.StatusForbidden:
+		return types.FailureNoPermission
+	default:
+		return types.FailureServerError
+	}
+}
+
+// NewFailedMessage records that msg could not be posted at failedAt.
+func NewFailedMessage(msg *types.ScheduledMessage, err error, failedAt time.Time) *types.FailedMessage {
+	return &types.FailedMessage{
+		Message:  msg,
+		Reason:   Classify(err),
+		Error:    err.Error(),
+		FailedAt: failedAt,
+	}
+}
+
+// NewPost builds the failure DM for failed, with buttons to retry, reschedule
+// or retarget the message, or move it to the owner's drafts.
+func NewPost(failed *types.FailedMessage, channelLink string) *model.Post {
+	msg := failed.Message
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	action := func(name string) *model.PostActionIntegration {
+		return &model.PostActionIntegration{
+			URL:     constants.FailureActionURL,
+			Context: map[string]any{"action": name, "id": msg.ID},
+		}
+	}
+	options := make([]*model.PostActionOptions, 0, len(constants.ReminderPostponeOptions))
+	for _, option := range constants.ReminderPostponeOptions {
+		options = append(options, &model.PostActionOptions{Text: option.Text, Value: option.Value})
+	}
+	post := &model.Post{Message: formatter.FormatFailureNotice(msg.PostAt.In(loc), loc.String(), channelLink, failed.Reason, failed.Error, msg.MessageContent)}
+	if failed.Reason != types.FailureFilesMissing {
+		post.FileIds = msg.FileIDs
+	}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Actions: []*model.PostAction{
+			{
+				Id:          constants.FailureActionRetry,
+				Name:        "Retry now",
+				Type:        model.PostActionTypeButton,
+				Style:       "primary",
+				Integration: action(constants.FailureActionRetry),
+			},
+			{
+				Id:          constants.FailureActionReschedule,
+				Name:        "Reschedule in...",
+				Type:        model.PostActionTypeSelect,
+				Options:     options,
+				Integration: action(constants.FailureActionReschedule),
+			},
+			{
+				Id:          constants.FailureActionRetarget,
+				Name:        "Post in channel...",
+				Type:        model.PostActionTypeSelect,
+				DataSource:  "channels",
+				Integration: action(constants.FailureActionRetarget),
+			},
+			{
+				Id:          constants.FailureActionDraft,
+				Name:        "Move to draft",
+				Type:        model.PostActionTypeButton,
+				Integration: action(constants.FailureActionDraft),
+			},
+		},
+	}})
+	return post
+}
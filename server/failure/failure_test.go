@@ -0,0 +1,316 @@
+package failure
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type mocks struct {
+	store    *mock.MockStore
+	failed   *testutil.FakeFailedMessages
+	user     *mock.MockUserService
+	sender   *mock.MockMessageSender
+	policy   *testutil.FakePolicy
+	schedule *mock.MockScheduleService
+	drafts   *mock.MockDraftService
+	events   *testutil.FakeNotifier
+}
+
+var now = time.Date(2025, 1, 2, 9, 30, 0, 0, time.UTC)
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		store:    mock.NewMockStore(ctrl),
+		failed:   &testutil.FakeFailedMessages{},
+		user:     mock.NewMockUserService(ctrl),
+		sender:   mock.NewMockMessageSender(ctrl),
+		policy:   &testutil.FakePolicy{},
+		schedule: mock.NewMockScheduleService(ctrl),
+		drafts:   mock.NewMockDraftService(ctrl),
+		events:   &testutil.FakeNotifier{},
+	}
+	svc := New(testutil.FakeLogger{}, m.store, m.failed, m.user, m.sender, m.policy, m.schedule, m.drafts, testutil.FakeClock{NowTime: now}, m.events)
+	failedAt := now.Add(-30 * time.Minute)
+	m.failed.Messages = map[string]*types.FailedMessage{
+		"m1": {
+			Message: &types.ScheduledMessage{
+				ID:             "m1",
+				UserID:         "u1",
+				ChannelID:      "c1",
+				PostAt:         failedAt,
+				MessageContent: "hello",
+				Timezone:       "UTC",
+				RemindedAt:     &failedAt,
+			},
+			Reason:   types.FailureChannelArchived,
+			Error:    "channel archived",
+			FailedAt: failedAt,
+		},
+	}
+	return svc, m
+}
+
+// expectRetime expects msg to be retimed to channelID and postAt, and moves it
+// there unchanged.
+func expectRetime(m *mocks, channelID string, postAt time.Time) {
+	m.schedule.EXPECT().Retime(gomock.Any(), channelID, postAt).DoAndReturn(func(msg *types.ScheduledMessage, channelID string, postAt time.Time) error {
+		msg.ChannelID = channelID
+		msg.PostAt = postAt
+		return nil
+	})
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want types.FailureReason
+	}{
+		{"policy denial", &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelDisabled}, types.FailureNoPermission},
+		{"archived channel", model.NewAppError("CreatePost", constants.FailureAppErrArchived, nil, "", http.StatusBadRequest), types.FailureChannelArchived},
+		{"too long", model.NewAppError("Post.IsValid", constants.FailureAppErrTooLong, nil, "", http.StatusBadRequest), types.FailureTooLong},
+		{"file ids", model.NewAppError("Post.IsValid", constants.FailureAppErrFileIDs, nil, "", http.StatusBadRequest), types.FailureFilesMissing},
+		{"forbidden", model.NewAppError("CreatePost", "api.post.create_post.channel_read_only", nil, "", http.StatusForbidden), types.FailureNoPermission},
+		{"wrapped app error", fmt.Errorf("create post: %w", model.NewAppError("CreatePost", constants.FailureAppErrPermissions, nil, "", http.StatusForbidden)), types.FailureNoPermission},
+		{"other app error", model.NewAppError("CreatePost", "app.post.save.app_error", nil, "", http.StatusInternalServerError), types.FailureServerError},
+		{"plain error", errors.New("connection reset"), types.FailureServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Classify(tt.err))
+		})
+	}
+}
+
+func TestNewPost(t *testing.T) {
+	msg := &types.ScheduledMessage{ID: "m1", PostAt: now, Timezone: "UTC", MessageContent: "hello", FileIDs: []string{"f1"}}
+	post := NewPost(NewFailedMessage(msg, errors.New("boom"), now), "in channel: ~town-square")
+
+	assert.Contains(t, post.Message, "in channel: ~town-square could not be posted because the server could not post it")
+	assert.Contains(t, post.Message, "Details: boom")
+	assert.Equal(t, model.StringArray{"f1"}, post.FileIds)
+	actions := post.Attachments()[0].Actions
+	require.Len(t, actions, 4)
+	assert.Equal(t, constants.FailureActionRetry, actions[0].Integration.Context["action"])
+	assert.Equal(t, model.PostActionTypeSelect, actions[1].Type)
+	assert.Len(t, actions[1].Options, len(constants.ReminderPostponeOptions))
+	assert.Equal(t, "channels", actions[2].DataSource)
+	assert.Equal(t, constants.FailureActionDraft, actions[3].Integration.Context["action"])
+	for _, action := range actions {
+		assert.Equal(t, constants.FailureActionURL, action.Integration.URL)
+		assert.Equal(t, "m1", action.Integration.Context["id"])
+	}
+}
+
+func TestNewPost_MissingFilesNotAttached(t *testing.T) {
+	msg := &types.ScheduledMessage{ID: "m1", Timezone: "UTC", FileIDs: []string{"f1"}}
+	fileErr := model.NewAppError("Post.IsValid", constants.FailureAppErrFileIDs, nil, "", http.StatusBadRequest)
+
+	post := NewPost(NewFailedMessage(msg, fileErr, now), "in channel: ~town-square")
+
+	assert.Empty(t, post.FileIds)
+}
+
+func TestRetry(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().SaveScheduledMessage("u1", gomock.Any()).DoAndReturn(func(_ string, msg *types.ScheduledMessage) error {
+		assert.Equal(t, now, msg.PostAt)
+		assert.Nil(t, msg.RemindedAt)
+		return nil
+	})
+	m.sender.EXPECT().SendNow("m1").Return(&types.ScheduledMessage{ID: "m1"}, nil)
+
+	msg, err := svc.Retry("u1", "m1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "c1", msg.ChannelID)
+	assert.Empty(t, m.failed.Messages)
+}
+
+func TestRetry_SomeoneElsesMessage(t *testing.T) {
+	svc, m := setupService(t)
+
+	_, err := svc.Retry("u2", "m1")
+
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Len(t, m.failed.Messages, 1)
+}
+
+func TestRetry_Unknown(t *testing.T) {
+	svc, _ := setupService(t)
+
+	_, err := svc.Retry("u1", "missing")
+
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+}
+
+func TestRetry_FailsAgain(t *testing.T) {
+	svc, m := setupService(t)
+	postErr := errors.New("still archived")
+	m.store.EXPECT().SaveScheduledMessage("u1", gomock.Any()).Return(nil)
+	m.sender.EXPECT().SendNow("m1").Return(nil, postErr)
+
+	_, err := svc.Retry("u1", "m1")
+
+	assert.ErrorIs(t, err, postErr)
+}
+
+func TestReschedule(t *testing.T) {
+	svc, m := setupService(t)
+	expectRetime(m, "c1", now.Add(time.Hour))
+	m.store.EXPECT().SaveScheduledMessage("u1", gomock.Any()).Return(nil)
+
+	msg, err := svc.Reschedule("u1", "m1", time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour), msg.PostAt)
+	assert.Empty(t, m.failed.Messages)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventScheduled, events[0].Type)
+	assert.Equal(t, "u1", events[0].ActorID)
+}
+
+func TestReschedule_PolicyDenied(t *testing.T) {
+	svc, m := setupService(t)
+	m.policy.ScheduleErr = &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelDisabled}
+
+	_, err := svc.Reschedule("u1", "m1", time.Hour)
+
+	assert.ErrorIs(t, err, types.ErrPolicyDenied)
+	assert.Len(t, m.failed.Messages, 1)
+}
+
+func TestReschedule_CheckFails(t *testing.T) {
+	svc, m := setupService(t)
+	m.schedule.EXPECT().Retime(gomock.Any(), "c1", now.Add(time.Hour)).Return(errors.New("within the quiet hours"))
+
+	_, err := svc.Reschedule("u1", "m1", time.Hour)
+
+	assert.ErrorContains(t, err, "quiet hours")
+	assert.Len(t, m.failed.Messages, 1)
+	assert.Empty(t, m.events.Events())
+}
+
+func TestRetarget(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	expectRetime(m, "c2", now)
+	m.store.EXPECT().SaveScheduledMessage("u1", gomock.Any()).DoAndReturn(func(_ string, msg *types.ScheduledMessage) error {
+		assert.Equal(t, "c2", msg.ChannelID)
+		return nil
+	})
+	m.sender.EXPECT().SendNow("m1").Return(&types.ScheduledMessage{ID: "m1"}, nil)
+
+	msg, err := svc.Retarget("u1", "m1", "c2")
+
+	require.NoError(t, err)
+	assert.Equal(t, "c2", msg.ChannelID)
+}
+
+func TestRetarget_PolicyDenied(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	m.policy.ScheduleErr = &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelDisabled}
+
+	_, err := svc.Retarget("u1", "m1", "c2")
+
+	assert.ErrorIs(t, err, types.ErrPolicyDenied)
+	assert.Len(t, m.failed.Messages, 1)
+}
+
+func TestRetarget_QuietHoursRefused(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	m.schedule.EXPECT().Retime(gomock.Any(), "c2", now).Return(errors.New("within the quiet hours"))
+
+	_, err := svc.Retarget("u1", "m1", "c2")
+
+	assert.ErrorContains(t, err, "quiet hours")
+	assert.Len(t, m.failed.Messages, 1)
+}
+
+func TestRetarget_QuietHoursShiftQueues(t *testing.T) {
+	svc, m := setupService(t)
+	shifted := now.Add(12 * time.Hour)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	m.schedule.EXPECT().Retime(gomock.Any(), "c2", now).DoAndReturn(func(msg *types.ScheduledMessage, channelID string, postAt time.Time) error {
+		msg.ChannelID = channelID
+		msg.PostAt = shifted
+		msg.ShiftedFrom = &postAt
+		return nil
+	})
+	m.store.EXPECT().SaveScheduledMessage("u1", gomock.Any()).Return(nil)
+
+	msg, err := svc.Retarget("u1", "m1", "c2")
+
+	require.NoError(t, err)
+	assert.Equal(t, shifted, msg.PostAt)
+	assert.Empty(t, m.failed.Messages)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventScheduled, events[0].Type)
+}
+
+func TestRetarget_CannotPost(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(false)
+
+	_, err := svc.Retarget("u1", "m1", "c2")
+
+	assert.ErrorIs(t, err, ErrCannotPost)
+	assert.Len(t, m.failed.Messages, 1)
+}
+
+func TestDraft(t *testing.T) {
+	svc, m := setupService(t)
+	m.failed.Messages["m1"].Message.FileIDs = []string{"f1"}
+	m.drafts.EXPECT().SendToDrafts(gomock.Any()).Do(func(msg *types.ScheduledMessage) {
+		assert.Equal(t, "c1", msg.ChannelID)
+		assert.Equal(t, "hello", msg.MessageContent)
+		assert.Equal(t, []string{"f1"}, msg.FileIDs)
+	})
+
+	msg, err := svc.Draft("u1", "m1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "hello", msg.MessageContent)
+	assert.Empty(t, m.failed.Messages)
+}
+
+func TestDraft_FilesMissing(t *testing.T) {
+	svc, m := setupService(t)
+	m.failed.Messages["m1"].Message.FileIDs = []string{"f1"}
+	m.failed.Messages["m1"].Reason = types.FailureFilesMissing
+	m.drafts.EXPECT().SendToDrafts(gomock.Any()).Do(func(msg *types.ScheduledMessage) {
+		assert.Empty(t, msg.FileIDs)
+	})
+
+	_, err := svc.Draft("u1", "m1")
+
+	require.NoError(t, err)
+}
+
+func TestDraft_SomeoneElses(t *testing.T) {
+	svc, m := setupService(t)
+
+	_, err := svc.Draft("u2", "m1")
+
+	require.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Contains(t, m.failed.Messages, "m1")
+}
@@ -0,0 +1,142 @@
+package feed
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// ErrInvalidToken is returned by Render when the token does not belong to any user.
+var ErrInvalidToken = errors.New(constants.ErrFeedInvalidLink)
+
+type Service struct {
+	logger  ports.Logger
+	tokens  ports.FeedTokenStore
+	store   ports.Store
+	channel ports.ChannelService
+	config  ports.ConfigService
+	clock   ports.Clock
+}
+
+func New(
+	logger ports.Logger,
+	tokens ports.FeedTokenStore,
+	store ports.Store,
+	channel ports.ChannelService,
+	config ports.ConfigService,
+	clk ports.Clock,
+) *Service {
+	logger.Debug("Creating new feed Service")
+	return &Service{
+		logger:  logger,
+		tokens:  tokens,
+		store:   store,
+		channel: channel,
+		config:  config,
+		clock:   clk,
+	}
+}
+
+// URL returns the user's calendar feed URL, creating a token on first use.
+func (s *Service) URL(userID string) (string, error) {
+	s.logger.Debug("Getting calendar feed URL", "user_id", userID)
+	token, err := s.tokens.GetFeedToken(userID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load calendar feed token: %w", err)
+	}
+	if token == "" {
+		s.logger.Debug("User has no calendar feed token yet, creating one", "user_id", userID)
+		return s.Rotate(userID)
+	}
+	return s.buildURL(token)
+}
+
+// Rotate replaces the user's calendar feed token, invalidating the previous URL.
+func (s *Service) Rotate(userID string) (string, error) {
+	s.logger.Debug("Rotating calendar feed token", "user_id", userID)
+	token, err := generateToken()
+	if err != nil {
+		s.logger.Error("Failed to generate calendar feed token", "user_id", userID, "error", err)
+		return "", fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	if err := s.tokens.SaveFeedToken(userID, token); err != nil {
+		return "", fmt.Errorf("failed to save calendar feed token: %w", err)
+	}
+	s.logger.Info("Calendar feed token rotated", "user_id", userID)
+	return s.buildURL(token)
+}
+
+// Render returns the iCalendar document for the owner of token.
+func (s *Service) Render(token string) ([]byte, error) {
+	s.logger.Debug("Rendering calendar feed")
+	userID, err := s.tokens.GetFeedTokenOwner(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up calendar feed: %w", err)
+	}
+	if userID == "" {
+		s.logger.Debug("Calendar feed token has no owner")
+		return nil, ErrInvalidToken
+	}
+
+	msgs, err := s.loadMessages(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	channelCache := make(map[string]string)
+	events := make([]event, 0, len(msgs))
+	for _, m := range msgs {
+		if _, ok := channelCache[m.ChannelID]; !ok {
+			channelCache[m.ChannelID] = s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(m.ChannelID))
+		}
+		events = append(events, buildEvent(m, channelCache[m.ChannelID]))
+	}
+	s.logger.Debug("Rendered calendar feed", "user_id", userID, "events", len(events))
+	return encodeCalendar(events, s.clock.Now()), nil
+}
+
+func (s *Service) loadMessages(userID string) ([]*types.ScheduledMessage, error) {
+	msgs, err := s.store.ListUserMessages(userID)
+	if err != nil {
+		s.logger.Error("Failed to list user messages for calendar feed", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	return msgs, nil
+}
+
+func (s *Service) buildURL(token string) (string, error) {
+	cfg := s.config.GetConfig()
+	if cfg == nil || cfg.ServiceSettings.SiteURL == nil || *cfg.ServiceSettings.SiteURL == "" {
+		s.logger.Warn("Cannot build calendar feed URL without a Site URL")
+		return "", errors.New(constants.ErrFeedNoSiteURL)
+	}
+	siteURL := strings.TrimRight(*cfg.ServiceSettings.SiteURL, "/")
+	return fmt.Sprintf("%s/plugins/%s%s%s%s", siteURL, constants.PluginID, constants.FeedPathPrefix, token, constants.FeedFileExtension), nil
+}
+
+func buildEvent(m *types.ScheduledMessage, channelLink string) event {
+	description := excerpt(m.MessageContent, constants.FeedExcerptRunes)
+	if len(m.FileIDs) > 0 {
+		description = fmt.Sprintf("+ %d files\n%s", len(m.FileIDs), description)
+	}
+	return event{
+		UID:         fmt.Sprintf("%s@%s", m.ID, constants.PluginID),
+		Start:       m.PostAt,
+		Summary:     fmt.Sprintf("Scheduled message %s", channelLink),
+		Description: description,
+	}
+}
+
+func generateToken() (string, error) {
+	b := make([]byte, constants.FeedTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
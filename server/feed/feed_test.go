@@ -0,0 +1,126 @@
+package feed
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type feedMocks struct {
+	tokens  *mock.MockFeedTokenStore
+	store   *mock.MockStore
+	channel *mock.MockChannelService
+	config  *mock.MockConfigService
+}
+
+func setupFeed(t *testing.T) (*Service, *feedMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &feedMocks{
+		tokens:  mock.NewMockFeedTokenStore(ctrl),
+		store:   mock.NewMockStore(ctrl),
+		channel: mock.NewMockChannelService(ctrl),
+		config:  mock.NewMockConfigService(ctrl),
+	}
+	clk := testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	return New(testutil.FakeLogger{}, m.tokens, m.store, m.channel, m.config, clk), m
+}
+
+func siteConfig(url string) *model.Config {
+	cfg := &model.Config{}
+	cfg.ServiceSettings.SiteURL = model.NewPointer(url)
+	return cfg
+}
+
+func TestURL_ExistingToken(t *testing.T) {
+	svc, m := setupFeed(t)
+	m.tokens.EXPECT().GetFeedToken("user").Return("abc", nil)
+	m.config.EXPECT().GetConfig().Return(siteConfig("https://chat.example.com/"))
+
+	url, err := svc.URL("user")
+	require.NoError(t, err)
+	assert.Equal(t, "https://chat.example.com/plugins/com.mattermost-plugin-schedule-message-gui/feed/abc.ics", url)
+}
+
+func TestURL_CreatesTokenWhenMissing(t *testing.T) {
+	svc, m := setupFeed(t)
+	var saved string
+	m.tokens.EXPECT().GetFeedToken("user").Return("", nil)
+	m.tokens.EXPECT().SaveFeedToken("user", gomock.Any()).DoAndReturn(func(_ string, token string) error {
+		saved = token
+		return nil
+	})
+	m.config.EXPECT().GetConfig().Return(siteConfig("https://chat.example.com"))
+
+	url, err := svc.URL("user")
+	require.NoError(t, err)
+	assert.Len(t, saved, 64)
+	assert.True(t, strings.HasSuffix(url, "/feed/"+saved+".ics"))
+}
+
+func TestURL_NoSiteURL(t *testing.T) {
+	svc, m := setupFeed(t)
+	m.tokens.EXPECT().GetFeedToken("user").Return("abc", nil)
+	m.config.EXPECT().GetConfig().Return(&model.Config{})
+
+	_, err := svc.URL("user")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Site URL")
+}
+
+func TestRotate_SaveError(t *testing.T) {
+	svc, m := setupFeed(t)
+	m.tokens.EXPECT().SaveFeedToken("user", gomock.Any()).Return(errors.New("kv down"))
+
+	_, err := svc.Rotate("user")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "kv down")
+}
+
+func TestRender_InvalidToken(t *testing.T) {
+	svc, m := setupFeed(t)
+	m.tokens.EXPECT().GetFeedTokenOwner("nope").Return("", nil)
+
+	_, err := svc.Render("nope")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestRender_Events(t *testing.T) {
+	svc, m := setupFeed(t)
+	later := &types.ScheduledMessage{ID: "b", UserID: "user", ChannelID: "c1", PostAt: time.Date(2025, 1, 3, 9, 0, 0, 0, time.UTC), MessageContent: "second"}
+	sooner := &types.ScheduledMessage{ID: "a", UserID: "user", ChannelID: "c1", PostAt: time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC), MessageContent: "first", FileIDs: []string{"f1"}}
+	info := &ports.ChannelInfo{ChannelID: "c1", ChannelLink: "~town-square"}
+
+	m.tokens.EXPECT().GetFeedTokenOwner("tok").Return("user", nil)
+	m.store.EXPECT().ListUserMessages("user").Return([]*types.ScheduledMessage{sooner, later}, nil)
+	m.channel.EXPECT().GetInfoOrUnknown("c1").Return(info).Times(1)
+	m.channel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square").Times(1)
+
+	out, err := svc.Render("tok")
+	require.NoError(t, err)
+	body := string(out)
+	assert.Equal(t, 2, strings.Count(body, "BEGIN:VEVENT"))
+	assert.Less(t, strings.Index(body, "UID:a@"), strings.Index(body, "UID:b@"))
+	assert.Contains(t, body, `DESCRIPTION:+ 1 files\nfirst`)
+	assert.Contains(t, body, "SUMMARY:Scheduled message in channel: ~town-square")
+}
+
+func TestRender_ListError(t *testing.T) {
+	svc, m := setupFeed(t)
+	m.tokens.EXPECT().GetFeedTokenOwner("tok").Return("user", nil)
+	m.store.EXPECT().ListUserMessages("user").Return(nil, errors.New("kv down"))
+
+	_, err := svc.Render("tok")
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package feed
+
+import (
+	"strings"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+)
+
+const (
+	icalTimeLayout  = "20060102T150405Z"
+	icalLineBreak   = "\r\n"
+	icalMaxLineSize = 75
+)
+
+type event struct {
+	UID         string
+	Start       time.Time
+	Summary     string
+	Description string
+}
+
+func encodeCalendar(events []event, now time.Time) []byte {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:"+constants.FeedProductID)
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:"+escapeText(constants.FeedCalendarName))
+	stamp := now.UTC().Format(icalTimeLayout)
+	for _, e := range events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+e.UID)
+		writeLine(&b, "DTSTAMP:"+stamp)
+		writeLine(&b, "DTSTART:"+e.Start.UTC().Format(icalTimeLayout))
+		writeLine(&b, "DTEND:"+e.Start.Add(constants.FeedEventDuration).UTC().Format(icalTimeLayout))
+		writeLine(&b, "SUMMARY:"+escapeText(e.Summary))
+		writeLine(&b, "DESCRIPTION:"+escapeText(e.Description))
+		writeLine(&b, "TRANSP:TRANSPARENT")
+		writeLine(&b, "END:VEVENT")
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// writeLine folds content lines longer than 75 octets as required by RFC 5545,
+// taking care not to split a multi-byte UTF-8 sequence.
+func writeLine(b *strings.Builder, line string) {
+	limit := icalMaxLineSize
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isRuneStart(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString(icalLineBreak)
+		b.WriteString(" ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards the limit.
+		limit = icalMaxLineSize - 1
+	}
+	b.WriteString(line)
+	b.WriteString(icalLineBreak)
+}
+
+func isRuneStart(c byte) bool {
+	return c&0xC0 != 0x80
+}
+
+func escapeText(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+func excerpt(s string, maxRunes int) string {
+	runes := []rune(strings.TrimSpace(s))
+	if len(runes) <= maxRunes {
+		return string(runes)
+	}
+	return string(runes[:maxRunes]) + "…"
+}
@@ -0,0 +1,59 @@
+package feed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `a\, b\; c\\d\ne`, escapeText("a, b; c\\d\r\ne"))
+}
+
+func TestExcerpt(t *testing.T) {
+	assert.Equal(t, "short", excerpt("  short  ", 10))
+	assert.Equal(t, "héllo…", excerpt("héllo world", 5))
+}
+
+func TestWriteLine_FoldsLongLines(t *testing.T) {
+	var b strings.Builder
+	writeLine(&b, "DESCRIPTION:"+strings.Repeat("é", 60))
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), icalLineBreak), icalLineBreak)
+	assert.Greater(t, len(lines), 1)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), icalMaxLineSize)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+		assert.True(t, strings.ToValidUTF8(line, "") == line, "line %d splits a rune", i)
+	}
+}
+
+func TestEncodeCalendar(t *testing.T) {
+	now := time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2025, 1, 2, 9, 30, 0, 0, time.UTC)
+	out := string(encodeCalendar([]event{{
+		UID:         "id1@plugin",
+		Start:       start,
+		Summary:     "Scheduled message in channel: ~town-square",
+		Description: "hello, world",
+	}}, now))
+
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\n"))
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"))
+	assert.Contains(t, out, "UID:id1@plugin\r\n")
+	assert.Contains(t, out, "DTSTAMP:20250101T080000Z\r\n")
+	assert.Contains(t, out, "DTSTART:20250102T093000Z\r\n")
+	assert.Contains(t, out, "DTEND:20250102T094500Z\r\n")
+	assert.Contains(t, out, `DESCRIPTION:hello\, world`)
+	assert.Equal(t, 1, strings.Count(out, "BEGIN:VEVENT"))
+}
+
+func TestEncodeCalendar_NoEvents(t *testing.T) {
+	out := string(encodeCalendar(nil, time.Now()))
+	assert.NotContains(t, out, "VEVENT")
+	assert.Contains(t, out, "END:VCALENDAR")
+}
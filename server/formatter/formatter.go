@@ -2,13 +2,16 @@ package formatter
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
-func FormatScheduleSuccess(postAt time.Time, tz, channelLink string) string {
-	return fmt.Sprintf("%s Scheduled message for %s (%s) %s", constants.EmojiSuccess, postAt.Format(constants.TimeLayout), tz, channelLink)
+func FormatScheduleSuccess(postAt time.Time, layout, tz, channelLink string) string {
+	return fmt.Sprintf("%s Scheduled message for %s (%s) %s", constants.EmojiSuccess, postAt.Format(layout), tz, channelLink)
 }
 
 func FormatEmptyCommandError() string {
@@ -28,6 +31,499 @@ func FormatSchedulerFailure(channelLink string, postErr error, originalMsg strin
 	return fmt.Sprintf("%s Error scheduling message %s: %v -- original message: %s", constants.EmojiError, channelLink, postErr, originalMsg)
 }
 
-func FormatListAttachmentHeader(postAt time.Time, channelLink, messageContent string) string {
-	return fmt.Sprintf("##### %s\n%s\n\n%s", postAt.Format(constants.TimeLayout), channelLink, messageContent)
+func FormatListAttachmentHeader(postAt time.Time, layout, channelLink, messageContent string) string {
+	return fmt.Sprintf("##### %s\n%s\n\n%s", postAt.Format(layout), channelLink, messageContent)
+}
+
+func FormatFeedURL(url string, rotated bool) string {
+	intro := "Subscribe to this link in your calendar app to see your upcoming scheduled messages:"
+	if rotated {
+		intro = fmt.Sprintf("%s Your calendar feed link has been replaced. The old link no longer works. Your new link:", constants.EmojiSuccess)
+	}
+	rotateCommand := fmt.Sprintf("/%s %s %s %s", constants.CommandTrigger, constants.SubcommandSettings, constants.SettingsFeed, constants.SettingsFeedRotate)
+	return fmt.Sprintf("%s\n%s\n\n`%s`\n\nAnyone with this link can see your scheduled messages. Use `%s` to replace it.", constants.FeedHeader, intro, url, rotateCommand)
+}
+
+func FormatUnknownSettingsCommand(text string) string {
+	helpCommand := fmt.Sprintf("/%s %s", constants.CommandTrigger, constants.SubcommandHelp)
+	return fmt.Sprintf("%s Unknown settings option `%s`. Use %s for instructions.", constants.EmojiError, text, helpCommand)
+}
+
+func FormatListMore(cursor string) string {
+	return fmt.Sprintf("More messages are available. Repeat the same `/%s %s` command with `%s%s` added to see them.", constants.CommandTrigger, constants.SubcommandList, constants.ListFilterCursor, cursor)
+}
+
+func FormatListFilterError(err error) string {
+	helpCommand := fmt.Sprintf("/%s %s", constants.CommandTrigger, constants.SubcommandHelp)
+	return fmt.Sprintf("%s Could not list messages: %v. Use %s for instructions.", constants.EmojiError, err, helpCommand)
+}
+
+func FormatAdminListEntry(msgID string, postAt time.Time, tz, owner, channelLink, content string) string {
+	return fmt.Sprintf("- `%s` **%s** (%s) by @%s %s\n  > %s", msgID, postAt.Format(constants.TimeLayout), tz, owner, channelLink, Excerpt(content, constants.AdminListExcerptRunes))
+}
+
+func FormatAdminListTruncated(shown, total int) string {
+	return fmt.Sprintf("_Showing %d of %d messages. Add `@user` or `~channel` to narrow the list._", shown, total)
+}
+
+func FormatAdminCancelled(msgID, owner string, postAt time.Time, tz, channelLink string) string {
+	return fmt.Sprintf("%s Cancelled message `%s` from @%s scheduled for %s (%s) %s. The owner has been notified.", constants.EmojiSuccess, msgID, owner, postAt.Format(constants.TimeLayout), tz, channelLink)
+}
+
+func FormatAdminCancelNotice(postAt time.Time, tz, channelLink, content string) string {
+	return fmt.Sprintf("%s A System Admin cancelled your message scheduled for %s (%s) %s. It will not be sent. Original message:\n\n%s", constants.EmojiWarning, postAt.Format(constants.TimeLayout), tz, channelLink, content)
+}
+
+func FormatAdminUsage() string {
+	return fmt.Sprintf("Usage: `/%[1]s %[2]s %[3]s [@user] [~channel]`, `/%[1]s %[2]s %[4]s <id>`, `/%[1]s %[2]s %[5]s`, `/%[1]s %[2]s %[6]s [<duration>] [send|skip]` or `/%[1]s %[2]s %[7]s [send|skip]`",
+		constants.CommandTrigger, constants.SubcommandAdmin, constants.AdminList, constants.AdminCancel, constants.AdminStatus, constants.AdminPause, constants.AdminResume)
+}
+
+// FormatDeliveryPaused confirms a pause to the admin. Times are in UTC.
+func FormatDeliveryPaused(state *types.PauseState) string {
+	if state.ResumeAt == nil {
+		return fmt.Sprintf("%s Delivery of all scheduled messages is paused until you run `/%s %s %s`.", constants.EmojiWarning, constants.CommandTrigger, constants.SubcommandAdmin, constants.AdminResume)
+	}
+	return fmt.Sprintf("%s Delivery of all scheduled messages is paused until %s UTC. Messages that come due before then will be %s.",
+		constants.EmojiWarning, state.ResumeAt.UTC().Format(constants.TimeLayout), describeResumeMode(state.ResumeMode))
+}
+
+// FormatDeliveryResumed confirms a resume to the admin.
+func FormatDeliveryResumed(result *types.ResumeResult) string {
+	if result.Mode == types.ResumeSkip {
+		return fmt.Sprintf("%s Delivery resumed. Skipped %d overdue messages and returned them to their owners.", constants.EmojiSuccess, result.Skipped)
+	}
+	return fmt.Sprintf("%s Delivery resumed. Overdue messages will be sent within a minute.", constants.EmojiSuccess)
+}
+
+// FormatDeliveryPausedWarning is appended to a user's scheduling confirmation
+// while delivery is paused.
+func FormatDeliveryPausedWarning(resumeAt *time.Time, loc *time.Location) string {
+	if resumeAt == nil {
+		return fmt.Sprintf("%s A System Admin has paused delivery of scheduled messages. Your message will wait until delivery resumes.", constants.EmojiWarning)
+	}
+	return fmt.Sprintf("%s A System Admin has paused delivery of scheduled messages until %s. Your message will wait until delivery resumes.", constants.EmojiWarning, resumeAt.In(loc).Format(constants.TimeLayout))
+}
+
+// FormatPauseSkippedNotice tells an owner that their message came due while
+// delivery was paused and was not sent.
+func FormatPauseSkippedNotice(postAt time.Time, tz, channelLink, content string) string {
+	return fmt.Sprintf("%s Your message scheduled for %s (%s) %s was not sent because delivery was paused by a System Admin. Original message:\n\n%s", constants.EmojiWarning, postAt.Format(constants.TimeLayout), tz, channelLink, content)
+}
+
+// FormatDeactivationSummary tells System Admins what happened to a
+// deactivated user's pending messages.
+func FormatDeactivationSummary(username string, count int, mode types.DeactivationMode) string {
+	if mode == types.DeactivationCancel {
+		return fmt.Sprintf("%s @%s was deactivated. Their %d pending scheduled messages were cancelled.", constants.EmojiWarning, username, count)
+	}
+	return fmt.Sprintf("%s @%s was deactivated. Their %d pending scheduled messages are held and will be restored if they are reactivated.", constants.EmojiWarning, username, count)
+}
+
+// FormatReactivationSummary tells System Admins that a reactivated user's
+// held messages were restored.
+func FormatReactivationSummary(username string, restored, returned int) string {
+	text := fmt.Sprintf("%s @%s was reactivated. %d held scheduled messages were restored.", constants.EmojiSuccess, username, restored)
+	if returned > 0 {
+		text += fmt.Sprintf(" %d could not be restored, usually because their time had passed, and were returned to them by DM.", returned)
+	}
+	return text
+}
+
+// FormatHeldMessageReturned tells a reactivated user that a held message was
+// not sent.
+func FormatHeldMessageReturned(postAt time.Time, tz, channelLink, content string) string {
+	return fmt.Sprintf("%s Your message scheduled for %s (%s) %s was not sent because your account was deactivated. Original message:\n\n%s", constants.EmojiWarning, postAt.Format(constants.TimeLayout), tz, channelLink, content)
+}
+
+// FormatLeftChannelNotice asks an owner what to do with a message scheduled
+// for a channel they have left.
+func FormatLeftChannelNotice(postAt time.Time, tz, channelLink, content string) string {
+	return fmt.Sprintf("%s You are no longer a member of the channel for your message scheduled for %s (%s) %s, so it cannot be posted there. Choose what to do with it:\n\n> %s",
+		constants.EmojiWarning, postAt.Format(constants.TimeLayout), tz, channelLink, Excerpt(content, constants.AdminListExcerptRunes))
+}
+
+// FormatLeftChannelResolved replaces the left channel notice once the owner
+// has chosen what to do.
+func FormatLeftChannelResolved(action string, postAt time.Time, tz, channelLink string) string {
+	if action == constants.LeftChannelActionCancel {
+		return fmt.Sprintf("%s Cancelled your message scheduled for %s (%s).", constants.EmojiSuccess, postAt.Format(constants.TimeLayout), tz)
+	}
+	return fmt.Sprintf("%s Your message scheduled for %s (%s) will now be posted %s.", constants.EmojiSuccess, postAt.Format(constants.TimeLayout), tz, channelLink)
+}
+
+// FormatMinutes renders a whole number of minutes as days, hours and minutes,
+// e.g. "1 hour 30 minutes".
+func FormatMinutes(minutes int) string {
+	units := []struct {
+		name string
+		size int
+	}{{"day", 24 * 60}, {"hour", 60}, {"minute", 1}}
+	var parts []string
+	for _, unit := range units {
+		n := minutes / unit.size
+		minutes %= unit.size
+		switch {
+		case n == 1:
+			parts = append(parts, "1 "+unit.name)
+		case n > 1:
+			parts = append(parts, fmt.Sprintf("%d %ss", n, unit.name))
+		}
+	}
+	if len(parts) == 0 {
+		return "0 minutes"
+	}
+	return strings.Join(parts, " ")
+}
+
+// FormatReminderNote is added to a scheduling confirmation when the owner
+// will be reminded before the message is posted.
+func FormatReminderNote(minutes int) string {
+	return fmt.Sprintf("You will be reminded %s before it is posted.", FormatMinutes(minutes))
+}
+
+// FormatBotDeliveryNote is added to a scheduling confirmation when the message
+// will be posted as the bot.
+func FormatBotDeliveryNote() string {
+	return "It will be posted by the bot on your behalf."
+}
+
+// FormatBotAttribution appends the line naming the owner to a message posted
+// as the bot.
+func FormatBotAttribution(message, username string) string {
+	attribution := fmt.Sprintf(constants.BotAttribution, username)
+	if strings.TrimSpace(message) == "" {
+		return attribution
+	}
+	return message + "\n\n" + attribution
+}
+
+// FormatReminderSetting describes the user's default reminder.
+func FormatReminderSetting(minutes int, changed bool) string {
+	prefix := "Your"
+	if changed {
+		prefix = constants.EmojiSuccess + " Saved. Your"
+	}
+	command := fmt.Sprintf("/%s %s %s", constants.CommandTrigger, constants.SubcommandSettings, constants.SettingsReminder)
+	if minutes <= 0 {
+		return fmt.Sprintf("%s new scheduled messages have no reminder. Use `%s <minutes>` to be reminded before each one is posted.", prefix, command)
+	}
+	return fmt.Sprintf("%s new scheduled messages come with a reminder %s before they are posted. Use `%s off` to stop, or add `remind <minutes>` to a single message to override it.", prefix, FormatMinutes(minutes), command)
+}
+
+// FormatReminderNotice is the reminder DM sent ahead of a message's post
+// time.
+func FormatReminderNotice(postAt time.Time, layout, tz, channelLink, content string) string {
+	return fmt.Sprintf("**Reminder:** your message scheduled for %s (%s) %s will be posted soon:\n\n> %s",
+		postAt.Format(layout), tz, channelLink, Excerpt(content, constants.AdminListExcerptRunes))
+}
+
+// FormatReminderResolved replaces a reminder once the owner has acted on it.
+func FormatReminderResolved(action string, postAt time.Time, tz, channelLink string) string {
+	switch action {
+	case constants.ReminderActionSend:
+		return fmt.Sprintf("%s Sent your message %s now instead of at %s (%s).", constants.EmojiSuccess, channelLink, postAt.Format(constants.TimeLayout), tz)
+	case constants.ReminderActionCancel:
+		return fmt.Sprintf("%s Cancelled your message scheduled for %s (%s) %s.", constants.EmojiSuccess, postAt.Format(constants.TimeLayout), tz, channelLink)
+	default:
+		return fmt.Sprintf("%s Postponed your message %s to %s (%s).", constants.EmojiSuccess, channelLink, postAt.Format(constants.TimeLayout), tz)
+	}
+}
+
+// FormatFailureReason explains a failure reason to the message's owner.
+func FormatFailureReason(reason types.FailureReason) string {
+	switch reason {
+	case types.FailureChannelArchived:
+		return "the channel has been archived"
+	case types.FailureNoPermission:
+		return "you are not allowed to post there any more"
+	case types.FailureFilesMissing:
+		return "one or more of its attached files no longer exist"
+	case types.FailureTooLong:
+		return "the message is too long"
+	default:
+		return "the server could not post it"
+	}
+}
+
+// FormatFailureNotice tells the owner a due message could not be posted and
+// why, with the underlying error as a detail. The buttons below it offer ways
+// to recover the message.
+func FormatFailureNotice(postAt time.Time, tz, channelLink string, reason types.FailureReason, detail, content string) string {
+	return fmt.Sprintf("%s Your message scheduled for %s (%s) %s could not be posted because %s:\n\n> %s\n\n_Details: %s_",
+		constants.EmojiError, postAt.Format(constants.TimeLayout), tz, channelLink, FormatFailureReason(reason), Excerpt(content, constants.AdminListExcerptRunes), detail)
+}
+
+// FormatFailureResolved replaces a failure DM once the owner has acted on it.
+// queued means the message went back in the queue instead of being posted.
+func FormatFailureResolved(queued bool, postAt time.Time, tz, channelLink string) string {
+	if queued {
+		return fmt.Sprintf("%s Rescheduled your message %s for %s (%s).", constants.EmojiSuccess, channelLink, postAt.Format(constants.TimeLayout), tz)
+	}
+	return fmt.Sprintf("%s Posted your message %s.", constants.EmojiSuccess, channelLink)
+}
+
+// FormatMovedToDraft confirms that a scheduled message was cancelled and
+// sent to the webapp as a draft. The text is included in case the draft
+// could not be saved.
+func FormatMovedToDraft(channelLink, content string) string {
+	return fmt.Sprintf("%s Moved your scheduled message %s to your drafts. If it is not there, here is the text:\n\n```\n%s\n```", constants.EmojiSuccess, channelLink, content)
+}
+
+// FormatDeliveryConfirmationLine describes one posted message for a delivery
+// confirmation.
+func FormatDeliveryConfirmationLine(channelLink, permalink string) string {
+	if permalink == "" {
+		return channelLink
+	}
+	return fmt.Sprintf("%s ([view message](%s))", channelLink, permalink)
+}
+
+// FormatDeliveryConfirmation confirms that one or more scheduled messages
+// were posted.
+func FormatDeliveryConfirmation(lines []string) string {
+	if len(lines) == 1 {
+		return fmt.Sprintf("%s Your scheduled message was posted %s.", constants.EmojiSuccess, lines[0])
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %d of your scheduled messages were posted:\n", constants.EmojiSuccess, len(lines))
+	for _, line := range lines {
+		fmt.Fprintf(&b, "\n- %s", line)
+	}
+	return b.String()
+}
+
+// FormatConfirmationSetting describes whether the user gets delivery
+// confirmations.
+func FormatConfirmationSetting(enabled, changed bool) string {
+	prefix := "You"
+	if changed {
+		prefix = constants.EmojiSuccess + " Saved. You"
+	}
+	command := fmt.Sprintf("/%s %s %s", constants.CommandTrigger, constants.SubcommandSettings, constants.SettingsConfirmations)
+	if enabled {
+		return fmt.Sprintf("%s will get a DM with a link to each scheduled message once it is posted. Use `%s off` to stop.", prefix, command)
+	}
+	return fmt.Sprintf("%s do not get a DM when your scheduled messages are posted. Use `%s on` to get one.", prefix, command)
+}
+
+// FormatDigestHeader introduces a digest of the messages due in the coming
+// day or week.
+func FormatDigestHeader(frequency types.DigestFrequency) string {
+	period := "day"
+	if frequency == types.DigestWeekly {
+		period = "week"
+	}
+	return fmt.Sprintf("### Your scheduled messages for the next %s", period)
+}
+
+// FormatDigestMore ends a digest that could not show every message.
+func FormatDigestMore() string {
+	return fmt.Sprintf("More messages are due. Use `/%s %s` to see them all.", constants.CommandTrigger, constants.SubcommandList)
+}
+
+// FormatDigestSetting describes how often the user gets a digest.
+func FormatDigestSetting(frequency types.DigestFrequency, changed bool) string {
+	prefix := "You"
+	if changed {
+		prefix = constants.EmojiSuccess + " Saved. You"
+	}
+	command := fmt.Sprintf("/%s %s %s", constants.CommandTrigger, constants.SubcommandSettings, constants.SettingsDigest)
+	switch frequency {
+	case types.DigestDaily:
+		return fmt.Sprintf("%s will get a list of the messages due in the next day every weekday at %d:00. Use `%s off` to stop.", prefix, constants.DigestHour, command)
+	case types.DigestWeekly:
+		return fmt.Sprintf("%s will get a list of the messages due in the next week every Monday at %d:00. Use `%s off` to stop.", prefix, constants.DigestHour, command)
+	}
+	return fmt.Sprintf("%s do not get a digest of your upcoming messages. Use `%s daily` or `%s weekly` to get one.", prefix, command, command)
+}
+
+// TimeLayout is the layout times are shown in for a user who prefers a 12 or
+// 24-hour clock.
+func TimeLayout(use24Hour bool) string {
+	if use24Hour {
+		return constants.TimeLayout24Hour
+	}
+	return constants.TimeLayout
+}
+
+// FormatPreferences lists a user's preferences, as shown when the settings
+// dialog cannot be opened or after it has been saved.
+func FormatPreferences(prefs *types.UserPreferences, changed bool) string {
+	title := "Your scheduled message settings:"
+	if changed {
+		title = constants.EmojiSuccess + " Saved your scheduled message settings:"
+	}
+	defaultTime := constants.DefaultScheduleTime + " (server default)"
+	if prefs.DefaultTime != "" {
+		defaultTime = prefs.DefaultTime
+	}
+	clock := "12-hour"
+	if prefs.Use24Hour {
+		clock = "24-hour"
+	}
+	timezone := "from your profile"
+	if prefs.Timezone != "" {
+		timezone = prefs.Timezone
+	}
+	pageSize := fmt.Sprintf("%d (server default)", constants.DefaultQueryLimit)
+	if prefs.ListPageSize > 0 {
+		pageSize = fmt.Sprintf("%d", prefs.ListPageSize)
+	}
+	reminder := "none"
+	if prefs.ReminderMinutes > 0 {
+		reminder = FormatMinutes(prefs.ReminderMinutes) + " before"
+	}
+	confirmations := "off"
+	if prefs.ConfirmDelivery {
+		confirmations = "on"
+	}
+	digest := constants.SettingsOff
+	if prefs.Digest != types.DigestOff {
+		digest = string(prefs.Digest)
+	}
+	return strings.Join([]string{
+		title,
+		"- **Default time:** " + defaultTime,
+		"- **Clock:** " + clock,
+		"- **Timezone:** " + timezone,
+		"- **List page size:** " + pageSize,
+		"- **Reminder:** " + reminder,
+		"- **Delivery confirmations:** " + confirmations,
+		"- **Digest:** " + digest,
+	}, "\n")
+}
+
+func describeResumeMode(mode types.ResumeMode) string {
+	if mode == types.ResumeSkip {
+		return "skipped and returned to their owners"
+	}
+	return "sent late"
+}
+
+// FormatSchedulerStatus renders a scheduler status report as markdown. Times
+// are shown in UTC since the report is not tied to any one user's timezone.
+func FormatSchedulerStatus(status *types.SchedulerStatus) string {
+	state := fmt.Sprintf("%s Running", constants.EmojiSuccess)
+	switch {
+	case !status.Running:
+		state = fmt.Sprintf("%s Not running", constants.EmojiError)
+	case status.Stalled:
+		state = fmt.Sprintf("%s Running, but no tick for over %s", constants.EmojiWarning, constants.SchedulerStallAfter)
+	}
+	if status.Pause != nil {
+		state += fmt.Sprintf(". %s Delivery paused by an admin since %s UTC", constants.EmojiWarning, status.Pause.PausedAt.UTC().Format(constants.TimeLayout))
+		if status.Pause.ResumeAt != nil {
+			state += fmt.Sprintf(", resuming %s UTC (overdue messages will be %s)", status.Pause.ResumeAt.UTC().Format(constants.TimeLayout), describeResumeMode(status.Pause.ResumeMode))
+		}
+	}
+	lastTick := "never"
+	if !status.LastTickAt.IsZero() {
+		lastTick = fmt.Sprintf("%s UTC (took %dms)", status.LastTickAt.UTC().Format(constants.TimeLayout), status.LastTickDurationMS)
+	}
+	lines := []string{
+		constants.AdminStatusHeader,
+		fmt.Sprintf("- **Scheduler:** %s", state),
+		fmt.Sprintf("- **Last tick:** %s", lastTick),
+		fmt.Sprintf("- **Pending:** %d (due: %d, overdue: %d)", status.Pending, status.Due, status.Overdue),
+	}
+	if oldest := status.OldestOverdue; oldest != nil {
+		lines = append(lines, fmt.Sprintf("- **Oldest overdue:** `%s` for %s UTC", oldest.ID, oldest.PostAt.UTC().Format(constants.TimeLayout)))
+	}
+	prefixes := make([]string, 0, len(status.StoreKeys))
+	for prefix := range status.StoreKeys {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	counts := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		counts = append(counts, fmt.Sprintf("%s: %d", prefix, status.StoreKeys[prefix]))
+	}
+	lines = append(lines, fmt.Sprintf("- **Store keys:** %s", strings.Join(counts, ", ")))
+	if status.StoreError != "" {
+		lines = append(lines, fmt.Sprintf("- **Store error:** %s", status.StoreError))
+	}
+	if len(status.RecentErrors) == 0 {
+		lines = append(lines, "- **Recent errors:** none")
+		return strings.Join(lines, "\n")
+	}
+	lines = append(lines, "- **Recent errors:**")
+	for _, e := range status.RecentErrors {
+		target := ""
+		if e.MessageID != "" {
+			target = fmt.Sprintf(" `%s`", e.MessageID)
+		}
+		lines = append(lines, fmt.Sprintf("  - %s UTC%s: %s", e.At.UTC().Format(constants.TimeLayout), target, e.Error))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Excerpt flattens text onto one line and shortens it to at most limit runes.
+func Excerpt(text string, limit int) string {
+	flat := strings.Join(strings.Fields(text), " ")
+	runes := []rune(flat)
+	if len(runes) <= limit {
+		return flat
+	}
+	return string(runes[:limit]) + "…"
+}
+
+// FormatChannelPolicy describes a channel's own rule and whether messages can
+// currently be scheduled there; checkErr is the result of the policy check.
+func FormatChannelPolicy(rule *types.ChannelPolicy, checkErr error) string {
+	enabled, limit := "on", "none"
+	if rule != nil && rule.Disabled {
+		enabled = "off"
+	}
+	if rule != nil && rule.MaxPending > 0 {
+		limit = fmt.Sprintf("%d pending messages", rule.MaxPending)
+	}
+	status := fmt.Sprintf("%s Messages can be scheduled here.", constants.EmojiSuccess)
+	if checkErr != nil {
+		status = fmt.Sprintf("%s Messages cannot be scheduled here: %v", constants.EmojiError, checkErr)
+	}
+	usage := fmt.Sprintf("/%s %s", constants.CommandTrigger, constants.SubcommandPolicy)
+	return fmt.Sprintf("%s\n- Scheduled messages: **%s**\n- Channel limit: **%s**\n\n%s\n\nChannel admins can change these with `%s %s`, `%s %s` or `%s %s <n>`.",
+		constants.PolicyHeader, enabled, limit, status, usage, constants.PolicyEnable, usage, constants.PolicyDisable, usage, constants.PolicyCap)
+}
+
+// FormatQuietHours describes a quiet hours rule, e.g. "22:00-07:00, weekends
+// (Europe/Berlin)".
+func FormatQuietHours(rule *types.QuietHours) string {
+	var parts []string
+	if rule.Start != "" && rule.Start != rule.End {
+		parts = append(parts, rule.Start+"-"+rule.End)
+	}
+	if rule.Weekends {
+		parts = append(parts, "weekends")
+	}
+	if len(rule.Holidays) > 0 {
+		parts = append(parts, "holidays")
+	}
+	text := strings.Join(parts, ", ")
+	if rule.Timezone != "" {
+		text = fmt.Sprintf("%s (%s)", text, rule.Timezone)
+	}
+	return text
+}
+
+func FormatQuietHoursShifted(requested time.Time) string {
+	return fmt.Sprintf("It was moved from %s because of quiet hours in this channel.", requested.Format(constants.TimeLayout))
+}
+
+// FormatHistoryEntry describes one delivery for /schedule history. A sent
+// message links to its post when permalink is set; a failed one gives the reason.
+func FormatHistoryEntry(entry *types.HistoryEntry, sentAt time.Time, tz, channelLink, permalink string) string {
+	if entry.Status == types.HistoryFailed {
+		return fmt.Sprintf("- %s **%s** (%s) %s: failed, %s", constants.EmojiError, sentAt.Format(constants.TimeLayout), tz, channelLink, entry.Error)
+	}
+	line := fmt.Sprintf("- %s **%s** (%s) %s", constants.EmojiSuccess, sentAt.Format(constants.TimeLayout), tz, channelLink)
+	if permalink != "" {
+		line += fmt.Sprintf(" ([view message](%s))", permalink)
+	}
+	return line
+}
+
+func FormatHistoryTruncated(shown, total int) string {
+	return fmt.Sprintf("_Showing the %d most recent of %d deliveries._", shown, total)
 }
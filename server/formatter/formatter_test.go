@@ -3,10 +3,12 @@ package formatter
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 func TestFormatScheduleSuccess(t *testing.T) {
@@ -16,7 +18,7 @@ func TestFormatScheduleSuccess(t *testing.T) {
 
 	expected := fmt.Sprintf("%s Scheduled message for %s (%s) %s", constants.EmojiSuccess, ts.Format(constants.TimeLayout), tz, channel)
 
-	got := FormatScheduleSuccess(ts, tz, channel)
+	got := FormatScheduleSuccess(ts, constants.TimeLayout, tz, channel)
 	if got != expected {
 		t.Fatalf("FormatScheduleSuccess() = %q, want %q", got, expected)
 	}
@@ -76,8 +78,196 @@ func TestFormatListAttachmentHeader(t *testing.T) {
 
 	expected := fmt.Sprintf("##### %s\n%s\n\n%s", ts.Format(constants.TimeLayout), channel, msg)
 
-	got := FormatListAttachmentHeader(ts, channel, msg)
+	got := FormatListAttachmentHeader(ts, constants.TimeLayout, channel, msg)
 	if got != expected {
 		t.Fatalf("FormatListAttachmentHeader() = %q, want %q", got, expected)
 	}
 }
+
+func TestFormatListAttachmentHeader_24Hour(t *testing.T) {
+	ts := time.Date(2025, time.January, 2, 15, 4, 0, 0, time.UTC)
+	got := FormatListAttachmentHeader(ts, TimeLayout(true), "in channel: ~town-square", "hello world")
+	if !strings.HasPrefix(got, "##### Jan 2, 2025 15:04\n") {
+		t.Fatalf("FormatListAttachmentHeader() = %q, want a 24-hour time", got)
+	}
+}
+
+func TestFormatPreferences(t *testing.T) {
+	got := FormatPreferences(&types.UserPreferences{DefaultTime: "08:30", Use24Hour: true, Timezone: "Europe/Berlin", ListPageSize: 5}, true)
+	for _, want := range []string{"Saved", "08:30", "24-hour", "Europe/Berlin", "**List page size:** 5", "**Digest:** off"} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("FormatPreferences() = %q, want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestFormatAdminListEntry(t *testing.T) {
+	ts := time.Date(2025, time.January, 2, 15, 4, 0, 0, time.UTC)
+	got := FormatAdminListEntry("id1", ts, "UTC", "alice", "in channel: ~town-square", "hello\nworld")
+	expected := fmt.Sprintf("- `id1` **%s** (UTC) by @alice in channel: ~town-square\n  > hello world", ts.Format(constants.TimeLayout))
+	if got != expected {
+		t.Fatalf("FormatAdminListEntry() = %q, want %q", got, expected)
+	}
+}
+
+func TestFormatSchedulerStatus(t *testing.T) {
+	tick := time.Date(2025, time.January, 2, 15, 4, 0, 0, time.UTC)
+	status := &types.SchedulerStatus{
+		SchedulerHealth: types.SchedulerHealth{
+			Running:            true,
+			LastTickAt:         tick,
+			LastTickDurationMS: 12,
+			RecentErrors:       []types.SchedulerError{{At: tick, MessageID: "m1", Error: "post failed"}},
+		},
+		Stalled:   true,
+		Pending:   2,
+		StoreKeys: map[string]int{"user_index": 1, "schedmsg": 2},
+	}
+
+	got := FormatSchedulerStatus(status)
+
+	for _, want := range []string{
+		constants.AdminStatusHeader,
+		"no tick for over",
+		fmt.Sprintf("**Last tick:** %s UTC (took 12ms)", tick.Format(constants.TimeLayout)),
+		"**Store keys:** schedmsg: 2, user_index: 1",
+		fmt.Sprintf("  - %s UTC `m1`: post failed", tick.Format(constants.TimeLayout)),
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("FormatSchedulerStatus() = %q, missing %q", got, want)
+		}
+	}
+	if strings.Contains(got, "Oldest overdue") {
+		t.Fatalf("FormatSchedulerStatus() = %q, unexpected oldest overdue line", got)
+	}
+}
+
+func TestFormatDeliveryPaused(t *testing.T) {
+	resumeAt := time.Date(2025, time.January, 2, 15, 4, 0, 0, time.UTC)
+
+	got := FormatDeliveryPaused(&types.PauseState{ResumeAt: &resumeAt, ResumeMode: types.ResumeSkip})
+	expected := fmt.Sprintf("%s Delivery of all scheduled messages is paused until %s UTC. Messages that come due before then will be skipped and returned to their owners.", constants.EmojiWarning, resumeAt.Format(constants.TimeLayout))
+	if got != expected {
+		t.Fatalf("FormatDeliveryPaused() = %q, want %q", got, expected)
+	}
+
+	got = FormatDeliveryPaused(&types.PauseState{})
+	if !strings.Contains(got, "/schedule admin resume") {
+		t.Fatalf("FormatDeliveryPaused() = %q, want resume instructions", got)
+	}
+}
+
+func TestFormatDeliveryPausedWarning(t *testing.T) {
+	loc := time.FixedZone("EST", -5*60*60)
+	resumeAt := time.Date(2025, time.January, 2, 15, 0, 0, 0, time.UTC)
+
+	got := FormatDeliveryPausedWarning(&resumeAt, loc)
+	if !strings.Contains(got, resumeAt.In(loc).Format(constants.TimeLayout)) {
+		t.Fatalf("FormatDeliveryPausedWarning() = %q, want local resume time", got)
+	}
+	if strings.Contains(FormatDeliveryPausedWarning(nil, loc), "messages until") {
+		t.Fatalf("FormatDeliveryPausedWarning(nil) should not mention a resume time")
+	}
+}
+
+func TestFormatLeftChannelResolved(t *testing.T) {
+	postAt := time.Date(2025, time.January, 2, 9, 0, 0, 0, time.UTC)
+
+	moved := FormatLeftChannelResolved(constants.LeftChannelActionMove, postAt, "UTC", "in channel: ~random")
+	if !strings.Contains(moved, "in channel: ~random") {
+		t.Fatalf("FormatLeftChannelResolved(move) = %q, want new channel link", moved)
+	}
+	cancelled := FormatLeftChannelResolved(constants.LeftChannelActionCancel, postAt, "UTC", "in channel: ~random")
+	if !strings.Contains(cancelled, "Cancelled") || strings.Contains(cancelled, "~random") {
+		t.Fatalf("FormatLeftChannelResolved(cancel) = %q", cancelled)
+	}
+}
+
+func TestFormatMinutes(t *testing.T) {
+	for minutes, want := range map[int]string{
+		0:    "0 minutes",
+		1:    "1 minute",
+		45:   "45 minutes",
+		60:   "1 hour",
+		90:   "1 hour 30 minutes",
+		1500: "1 day 1 hour",
+	} {
+		if got := FormatMinutes(minutes); got != want {
+			t.Errorf("FormatMinutes(%d) = %q, want %q", minutes, got, want)
+		}
+	}
+}
+
+func TestFormatDeliveryConfirmation(t *testing.T) {
+	single := FormatDeliveryConfirmation([]string{FormatDeliveryConfirmationLine("in channel: ~town-square", "https://x/_redirect/pl/p1")})
+	if !strings.Contains(single, "Your scheduled message was posted in channel: ~town-square ([view message](https://x/_redirect/pl/p1)).") {
+		t.Errorf("unexpected single confirmation: %q", single)
+	}
+
+	batch := FormatDeliveryConfirmation([]string{
+		FormatDeliveryConfirmationLine("in channel: ~a", ""),
+		FormatDeliveryConfirmationLine("in channel: ~b", "https://x/_redirect/pl/p2"),
+	})
+	for _, want := range []string{"2 of your scheduled messages were posted:", "\n- in channel: ~a\n", "- in channel: ~b ([view message](https://x/_redirect/pl/p2))"} {
+		if !strings.Contains(batch, want) {
+			t.Errorf("batch confirmation %q missing %q", batch, want)
+		}
+	}
+}
+
+func TestFormatDigestSetting(t *testing.T) {
+	tests := []struct {
+		frequency types.DigestFrequency
+		changed   bool
+		want      string
+	}{
+		{types.DigestDaily, false, "You will get a list of the messages due in the next day every weekday at 8:00."},
+		{types.DigestWeekly, true, "Saved. You will get a list of the messages due in the next week every Monday at 8:00."},
+		{types.DigestOff, false, "You do not get a digest of your upcoming messages."},
+	}
+	for _, tt := range tests {
+		if got := FormatDigestSetting(tt.frequency, tt.changed); !strings.Contains(got, tt.want) {
+			t.Errorf("FormatDigestSetting(%q, %v) = %q, want it to contain %q", tt.frequency, tt.changed, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFailureNotice(t *testing.T) {
+	ts := time.Date(2025, time.January, 2, 15, 4, 0, 0, time.UTC)
+	reasons := map[types.FailureReason]string{
+		types.FailureChannelArchived: "the channel has been archived",
+		types.FailureNoPermission:    "you are not allowed to post there any more",
+		types.FailureFilesMissing:    "one or more of its attached files no longer exist",
+		types.FailureTooLong:         "the message is too long",
+		types.FailureServerError:     "the server could not post it",
+	}
+	for reason, want := range reasons {
+		got := FormatFailureNotice(ts, "UTC", "in channel: ~town-square", reason, "boom", "hello")
+		if !strings.Contains(got, "in channel: ~town-square could not be posted because "+want+":\n\n> hello") {
+			t.Errorf("FormatFailureNotice(%q) = %q, want reason %q", reason, got, want)
+		}
+		if !strings.HasSuffix(got, "_Details: boom_") {
+			t.Errorf("FormatFailureNotice(%q) = %q, want details", reason, got)
+		}
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		limit int
+		want  string
+	}{
+		{"short text unchanged", "hello", 10, "hello"},
+		{"whitespace flattened", "a\n\n  b\tc", 10, "a b c"},
+		{"long text truncated by runes", "안녕하세요 세계", 3, "안녕하…"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Excerpt(tc.text, tc.limit); got != tc.want {
+				t.Fatalf("Excerpt() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
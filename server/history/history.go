@@ -0,0 +1,122 @@
+package history
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service keeps each user's history of delivered and failed messages. It
+// listens for lifecycle events, so every delivery path is recorded without the
+// scheduler knowing about it.
+type Service struct {
+	logger    ports.Logger
+	store     ports.HistoryStore
+	config    ports.ConfigService
+	clock     ports.Clock
+	mu        sync.RWMutex
+	retention time.Duration
+}
+
+func New(logger ports.Logger, store ports.HistoryStore, config ports.ConfigService, clk ports.Clock, retentionDays int) *Service {
+	logger.Debug("Creating new history Service", "retention_days", retentionDays)
+	return &Service{
+		logger:    logger,
+		store:     store,
+		config:    config,
+		clock:     clk,
+		retention: retentionFor(retentionDays),
+	}
+}
+
+// Configure changes how long entries are kept. Zero keeps them until the
+// per-user cap pushes them out.
+func (s *Service) Configure(retentionDays int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.retention = retentionFor(retentionDays)
+	s.logger.Debug("History service configured", "retention_days", retentionDays)
+}
+
+func (s *Service) Notify(event *types.LifecycleEvent) {
+	var status types.HistoryStatus
+	switch event.Type {
+	case types.EventSent:
+		status = types.HistorySent
+	case types.EventFailed:
+		status = types.HistoryFailed
+	default:
+		return
+	}
+	sentAt := event.Timestamp
+	if sentAt.IsZero() {
+		sentAt = s.clock.Now().UTC()
+	}
+	entry := &types.HistoryEntry{
+		MessageID: event.MessageID,
+		ChannelID: event.ChannelID,
+		PostID:    event.PostID,
+		PostAt:    event.PostAt,
+		SentAt:    sentAt,
+		Timezone:  event.Timezone,
+		Status:    status,
+		Error:     event.Error,
+	}
+	if err := s.store.SaveHistoryEntry(event.UserID, entry, s.cutoff()); err != nil {
+		s.logger.Error("Failed to record sent message history", "user_id", event.UserID, "message_id", event.MessageID, "error", err)
+	}
+}
+
+// List returns the user's history newest first, leaving out expired entries.
+func (s *Service) List(userID string) ([]*types.HistoryEntry, error) {
+	s.logger.Debug("Listing sent message history", "user_id", userID)
+	entries, err := s.store.ListHistory(userID)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := s.cutoff()
+	if cutoff.IsZero() {
+		return entries, nil
+	}
+	var current []*types.HistoryEntry
+	for _, e := range entries {
+		if !e.SentAt.Before(cutoff) {
+			current = append(current, e)
+		}
+	}
+	return current, nil
+}
+
+// Permalink returns a link to the post that works from any team, or an empty
+// string when the Site URL is not configured.
+func (s *Service) Permalink(postID string) string {
+	if postID == "" {
+		return ""
+	}
+	cfg := s.config.GetConfig()
+	if cfg == nil || cfg.ServiceSettings.SiteURL == nil || *cfg.ServiceSettings.SiteURL == "" {
+		return ""
+	}
+	return fmt.Sprintf(constants.HistoryPermalink, strings.TrimRight(*cfg.ServiceSettings.SiteURL, "/"), postID)
+}
+
+func (s *Service) cutoff() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.retention == 0 {
+		return time.Time{}
+	}
+	return s.clock.Now().UTC().Add(-s.retention)
+}
+
+func retentionFor(days int) time.Duration {
+	if days <= 0 {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
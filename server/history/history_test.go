@@ -0,0 +1,107 @@
+package history
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func setupHistory(t *testing.T, retentionDays int) (*Service, *mock.MockHistoryStore, *mock.MockConfigService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockHistoryStore(ctrl)
+	config := mock.NewMockConfigService(ctrl)
+	return New(testutil.FakeLogger{}, store, config, testutil.FakeClock{NowTime: testNow}, retentionDays), store, config
+}
+
+func TestNotify_RecordsSentMessage(t *testing.T) {
+	svc, store, _ := setupHistory(t, 30)
+	event := &types.LifecycleEvent{
+		Type:      types.EventSent,
+		Timestamp: testNow,
+		MessageID: "m1",
+		UserID:    "u1",
+		ChannelID: "c1",
+		PostAt:    testNow.Add(-time.Second),
+		Timezone:  "Asia/Seoul",
+		PostID:    "p1",
+	}
+	store.EXPECT().SaveHistoryEntry("u1", &types.HistoryEntry{
+		MessageID: "m1",
+		ChannelID: "c1",
+		PostID:    "p1",
+		PostAt:    testNow.Add(-time.Second),
+		SentAt:    testNow,
+		Timezone:  "Asia/Seoul",
+		Status:    types.HistorySent,
+	}, testNow.Add(-30*24*time.Hour)).Return(nil)
+
+	svc.Notify(event)
+}
+
+func TestNotify_RecordsFailureWithoutRetention(t *testing.T) {
+	svc, store, _ := setupHistory(t, 0)
+	store.EXPECT().SaveHistoryEntry("u1", gomock.Any(), time.Time{}).DoAndReturn(func(_ string, e *types.HistoryEntry, _ time.Time) error {
+		assert.Equal(t, types.HistoryFailed, e.Status)
+		assert.Equal(t, "channel archived", e.Error)
+		assert.Equal(t, testNow, e.SentAt)
+		return errors.New("boom")
+	})
+
+	svc.Notify(&types.LifecycleEvent{Type: types.EventFailed, UserID: "u1", MessageID: "m1", Error: "channel archived"})
+}
+
+func TestNotify_IgnoresOtherEvents(t *testing.T) {
+	svc, _, _ := setupHistory(t, 30)
+	svc.Notify(&types.LifecycleEvent{Type: types.EventScheduled, UserID: "u1"})
+	svc.Notify(&types.LifecycleEvent{Type: types.EventCancelled, UserID: "u1"})
+}
+
+func TestList_DropsExpiredEntries(t *testing.T) {
+	svc, store, _ := setupHistory(t, 1)
+	store.EXPECT().ListHistory("u1").Return([]*types.HistoryEntry{
+		{MessageID: "new", SentAt: testNow.Add(-time.Hour)},
+		{MessageID: "old", SentAt: testNow.Add(-48 * time.Hour)},
+	}, nil)
+
+	got, err := svc.List("u1")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "new", got[0].MessageID)
+}
+
+func TestList_Error(t *testing.T) {
+	svc, store, _ := setupHistory(t, 1)
+	store.EXPECT().ListHistory("u1").Return(nil, errors.New("boom"))
+
+	_, err := svc.List("u1")
+	require.Error(t, err)
+}
+
+func TestPermalink(t *testing.T) {
+	svc, _, config := setupHistory(t, 0)
+	cfg := &model.Config{}
+	cfg.ServiceSettings.SiteURL = model.NewPointer("https://chat.example.com/")
+	config.EXPECT().GetConfig().Return(cfg)
+
+	assert.Equal(t, "https://chat.example.com/_redirect/pl/p1", svc.Permalink("p1"))
+}
+
+func TestPermalink_NoSiteURL(t *testing.T) {
+	svc, _, config := setupHistory(t, 0)
+	config.EXPECT().GetConfig().Return(&model.Config{})
+
+	assert.Empty(t, svc.Permalink("p1"))
+	assert.Empty(t, svc.Permalink(""))
+}
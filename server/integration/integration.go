@@ -0,0 +1,203 @@
+package integration
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var (
+	// ErrInvalidToken is returned by Authenticate when the secret matches no token.
+	ErrInvalidToken = errors.New(constants.ErrIntegrationInvalidToken)
+	// ErrChannelNotAllowed is returned when a token is used outside its channel scope.
+	ErrChannelNotAllowed = errors.New(constants.ErrIntegrationChannelDenied)
+)
+
+// Service issues integration tokens and answers the questions the REST API
+// needs when a request is authorized by one. Messages scheduled with a token
+// are owned by, and posted as, the token's bot account.
+type Service struct {
+	logger ports.Logger
+	tokens ports.IntegrationTokenStore
+	store  ports.Store
+	user   ports.UserService
+	botID  string
+	clock  ports.Clock
+}
+
+func New(
+	logger ports.Logger,
+	tokens ports.IntegrationTokenStore,
+	store ports.Store,
+	user ports.UserService,
+	botID string,
+	clk ports.Clock,
+) *Service {
+	logger.Debug("Creating new integration Service")
+	return &Service{
+		logger: logger,
+		tokens: tokens,
+		store:  store,
+		user:   user,
+		botID:  botID,
+		clock:  clk,
+	}
+}
+
+// Issue creates a token scoped to channelIDs and returns it with its secret.
+// The secret is only available here; the store keeps its hash. An empty
+// botUserID posts as the plugin bot.
+func (s *Service) Issue(name string, channelIDs []string, botUserID string, createdBy string) (*types.IntegrationToken, string, error) {
+	s.logger.Debug("Issuing integration token", "name", name, "channel_count", len(channelIDs), "bot_user_id", botUserID, "created_by", createdBy)
+	channelIDs = normalizeChannelIDs(channelIDs)
+	if len(channelIDs) == 0 {
+		return nil, "", errors.New(constants.ErrIntegrationNoChannels)
+	}
+	if botUserID == "" {
+		botUserID = s.botID
+	} else if err := s.checkBot(botUserID); err != nil {
+		return nil, "", err
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		s.logger.Error("Failed to generate integration token secret", "error", err)
+		return nil, "", fmt.Errorf("failed to generate integration token: %w", err)
+	}
+	token := &types.IntegrationToken{
+		ID:         uuid.NewString(),
+		Name:       strings.TrimSpace(name),
+		TokenHash:  hashSecret(secret),
+		ChannelIDs: channelIDs,
+		BotUserID:  botUserID,
+		CreatedBy:  createdBy,
+		CreatedAt:  s.clock.Now().UTC(),
+	}
+	if err := s.tokens.SaveToken(token); err != nil {
+		return nil, "", fmt.Errorf("failed to save integration token: %w", err)
+	}
+	s.logger.Info("Issued integration token", "token_id", token.ID, "name", token.Name, "created_by", createdBy)
+	return token, secret, nil
+}
+
+func (s *Service) List() ([]*types.IntegrationToken, error) {
+	s.logger.Debug("Listing integration tokens")
+	tokens, err := s.tokens.ListTokens()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list integration tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+func (s *Service) Revoke(id string) (bool, error) {
+	s.logger.Debug("Revoking integration token", "token_id", id)
+	deleted, err := s.tokens.DeleteToken(id)
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke integration token: %w", err)
+	}
+	return deleted, nil
+}
+
+// Authenticate returns the token matching secret, or ErrInvalidToken.
+func (s *Service) Authenticate(secret string) (*types.IntegrationToken, error) {
+	s.logger.Debug("Authenticating integration token")
+	if secret == "" {
+		return nil, ErrInvalidToken
+	}
+	token, err := s.tokens.GetTokenByHash(hashSecret(secret))
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up integration token: %w", err)
+	}
+	if token == nil {
+		return nil, ErrInvalidToken
+	}
+	s.logger.Debug("Integration token authenticated", "token_id", token.ID)
+	return token, nil
+}
+
+// ListMessages returns one page of the messages scheduled with token. The
+// query is confined to the token's channels; naming any other channel yields
+// ErrChannelNotAllowed.
+func (s *Service) ListMessages(token *types.IntegrationToken, query *types.MessageQuery) (*types.MessagePage, error) {
+	s.logger.Debug("Listing messages for integration token", "token_id", token.ID, "channel_count", len(query.ChannelIDs))
+	for _, channelID := range query.ChannelIDs {
+		if !token.AllowsChannel(channelID) {
+			return nil, ErrChannelNotAllowed
+		}
+	}
+	scoped := *query
+	scoped.UserID = token.BotUserID
+	scoped.IntegrationTokenID = token.ID
+	if len(scoped.ChannelIDs) == 0 {
+		scoped.ChannelIDs = token.ChannelIDs
+	}
+	page, err := s.store.QueryMessages(&scoped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	s.logger.Debug("Listed messages for integration token", "token_id", token.ID, "count", len(page.Messages))
+	return page, nil
+}
+
+// GetMessage returns a message the token may act on. Messages scheduled by
+// another token, even one sharing the same bot, or outside the token's
+// channels yield ErrChannelNotAllowed.
+func (s *Service) GetMessage(token *types.IntegrationToken, msgID string) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Getting message for integration token", "token_id", token.ID, "message_id", msgID)
+	msg, err := s.store.GetScheduledMessage(msgID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.UserID != token.BotUserID || msg.IntegrationTokenID != token.ID || !token.AllowsChannel(msg.ChannelID) {
+		s.logger.Warn("Integration token attempted to access message outside its scope", "token_id", token.ID, "message_id", msgID)
+		return nil, ErrChannelNotAllowed
+	}
+	return msg, nil
+}
+
+func (s *Service) checkBot(userID string) error {
+	user, err := s.user.Get(userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user %s: %w", userID, err)
+	}
+	if !user.IsBot {
+		return fmt.Errorf(constants.ErrIntegrationNotBot, userID)
+	}
+	return nil
+}
+
+func normalizeChannelIDs(channelIDs []string) []string {
+	seen := make(map[string]bool, len(channelIDs))
+	out := make([]string, 0, len(channelIDs))
+	for _, id := range channelIDs {
+		id = strings.TrimSpace(id)
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, constants.IntegrationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
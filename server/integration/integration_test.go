@@ -0,0 +1,134 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type integrationMocks struct {
+	tokens *mock.MockIntegrationTokenStore
+	store  *mock.MockStore
+	user   *mock.MockUserService
+}
+
+func setupService(t *testing.T) (*Service, *integrationMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &integrationMocks{
+		tokens: mock.NewMockIntegrationTokenStore(ctrl),
+		store:  mock.NewMockStore(ctrl),
+		user:   mock.NewMockUserService(ctrl),
+	}
+	return New(testutil.FakeLogger{}, m.tokens, m.store, m.user, "plugin-bot", testutil.FakeClock{NowTime: testNow}), m
+}
+
+func TestIssue_DefaultsToPluginBot(t *testing.T) {
+	svc, m := setupService(t)
+	var saved *types.IntegrationToken
+	m.tokens.EXPECT().SaveToken(gomock.Any()).DoAndReturn(func(tok *types.IntegrationToken) error {
+		saved = tok
+		return nil
+	})
+
+	token, secret, err := svc.Issue(" CI ", []string{"c1", " c1", "", "c2"}, "", "admin")
+	require.NoError(t, err)
+	assert.Len(t, secret, 64)
+	assert.Same(t, saved, token)
+	assert.Equal(t, "CI", token.Name)
+	assert.Equal(t, "plugin-bot", token.BotUserID)
+	assert.Equal(t, []string{"c1", "c2"}, token.ChannelIDs)
+	assert.Equal(t, hashSecret(secret), token.TokenHash)
+	assert.NotContains(t, token.TokenHash, secret)
+	assert.True(t, token.CreatedAt.Equal(testNow))
+}
+
+func TestIssue_RequiresChannels(t *testing.T) {
+	svc, _ := setupService(t)
+	_, _, err := svc.Issue("CI", []string{" "}, "", "admin")
+	require.Error(t, err)
+}
+
+func TestIssue_DesignatedBotMustBeBot(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().Get("human").Return(&model.User{Id: "human"}, nil)
+
+	_, _, err := svc.Issue("CI", []string{"c1"}, "human", "admin")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a bot")
+}
+
+func TestAuthenticate(t *testing.T) {
+	svc, m := setupService(t)
+	token := &types.IntegrationToken{ID: "t1"}
+	m.tokens.EXPECT().GetTokenByHash(hashSecret("good")).Return(token, nil)
+	m.tokens.EXPECT().GetTokenByHash(hashSecret("bad")).Return(nil, nil)
+
+	got, err := svc.Authenticate("good")
+	require.NoError(t, err)
+	assert.Same(t, token, got)
+
+	_, err = svc.Authenticate("bad")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = svc.Authenticate("")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestListMessages_DefaultsToTokenChannels(t *testing.T) {
+	svc, m := setupService(t)
+	token := &types.IntegrationToken{ID: "t1", BotUserID: "bot", ChannelIDs: []string{"c1", "c2"}}
+	page := &types.MessagePage{Messages: []*types.ScheduledMessage{{ID: "a"}}}
+	m.store.EXPECT().QueryMessages(&types.MessageQuery{UserID: "bot", ChannelIDs: []string{"c1", "c2"}, Text: "deploy", IntegrationTokenID: "t1"}).Return(page, nil)
+
+	got, err := svc.ListMessages(token, &types.MessageQuery{UserID: "someone-else", Text: "deploy"})
+	require.NoError(t, err)
+	assert.Same(t, page, got)
+}
+
+func TestListMessages_ChannelOutsideScope(t *testing.T) {
+	svc, _ := setupService(t)
+	token := &types.IntegrationToken{ID: "t1", BotUserID: "bot", ChannelIDs: []string{"c1"}}
+
+	_, err := svc.ListMessages(token, &types.MessageQuery{ChannelIDs: []string{"c1", "c9"}})
+	assert.ErrorIs(t, err, ErrChannelNotAllowed)
+}
+
+func TestGetMessage_Scope(t *testing.T) {
+	svc, m := setupService(t)
+	token := &types.IntegrationToken{ID: "t1", BotUserID: "bot", ChannelIDs: []string{"c1"}}
+	m.store.EXPECT().GetScheduledMessage("mine").Return(&types.ScheduledMessage{ID: "mine", UserID: "bot", ChannelID: "c1", IntegrationTokenID: "t1"}, nil)
+	m.store.EXPECT().GetScheduledMessage("users").Return(&types.ScheduledMessage{ID: "users", UserID: "someone", ChannelID: "c1"}, nil)
+
+	msg, err := svc.GetMessage(token, "mine")
+	require.NoError(t, err)
+	assert.Equal(t, "mine", msg.ID)
+
+	_, err = svc.GetMessage(token, "users")
+	assert.ErrorIs(t, err, ErrChannelNotAllowed)
+}
+
+func TestGetMessage_OtherTokenSharingBot(t *testing.T) {
+	svc, m := setupService(t)
+	first := &types.IntegrationToken{ID: "t1", BotUserID: "bot", ChannelIDs: []string{"c1"}}
+	second := &types.IntegrationToken{ID: "t2", BotUserID: "bot", ChannelIDs: []string{"c1"}}
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "bot", ChannelID: "c1", IntegrationTokenID: "t1"}
+	m.store.EXPECT().GetScheduledMessage("m1").Return(msg, nil).Times(2)
+
+	got, err := svc.GetMessage(first, "m1")
+	require.NoError(t, err)
+	assert.Same(t, msg, got)
+
+	_, err = svc.GetMessage(second, "m1")
+	assert.ErrorIs(t, err, ErrChannelNotAllowed)
+}
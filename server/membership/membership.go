@@ -0,0 +1,194 @@
+package membership
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// ErrCannotPost is returned when the owner may not post in the channel they
+// picked for a message.
+var ErrCannotPost = errors.New("you cannot post in that channel")
+
+// Service tells owners straight away when they leave a channel they have
+// pending messages in, rather than letting the messages fail at send time,
+// and carries out the choice they make from the DM.
+type Service struct {
+	logger   ports.Logger
+	store    ports.Store
+	user     ports.UserService
+	direct   ports.DirectChannelService
+	channel  ports.ChannelService
+	poster   ports.PostService
+	policy   ports.PolicyService
+	schedule ports.ScheduleService
+	events   ports.EventNotifier
+	botID    string
+}
+
+func New(
+	logger ports.Logger,
+	store ports.Store,
+	user ports.UserService,
+	direct ports.DirectChannelService,
+	channel ports.ChannelService,
+	poster ports.PostService,
+	policy ports.PolicyService,
+	schedule ports.ScheduleService,
+	events ports.EventNotifier,
+	botID string,
+) *Service {
+	logger.Debug("Creating new membership Service")
+	return &Service{
+		logger:   logger,
+		store:    store,
+		user:     user,
+		direct:   direct,
+		channel:  channel,
+		poster:   poster,
+		policy:   policy,
+		schedule: schedule,
+		events:   events,
+		botID:    botID,
+	}
+}
+
+// UserLeftChannel DMs the user once for each of their pending messages in
+// channelID, with buttons to move, redirect or cancel it.
+func (s *Service) UserLeftChannel(userID, channelID string) {
+	msgs, err := s.store.ListUserMessages(userID)
+	if err != nil {
+		s.logger.Error("Failed to list scheduled messages of user who left a channel", "user_id", userID, "channel_id", channelID, "error", err)
+		return
+	}
+	var channelLink string
+	for _, msg := range msgs {
+		if msg.ChannelID != channelID {
+			continue
+		}
+		if channelLink == "" {
+			channelLink = s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(channelID))
+		}
+		s.logger.Debug("Asking owner what to do with message in channel they left", "user_id", userID, "channel_id", channelID, "message_id", msg.ID)
+		if err := s.poster.DM(s.botID, userID, s.buildLeftChannelPost(msg, channelLink)); err != nil {
+			s.logger.Error("Failed to DM user about message in channel they left", "user_id", userID, "message_id", msg.ID, "error", err)
+		}
+	}
+}
+
+// MoveMessage points the user's message at channelID, which they must be able
+// to post in and which the scheduling policy must allow. The channel's quiet
+// hours apply as they would to a new message there.
+func (s *Service) MoveMessage(userID, msgID, channelID string) (*types.ScheduledMessage, error) {
+	if !s.user.HasPermissionToChannel(userID, channelID, model.PermissionCreatePost) {
+		return nil, ErrCannotPost
+	}
+	if err := s.policy.CheckSchedule(channelID); err != nil {
+		return nil, err
+	}
+	return s.retarget(userID, msgID, channelID)
+}
+
+// MoveMessageToSelf points the user's message at their own DM channel.
+func (s *Service) MoveMessageToSelf(userID, msgID string) (*types.ScheduledMessage, error) {
+	dm, err := s.direct.GetDirect(userID, userID)
+	if err != nil {
+		s.logger.Error("Failed to get user's own DM channel", "user_id", userID, "error", err)
+		return nil, fmt.Errorf("failed to find your direct message channel: %w", err)
+	}
+	return s.retarget(userID, msgID, dm.Id)
+}
+
+func (s *Service) CancelMessage(userID, msgID string) (*types.ScheduledMessage, error) {
+	msg, err := s.ownedMessage(userID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteScheduledMessage(userID, msgID); err != nil {
+		return nil, fmt.Errorf("failed to cancel message: %w", err)
+	}
+	s.logger.Info("Cancelled message in channel its owner left", "user_id", userID, "message_id", msgID)
+	s.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, userID))
+	return msg, nil
+}
+
+// ownedMessage loads msgID, reporting someone else's message as not found.
+func (s *Service) ownedMessage(userID, msgID string) (*types.ScheduledMessage, error) {
+	msg, err := s.store.GetScheduledMessage(msgID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.UserID != userID {
+		s.logger.Warn("User tried to change someone else's scheduled message", "user_id", userID, "message_id", msgID, "owner_user_id", msg.UserID)
+		return nil, types.ErrMessageNotFound
+	}
+	return msg, nil
+}
+
+// retarget points the user's message at channelID, shifting or refusing it
+// by that channel's quiet hours. The store only saves the change if the
+// message is unchanged since it was read, so a message the scheduler has
+// taken for sending meanwhile is reported as not found rather than written
+// back, as is someone else's message.
+func (s *Service) retarget(userID, msgID, channelID string) (*types.ScheduledMessage, error) {
+	msg, err := s.store.UpdateScheduledMessage(userID, msgID, func(msg *types.ScheduledMessage) error {
+		if msg.UserID != userID {
+			s.logger.Warn("User tried to change someone else's scheduled message", "user_id", userID, "message_id", msgID, "owner_user_id", msg.UserID)
+			return types.ErrMessageNotFound
+		}
+		return s.schedule.Retime(msg, channelID, msg.PostAt)
+	})
+	if err != nil {
+		s.logger.Debug("Failed to move message", "user_id", userID, "message_id", msgID, "channel_id", channelID, "error", err)
+		return nil, err
+	}
+	s.logger.Info("Moved scheduled message to another channel", "user_id", userID, "message_id", msgID, "channel_id", channelID, "post_at", msg.PostAt)
+	s.events.Notify(types.NewLifecycleEvent(types.EventEdited, msg, userID))
+	return msg, nil
+}
+
+func (s *Service) buildLeftChannelPost(msg *types.ScheduledMessage, channelLink string) *model.Post {
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	action := func(name string) *model.PostActionIntegration {
+		return &model.PostActionIntegration{
+			URL:     constants.LeftChannelActionURL,
+			Context: map[string]any{"action": name, "id": msg.ID},
+		}
+	}
+	post := &model.Post{Message: formatter.FormatLeftChannelNotice(msg.PostAt.In(loc), loc.String(), channelLink, msg.MessageContent)}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Actions: []*model.PostAction{
+			{
+				Id:          constants.LeftChannelActionMove,
+				Name:        "Move to channel...",
+				Type:        model.PostActionTypeSelect,
+				DataSource:  "channels",
+				Integration: action(constants.LeftChannelActionMove),
+			},
+			{
+				Id:          constants.LeftChannelActionSelf,
+				Name:        "Send to me instead",
+				Type:        model.PostActionTypeButton,
+				Integration: action(constants.LeftChannelActionSelf),
+			},
+			{
+				Id:          constants.LeftChannelActionCancel,
+				Name:        "Cancel message",
+				Type:        model.PostActionTypeButton,
+				Style:       "danger",
+				Integration: action(constants.LeftChannelActionCancel),
+			},
+		},
+	}})
+	return post
+}
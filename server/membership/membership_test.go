@@ -0,0 +1,220 @@
+package membership
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type mocks struct {
+	store    *mock.MockStore
+	user     *mock.MockUserService
+	direct   *mock.MockDirectChannelService
+	channel  *mock.MockChannelService
+	poster   *mock.MockPostService
+	policy   *testutil.FakePolicy
+	schedule *mock.MockScheduleService
+	events   *testutil.FakeNotifier
+}
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		store:    mock.NewMockStore(ctrl),
+		user:     mock.NewMockUserService(ctrl),
+		direct:   mock.NewMockDirectChannelService(ctrl),
+		channel:  mock.NewMockChannelService(ctrl),
+		poster:   mock.NewMockPostService(ctrl),
+		policy:   &testutil.FakePolicy{},
+		schedule: mock.NewMockScheduleService(ctrl),
+		events:   &testutil.FakeNotifier{},
+	}
+	return New(testutil.FakeLogger{}, m.store, m.user, m.direct, m.channel, m.poster, m.policy, m.schedule, m.events, "bot"), m
+}
+
+// expectUpdate applies the service's update to stored, as the store would
+// if the message was unchanged since it was read.
+func expectUpdate(m *mocks, userID string, stored *types.ScheduledMessage) {
+	m.store.EXPECT().UpdateScheduledMessage(userID, stored.ID, gomock.Any()).
+		DoAndReturn(func(_, _ string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+			if err := update(stored); err != nil {
+				return nil, err
+			}
+			return stored, nil
+		})
+}
+
+// expectRetime expects a retime to channelID that keeps the post time.
+func expectRetime(m *mocks, channelID string) {
+	m.schedule.EXPECT().Retime(gomock.Any(), channelID, gomock.Any()).DoAndReturn(func(msg *types.ScheduledMessage, channelID string, postAt time.Time) error {
+		msg.ChannelID = channelID
+		msg.PostAt = postAt
+		return nil
+	})
+}
+
+func testMessage(id, channelID string) *types.ScheduledMessage {
+	return &types.ScheduledMessage{
+		ID:             id,
+		UserID:         "u1",
+		ChannelID:      channelID,
+		PostAt:         time.Date(2025, 1, 2, 9, 0, 0, 0, time.UTC),
+		MessageContent: "hello " + id,
+		Timezone:       "UTC",
+	}
+}
+
+func TestUserLeftChannel_DMsEachMessageInChannel(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().ListUserMessages("u1").Return([]*types.ScheduledMessage{testMessage("m1", "c1"), testMessage("m2", "other"), testMessage("m3", "c1")}, nil)
+	info := &ports.ChannelInfo{ChannelID: "c1", ChannelLink: "~town-square"}
+	m.channel.EXPECT().GetInfoOrUnknown("c1").Return(info).Times(1)
+	m.channel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square").Times(1)
+
+	var posts []*model.Post
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		posts = append(posts, post)
+		return nil
+	}).Times(2)
+
+	svc.UserLeftChannel("u1", "c1")
+
+	require.Len(t, posts, 2)
+	assert.Contains(t, posts[0].Message, "hello m1")
+	assert.Contains(t, posts[1].Message, "hello m3")
+	actions := posts[0].Attachments()[0].Actions
+	require.Len(t, actions, 3)
+	assert.Equal(t, model.PostActionTypeSelect, actions[0].Type)
+	assert.Equal(t, "channels", actions[0].DataSource)
+	for _, action := range actions {
+		assert.Equal(t, constants.LeftChannelActionURL, action.Integration.URL)
+		assert.Equal(t, "m1", action.Integration.Context["id"])
+	}
+	assert.Equal(t, constants.LeftChannelActionCancel, actions[2].Integration.Context["action"])
+}
+
+func TestUserLeftChannel_ListError(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().ListUserMessages("u1").Return(nil, errors.New("kv down"))
+	svc.UserLeftChannel("u1", "c1")
+}
+
+func TestMoveMessage(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	expectUpdate(m, "u1", testMessage("m1", "c1"))
+	expectRetime(m, "c2")
+
+	msg, err := svc.MoveMessage("u1", "m1", "c2")
+	require.NoError(t, err)
+	assert.Equal(t, "c2", msg.ChannelID)
+	assert.Equal(t, testMessage("m1", "c1").PostAt, msg.PostAt)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventEdited, events[0].Type)
+}
+
+func TestMoveMessage_QuietHoursShift(t *testing.T) {
+	svc, m := setupService(t)
+	stored := testMessage("m1", "c1")
+	requested := stored.PostAt
+	shifted := requested.Add(10 * time.Hour)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	expectUpdate(m, "u1", stored)
+	m.schedule.EXPECT().Retime(gomock.Any(), "c2", requested).DoAndReturn(func(msg *types.ScheduledMessage, channelID string, postAt time.Time) error {
+		msg.ChannelID = channelID
+		msg.PostAt = shifted
+		msg.ShiftedFrom = &postAt
+		return nil
+	})
+
+	msg, err := svc.MoveMessage("u1", "m1", "c2")
+	require.NoError(t, err)
+	assert.Equal(t, shifted, msg.PostAt)
+	require.NotNil(t, msg.ShiftedFrom)
+	assert.Equal(t, requested, *msg.ShiftedFrom)
+}
+
+func TestMoveMessage_QuietHoursRefuse(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	expectUpdate(m, "u1", testMessage("m1", "c1"))
+	m.schedule.EXPECT().Retime(gomock.Any(), "c2", gomock.Any()).Return(errors.New("within the quiet hours"))
+
+	_, err := svc.MoveMessage("u1", "m1", "c2")
+	assert.ErrorContains(t, err, "quiet hours")
+	assert.Empty(t, m.events.Events())
+}
+
+func TestMoveMessage_TakenForSending(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+	m.store.EXPECT().UpdateScheduledMessage("u1", "m1", gomock.Any()).Return(nil, types.ErrMessageNotFound)
+
+	_, err := svc.MoveMessage("u1", "m1", "c2")
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.Empty(t, m.events.Events())
+}
+
+func TestMoveMessage_NoPermission(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(false)
+
+	_, err := svc.MoveMessage("u1", "m1", "c2")
+	assert.ErrorIs(t, err, ErrCannotPost)
+	assert.Empty(t, m.events.Events())
+}
+
+func TestMoveMessage_PolicyRejects(t *testing.T) {
+	svc, m := setupService(t)
+	m.policy.ScheduleErr = errors.New("scheduling is disabled in this channel")
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionCreatePost).Return(true)
+
+	_, err := svc.MoveMessage("u1", "m1", "c2")
+	assert.EqualError(t, err, "scheduling is disabled in this channel")
+}
+
+func TestMoveMessage_NotOwner(t *testing.T) {
+	svc, m := setupService(t)
+	m.user.EXPECT().HasPermissionToChannel("u2", "c2", model.PermissionCreatePost).Return(true)
+	expectUpdate(m, "u2", testMessage("m1", "c1"))
+
+	_, err := svc.MoveMessage("u2", "m1", "c2")
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+}
+
+func TestMoveMessageToSelf(t *testing.T) {
+	svc, m := setupService(t)
+	m.direct.EXPECT().GetDirect("u1", "u1").Return(&model.Channel{Id: "dm"}, nil)
+	expectUpdate(m, "u1", testMessage("m1", "c1"))
+	expectRetime(m, "dm")
+
+	msg, err := svc.MoveMessageToSelf("u1", "m1")
+	require.NoError(t, err)
+	assert.Equal(t, "dm", msg.ChannelID)
+}
+
+func TestCancelMessage(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage("m1", "c1"), nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil)
+
+	_, err := svc.CancelMessage("u1", "m1")
+	require.NoError(t, err)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventCancelled, events[0].Type)
+	assert.Equal(t, "u1", events[0].ActorID)
+}
@@ -0,0 +1,227 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+const namespace = "scheduled_messages"
+
+var (
+	// durationBuckets suit ticks, store operations and HTTP requests, in seconds.
+	durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	// latenessBuckets suit how long after PostAt a message went out, in seconds.
+	latenessBuckets = []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600}
+)
+
+// eventCounters maps each lifecycle event to the counter it increments.
+var eventCounters = []struct {
+	event types.EventType
+	name  string
+	help  string
+}{
+	{types.EventScheduled, "scheduled_total", "Messages scheduled."},
+	{types.EventEdited, "edited_total", "Scheduled messages edited."},
+	{types.EventCancelled, "cancelled_total", "Scheduled messages cancelled."},
+	{types.EventSent, "sent_total", "Scheduled messages delivered."},
+	{types.EventFailed, "failed_total", "Scheduled messages that failed to deliver."},
+}
+
+// Registry collects the plugin's metrics in memory and renders them in the
+// Prometheus text exposition format. Values reset when the plugin restarts.
+type Registry struct {
+	mu               sync.Mutex
+	events           map[types.EventType]uint64
+	tick             *histogram
+	lateness         *histogram
+	pending          int
+	storeOps         map[string]*histogram
+	storeErrors      map[string]uint64
+	requests         map[requestKey]uint64
+	requestDurations map[string]*histogram
+}
+
+type requestKey struct {
+	route string
+	code  int
+}
+
+func New() *Registry {
+	return &Registry{
+		events:           map[types.EventType]uint64{},
+		tick:             newHistogram(durationBuckets),
+		lateness:         newHistogram(latenessBuckets),
+		storeOps:         map[string]*histogram{},
+		storeErrors:      map[string]uint64{},
+		requests:         map[requestKey]uint64{},
+		requestDurations: map[string]*histogram{},
+	}
+}
+
+// Notify counts lifecycle events. For deliveries it also records how late the
+// message went out compared to its PostAt.
+func (r *Registry) Notify(event *types.LifecycleEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events[event.Type]++
+	if (event.Type == types.EventSent || event.Type == types.EventFailed) && !event.Timestamp.IsZero() {
+		r.lateness.observe(event.Timestamp.Sub(event.PostAt).Seconds())
+	}
+}
+
+// ObserveTick records how long one scheduler pass took and how many messages
+// were still waiting when it finished.
+func (r *Registry) ObserveTick(duration time.Duration, pending int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.tick.observe(duration.Seconds())
+	r.pending = pending
+}
+
+func (r *Registry) ObserveStoreOp(op string, duration time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	h, ok := r.storeOps[op]
+	if !ok {
+		h = newHistogram(durationBuckets)
+		r.storeOps[op] = h
+	}
+	h.observe(duration.Seconds())
+	if err != nil {
+		r.storeErrors[op]++
+	}
+}
+
+func (r *Registry) ObserveRequest(route string, status int, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests[requestKey{route: route, code: status}]++
+	h, ok := r.requestDurations[route]
+	if !ok {
+		h = newHistogram(durationBuckets)
+		r.requestDurations[route] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+// WriteMetrics renders every metric in the Prometheus text format.
+func (r *Registry) WriteMetrics(w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b strings.Builder
+	for _, c := range eventCounters {
+		writeHeader(&b, c.name, c.help, "counter")
+		fmt.Fprintf(&b, "%s_%s %d\n", namespace, c.name, r.events[c.event])
+	}
+
+	writeHeader(&b, "pending", "Messages waiting to be sent after the last scheduler pass.", "gauge")
+	fmt.Fprintf(&b, "%s_pending %d\n", namespace, r.pending)
+
+	writeHeader(&b, "scheduler_tick_duration_seconds", "Time taken by one scheduler pass.", "histogram")
+	r.tick.write(&b, "scheduler_tick_duration_seconds", "")
+
+	writeHeader(&b, "delivery_lateness_seconds", "Time between a message's PostAt and its delivery attempt.", "histogram")
+	r.lateness.write(&b, "delivery_lateness_seconds", "")
+
+	writeHeader(&b, "store_operation_duration_seconds", "Time taken by store operations.", "histogram")
+	for _, op := range sortedKeys(r.storeOps) {
+		r.storeOps[op].write(&b, "store_operation_duration_seconds", label("op", op))
+	}
+
+	writeHeader(&b, "store_errors_total", "Store operations that returned an error.", "counter")
+	for _, op := range sortedKeys(r.storeErrors) {
+		fmt.Fprintf(&b, "%s_store_errors_total{%s} %d\n", namespace, label("op", op), r.storeErrors[op])
+	}
+
+	writeHeader(&b, "http_requests_total", "HTTP requests handled, by route and status code.", "counter")
+	keys := make([]requestKey, 0, len(r.requests))
+	for k := range r.requests {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		return keys[i].code < keys[j].code
+	})
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s_http_requests_total{%s,%s} %d\n", namespace, label("route", k.route), label("code", strconv.Itoa(k.code)), r.requests[k])
+	}
+
+	writeHeader(&b, "http_request_duration_seconds", "Time taken to handle HTTP requests, by route.", "histogram")
+	for _, route := range sortedKeys(r.requestDurations) {
+		r.requestDurations[route].write(&b, "http_request_duration_seconds", label("route", route))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type histogram struct {
+	buckets []float64
+	counts  []uint64
+	sum     float64
+	count   uint64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// write renders the cumulative buckets, sum and count. labels is empty or
+// comma-separated name="value" pairs that are placed before le.
+func (h *histogram) write(b *strings.Builder, name, labels string) {
+	prefix := ""
+	if labels != "" {
+		prefix = labels + ","
+	}
+	for i, upper := range h.buckets {
+		fmt.Fprintf(b, "%s_%s_bucket{%sle=\"%s\"} %d\n", namespace, name, prefix, formatFloat(upper), h.counts[i])
+	}
+	fmt.Fprintf(b, "%s_%s_bucket{%sle=\"+Inf\"} %d\n", namespace, name, prefix, h.count)
+	suffix := ""
+	if labels != "" {
+		suffix = "{" + labels + "}"
+	}
+	fmt.Fprintf(b, "%s_%s_sum%s %s\n", namespace, name, suffix, formatFloat(h.sum))
+	fmt.Fprintf(b, "%s_%s_count%s %d\n", namespace, name, suffix, h.count)
+}
+
+func writeHeader(b *strings.Builder, name, help, kind string) {
+	fmt.Fprintf(b, "# HELP %s_%s %s\n# TYPE %s_%s %s\n", namespace, name, help, namespace, name, kind)
+}
+
+func label(name, value string) string {
+	return fmt.Sprintf("%s=%q", name, value)
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
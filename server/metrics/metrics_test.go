@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func render(t *testing.T, r *Registry) string {
+	t.Helper()
+	var b strings.Builder
+	require.NoError(t, r.WriteMetrics(&b))
+	return b.String()
+}
+
+func TestNotify_CountsEventsAndLateness(t *testing.T) {
+	r := New()
+	postAt := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	r.Notify(&types.LifecycleEvent{Type: types.EventScheduled})
+	r.Notify(&types.LifecycleEvent{Type: types.EventScheduled})
+	r.Notify(&types.LifecycleEvent{Type: types.EventSent, PostAt: postAt, Timestamp: postAt.Add(20 * time.Second)})
+	r.Notify(&types.LifecycleEvent{Type: types.EventFailed, PostAt: postAt, Timestamp: postAt.Add(2 * time.Second)})
+
+	out := render(t, r)
+	assert.Contains(t, out, "# TYPE scheduled_messages_scheduled_total counter\nscheduled_messages_scheduled_total 2\n")
+	assert.Contains(t, out, "scheduled_messages_sent_total 1\n")
+	assert.Contains(t, out, "scheduled_messages_failed_total 1\n")
+	assert.Contains(t, out, "scheduled_messages_cancelled_total 0\n")
+	assert.Contains(t, out, `scheduled_messages_delivery_lateness_seconds_bucket{le="1"} 0`)
+	assert.Contains(t, out, `scheduled_messages_delivery_lateness_seconds_bucket{le="5"} 1`)
+	assert.Contains(t, out, `scheduled_messages_delivery_lateness_seconds_bucket{le="30"} 2`)
+	assert.Contains(t, out, `scheduled_messages_delivery_lateness_seconds_bucket{le="+Inf"} 2`)
+	assert.Contains(t, out, "scheduled_messages_delivery_lateness_seconds_sum 22\n")
+	assert.Contains(t, out, "scheduled_messages_delivery_lateness_seconds_count 2\n")
+}
+
+func TestObserveTick_SetsPending(t *testing.T) {
+	r := New()
+	r.ObserveTick(30*time.Millisecond, 7)
+	r.ObserveTick(10*time.Millisecond, 4)
+
+	out := render(t, r)
+	assert.Contains(t, out, "# TYPE scheduled_messages_pending gauge\nscheduled_messages_pending 4\n")
+	assert.Contains(t, out, `scheduled_messages_scheduler_tick_duration_seconds_bucket{le="0.025"} 1`)
+	assert.Contains(t, out, "scheduled_messages_scheduler_tick_duration_seconds_count 2\n")
+}
+
+func TestObserveStoreOpAndRequest_Labels(t *testing.T) {
+	r := New()
+	r.ObserveStoreOp("save", time.Millisecond, nil)
+	r.ObserveStoreOp("delete", time.Millisecond, errors.New("boom"))
+	r.ObserveRequest("GET /api/v1/messages", 200, time.Millisecond)
+	r.ObserveRequest("GET /api/v1/messages", 400, time.Millisecond)
+
+	out := render(t, r)
+	assert.Contains(t, out, `scheduled_messages_store_operation_duration_seconds_count{op="save"} 1`)
+	assert.Contains(t, out, `scheduled_messages_store_operation_duration_seconds_bucket{op="delete",le="0.005"} 1`)
+	assert.Contains(t, out, `scheduled_messages_store_errors_total{op="delete"} 1`)
+	assert.NotContains(t, out, `scheduled_messages_store_errors_total{op="save"}`)
+	assert.Contains(t, out, `scheduled_messages_http_requests_total{route="GET /api/v1/messages",code="200"} 1`)
+	assert.Contains(t, out, `scheduled_messages_http_requests_total{route="GET /api/v1/messages",code="400"} 1`)
+	assert.Contains(t, out, `scheduled_messages_http_request_duration_seconds_count{route="GET /api/v1/messages"} 2`)
+}
@@ -0,0 +1,182 @@
+package pause
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service keeps the admin's delivery pause in the KV store so every plugin
+// instance honors it, and applies the overdue-message policy on resume.
+type Service struct {
+	logger  ports.Logger
+	pauses  ports.PauseStore
+	store   ports.Store
+	poster  ports.PostService
+	channel ports.ChannelService
+	events  ports.EventNotifier
+	botID   string
+	clock   ports.Clock
+	mu      sync.Mutex
+}
+
+func New(
+	logger ports.Logger,
+	pauses ports.PauseStore,
+	store ports.Store,
+	poster ports.PostService,
+	channel ports.ChannelService,
+	events ports.EventNotifier,
+	botID string,
+	clk ports.Clock,
+) *Service {
+	logger.Debug("Creating new pause Service")
+	return &Service{
+		logger:  logger,
+		pauses:  pauses,
+		store:   store,
+		poster:  poster,
+		channel: channel,
+		events:  events,
+		botID:   botID,
+		clock:   clk,
+	}
+}
+
+// Pause stops delivery until Resume is called or, when resumeAt is set, until
+// that time passes, after which mode is applied. Pausing again while paused
+// replaces the resume time and mode but keeps the original pause time.
+func (s *Service) Pause(adminID string, resumeAt *time.Time, mode types.ResumeMode) (*types.PauseState, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.clock.Now().UTC()
+	if resumeAt != nil && !resumeAt.After(now) {
+		return nil, fmt.Errorf("resume time %s is not in the future", resumeAt.UTC().Format(time.RFC3339))
+	}
+	current, err := s.pauses.GetPauseState()
+	if err != nil {
+		return nil, err
+	}
+	state := &types.PauseState{PausedBy: adminID, PausedAt: now, ResumeAt: resumeAt, ResumeMode: mode}
+	if current != nil {
+		state.PausedAt = current.PausedAt
+	}
+	if err := s.pauses.SavePauseState(state); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Delivery of scheduled messages paused", "admin_id", adminID, "resume_at", resumeAt, "resume_mode", mode)
+	return state, nil
+}
+
+// Resume ends the pause. With ResumeSkip, messages that are already due are
+// deleted and returned to their owners by DM instead of being posted late.
+func (s *Service) Resume(actorID string, mode types.ResumeMode) (*types.ResumeResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, err := s.pauses.GetPauseState()
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, types.ErrDeliveryNotPaused
+	}
+	return s.resume(actorID, state, mode)
+}
+
+// State returns the current pause, or nil if delivery is not paused.
+func (s *Service) State() (*types.PauseState, error) {
+	return s.pauses.GetPauseState()
+}
+
+// DeliveryPaused reports whether the scheduler should hold due messages. A
+// pause whose resume time has passed is resumed here with its saved mode. If
+// the pause cannot be read, delivery carries on as normal.
+func (s *Service) DeliveryPaused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, err := s.pauses.GetPauseState()
+	if err != nil {
+		s.logger.Error("Failed to read delivery pause, delivering as normal", "error", err)
+		return false
+	}
+	if state == nil {
+		return false
+	}
+	if state.ResumeAt == nil || s.clock.Now().Before(*state.ResumeAt) {
+		s.logger.Debug("Delivery is paused", "paused_by", state.PausedBy, "resume_at", state.ResumeAt)
+		return true
+	}
+	s.logger.Info("Delivery pause reached its resume time", "resume_at", state.ResumeAt, "resume_mode", state.ResumeMode)
+	if _, err := s.resume(s.botID, state, state.ResumeMode); err != nil {
+		s.logger.Error("Failed to resume delivery automatically", "error", err)
+		return true
+	}
+	return false
+}
+
+func (s *Service) resume(actorID string, state *types.PauseState, mode types.ResumeMode) (*types.ResumeResult, error) {
+	result := &types.ResumeResult{Pause: state, Mode: mode}
+	if mode == types.ResumeSkip {
+		skipped, err := s.skipOverdue(actorID)
+		result.Skipped = skipped
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.pauses.DeletePauseState(); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Delivery of scheduled messages resumed", "actor_id", actorID, "mode", mode, "skipped", result.Skipped)
+	return result, nil
+}
+
+// skipOverdue deletes every message that is already due and DMs it back to
+// its owner. Messages that fail to delete are left to be sent late.
+func (s *Service) skipOverdue(actorID string) (int, error) {
+	now := s.clock.Now().UTC()
+	msgs, err := s.store.ListScheduledMessages()
+	if err != nil {
+		s.logger.Error("Failed to list scheduled messages to skip", "error", err)
+		return 0, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	skipped := 0
+	for _, msg := range msgs {
+		if msg.PostAt.After(now) {
+			continue
+		}
+		if err := s.store.DeleteScheduledMessage(msg.UserID, msg.ID); err != nil {
+			if !errors.Is(err, types.ErrMessageNotFound) {
+				s.logger.Error("Failed to delete overdue message on resume", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+			}
+			continue
+		}
+		skipped++
+		s.logger.Debug("Skipped overdue message on resume", "message_id", msg.ID, "user_id", msg.UserID, "post_at", msg.PostAt)
+		s.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, actorID))
+		s.notifyOwner(msg)
+	}
+	return skipped, nil
+}
+
+func (s *Service) notifyOwner(msg *types.ScheduledMessage) {
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		s.logger.Warn("Failed to load message timezone, falling back to UTC", "message_id", msg.ID, "timezone", msg.Timezone, "error", err)
+		loc = time.UTC
+	}
+	channelLink := s.channel.MakeChannelLink(s.channel.GetInfoOrUnknown(msg.ChannelID))
+	post := &model.Post{
+		Message: formatter.FormatPauseSkippedNotice(msg.PostAt.In(loc), loc.String(), channelLink, msg.MessageContent),
+		FileIds: msg.FileIDs,
+	}
+	if err := s.poster.DM(s.botID, msg.UserID, post); err != nil {
+		s.logger.Error("Failed to DM owner about skipped message", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+	}
+}
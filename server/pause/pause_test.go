@@ -0,0 +1,158 @@
+package pause
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	pauses  *mock.MockPauseStore
+	store   *mock.MockStore
+	poster  *mock.MockPostService
+	channel *mock.MockChannelService
+	events  *testutil.FakeNotifier
+}
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		pauses:  mock.NewMockPauseStore(ctrl),
+		store:   mock.NewMockStore(ctrl),
+		poster:  mock.NewMockPostService(ctrl),
+		channel: mock.NewMockChannelService(ctrl),
+		events:  &testutil.FakeNotifier{},
+	}
+	clk := testutil.FakeClock{NowTime: testNow}
+	return New(testutil.FakeLogger{}, m.pauses, m.store, m.poster, m.channel, m.events, "bot", clk), m
+}
+
+func TestPause_SavesState(t *testing.T) {
+	svc, m := setupService(t)
+	resumeAt := testNow.Add(time.Hour)
+	m.pauses.EXPECT().GetPauseState().Return(nil, nil)
+	m.pauses.EXPECT().SavePauseState(&types.PauseState{PausedBy: "admin", PausedAt: testNow, ResumeAt: &resumeAt, ResumeMode: types.ResumeSkip}).Return(nil)
+
+	state, err := svc.Pause("admin", &resumeAt, types.ResumeSkip)
+	require.NoError(t, err)
+	assert.Equal(t, testNow, state.PausedAt)
+}
+
+func TestPause_KeepsOriginalPauseTime(t *testing.T) {
+	svc, m := setupService(t)
+	pausedAt := testNow.Add(-time.Hour)
+	m.pauses.EXPECT().GetPauseState().Return(&types.PauseState{PausedBy: "other", PausedAt: pausedAt}, nil)
+	m.pauses.EXPECT().SavePauseState(gomock.Any()).Return(nil)
+
+	state, err := svc.Pause("admin", nil, types.ResumeSend)
+	require.NoError(t, err)
+	assert.Equal(t, pausedAt, state.PausedAt)
+	assert.Equal(t, "admin", state.PausedBy)
+}
+
+func TestPause_RejectsPastResumeTime(t *testing.T) {
+	svc, _ := setupService(t)
+	past := testNow.Add(-time.Minute)
+
+	_, err := svc.Pause("admin", &past, types.ResumeSend)
+	require.Error(t, err)
+}
+
+func TestResume_NotPaused(t *testing.T) {
+	svc, m := setupService(t)
+	m.pauses.EXPECT().GetPauseState().Return(nil, nil)
+
+	_, err := svc.Resume("admin", types.ResumeSend)
+	assert.ErrorIs(t, err, types.ErrDeliveryNotPaused)
+}
+
+func TestResume_Send(t *testing.T) {
+	svc, m := setupService(t)
+	state := &types.PauseState{PausedBy: "admin", PausedAt: testNow.Add(-time.Hour)}
+	m.pauses.EXPECT().GetPauseState().Return(state, nil)
+	m.pauses.EXPECT().DeletePauseState().Return(nil)
+
+	result, err := svc.Resume("admin", types.ResumeSend)
+	require.NoError(t, err)
+	assert.Same(t, state, result.Pause)
+	assert.Equal(t, 0, result.Skipped)
+	assert.Empty(t, m.events.Events())
+}
+
+func TestResume_SkipReturnsOverdueMessages(t *testing.T) {
+	svc, m := setupService(t)
+	m.pauses.EXPECT().GetPauseState().Return(&types.PauseState{PausedAt: testNow.Add(-time.Hour)}, nil)
+	overdue := &types.ScheduledMessage{ID: "late", UserID: "u1", ChannelID: "c1", PostAt: testNow.Add(-time.Minute), MessageContent: "hello", Timezone: "UTC", FileIDs: []string{"f1"}}
+	gone := &types.ScheduledMessage{ID: "gone", UserID: "u2", PostAt: testNow.Add(-time.Minute)}
+	future := &types.ScheduledMessage{ID: "future", UserID: "u1", PostAt: testNow.Add(time.Hour)}
+	m.store.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{overdue, gone, future}, nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "late").Return(nil)
+	m.store.EXPECT().DeleteScheduledMessage("u2", "gone").Return(errors.New("boom"))
+	info := &ports.ChannelInfo{ChannelID: "c1"}
+	m.channel.EXPECT().GetInfoOrUnknown("c1").Return(info)
+	m.channel.EXPECT().MakeChannelLink(info).Return("in channel: ~town-square")
+	m.poster.EXPECT().DM("bot", "u1", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		assert.Contains(t, post.Message, "was not sent because delivery was paused")
+		assert.Contains(t, post.Message, "hello")
+		assert.Equal(t, []string{"f1"}, []string(post.FileIds))
+		return nil
+	})
+	m.pauses.EXPECT().DeletePauseState().Return(nil)
+
+	result, err := svc.Resume("admin", types.ResumeSkip)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Skipped)
+	got := m.events.Events()
+	require.Len(t, got, 1)
+	assert.Equal(t, types.EventCancelled, got[0].Type)
+	assert.Equal(t, "late", got[0].MessageID)
+	assert.Equal(t, "admin", got[0].ActorID)
+}
+
+func TestDeliveryPaused(t *testing.T) {
+	svc, m := setupService(t)
+	later := testNow.Add(time.Minute)
+	gomock.InOrder(
+		m.pauses.EXPECT().GetPauseState().Return(nil, nil),
+		m.pauses.EXPECT().GetPauseState().Return(&types.PauseState{PausedAt: testNow}, nil),
+		m.pauses.EXPECT().GetPauseState().Return(&types.PauseState{PausedAt: testNow, ResumeAt: &later}, nil),
+		m.pauses.EXPECT().GetPauseState().Return(nil, errors.New("boom")),
+	)
+
+	assert.False(t, svc.DeliveryPaused())
+	assert.True(t, svc.DeliveryPaused())
+	assert.True(t, svc.DeliveryPaused())
+	assert.False(t, svc.DeliveryPaused())
+}
+
+func TestDeliveryPaused_AutoResumes(t *testing.T) {
+	svc, m := setupService(t)
+	due := testNow
+	m.pauses.EXPECT().GetPauseState().Return(&types.PauseState{PausedAt: testNow.Add(-time.Hour), ResumeAt: &due, ResumeMode: types.ResumeSkip}, nil)
+	m.store.EXPECT().ListScheduledMessages().Return(nil, nil)
+	m.pauses.EXPECT().DeletePauseState().Return(nil)
+
+	assert.False(t, svc.DeliveryPaused())
+}
+
+func TestDeliveryPaused_StaysPausedWhenResumeFails(t *testing.T) {
+	svc, m := setupService(t)
+	due := testNow
+	m.pauses.EXPECT().GetPauseState().Return(&types.PauseState{PausedAt: testNow.Add(-time.Hour), ResumeAt: &due}, nil)
+	m.pauses.EXPECT().DeletePauseState().Return(errors.New("boom"))
+
+	assert.True(t, svc.DeliveryPaused())
+}
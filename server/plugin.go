@@ -13,14 +13,32 @@ import (
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mm"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/admin"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/api"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/audit"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/bot"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/botdelivery"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/channel"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/clock"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/command"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/confirmation"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/deactivation"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/digest"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/draft"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/failure"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/feed"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/history"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/integration"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/membership"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/metrics"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/pause"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/policy"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/preferences"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/reminder"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/scheduler"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/store"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/webhook"
 )
 
 type ClientFactory func(api plugin.API, drv plugin.Driver) *pluginapi.Client
@@ -31,14 +49,22 @@ type BotEnsurer func(ports.BotService, ports.BotProfileImageService) (string, er
 
 type AppBuilder interface {
 	NewChannel(cli *pluginapi.Client) *channel.Channel
-	NewStore(cli *pluginapi.Client, maxUserMessages int) ports.Store
-	NewScheduler(cli *pluginapi.Client, st ports.Store, ch ports.ChannelService, botID string, clk ports.Clock) *scheduler.Scheduler
+	NewStore(cli *pluginapi.Client, maxUserMessages int, metrics ports.Metrics, clk ports.Clock) ports.Store
+	NewScheduler(cli *pluginapi.Client, st ports.Store, ch ports.ChannelService, botID string, clk ports.Clock, events ports.EventNotifier, policy ports.PolicyService, metrics ports.Metrics, pause ports.PauseService, digests ports.DigestService, failed ports.FailedMessageStore, prefs ports.PreferenceStore) *scheduler.Scheduler
 	NewCommandHandler(
 		cli *pluginapi.Client,
 		st ports.Store,
 		ch ports.ChannelService,
 		listSvc ports.ListService,
 		scheduleSvc ports.ScheduleService,
+		feedSvc ports.FeedService,
+		adminSvc ports.AdminService,
+		policySvc ports.PolicyService,
+		historySvc ports.HistoryService,
+		preferences ports.PreferenceService,
+		events ports.EventNotifier,
+		drafts ports.DraftService,
+		clk ports.Clock,
 		help string,
 	) *command.Handler
 	NewAPIHandler(
@@ -48,6 +74,18 @@ type AppBuilder interface {
 		Command command.Interface,
 		ListStervice ports.ListService,
 		ScheduleService *command.ScheduleService,
+		FeedService ports.FeedService,
+		WebhookDeliveries ports.WebhookDeliveryStore,
+		Integrations ports.IntegrationService,
+		Idempotency ports.IdempotencyStore,
+		Admin ports.AdminService,
+		Audit ports.AuditService,
+		Metrics ports.Metrics,
+		Membership ports.MembershipService,
+		Reminders ports.ReminderService,
+		Failures ports.FailureService,
+		Preferences ports.PreferenceService,
+		Drafts ports.DraftService,
 	) *api.Handler
 }
 
@@ -57,12 +95,15 @@ func (prodBuilder) NewChannel(cli *pluginapi.Client) *channel.Channel {
 	return channel.New(&cli.Log, &cli.Channel, &cli.Team, &cli.User)
 }
 
-func (prodBuilder) NewStore(cli *pluginapi.Client, maxUserMessages int) ports.Store {
-	return store.NewKVStore(&cli.Log, &cli.KV, mm.ListMatchingService{}, maxUserMessages)
+func (prodBuilder) NewStore(cli *pluginapi.Client, maxUserMessages int, metrics ports.Metrics, clk ports.Clock) ports.Store {
+	kvStore := store.NewKVStore(&cli.Log, &cli.KV, mm.ListMatchingService{}, maxUserMessages)
+	scoped := store.NewScopeIndexStore(&cli.Log, kvStore, &cli.KV, &cli.Channel)
+	return store.NewInstrumentedStore(scoped, metrics, clk)
 }
 
-func (prodBuilder) NewScheduler(cli *pluginapi.Client, st ports.Store, ch ports.ChannelService, botID string, clk ports.Clock) *scheduler.Scheduler {
-	return scheduler.New(&cli.Log, &cli.Post, st, ch, botID, clk)
+func (prodBuilder) NewScheduler(cli *pluginapi.Client, st ports.Store, ch ports.ChannelService, botID string, clk ports.Clock, events ports.EventNotifier, policy ports.PolicyService, metrics ports.Metrics, pause ports.PauseService, digests ports.DigestService, failed ports.FailedMessageStore, prefs ports.PreferenceStore) *scheduler.Scheduler {
+	botDelivery := botdelivery.New(&cli.Log, ch, &cli.User, &cli.File, botID)
+	return scheduler.New(&cli.Log, &cli.Post, st, ch, botID, clk, events, policy, metrics, pause, digests, failed, prefs, botDelivery)
 }
 
 func (prodBuilder) NewCommandHandler(
@@ -71,6 +112,14 @@ func (prodBuilder) NewCommandHandler(
 	ch ports.ChannelService,
 	listSvc ports.ListService,
 	scheduleSvc ports.ScheduleService,
+	feedSvc ports.FeedService,
+	adminSvc ports.AdminService,
+	policySvc ports.PolicyService,
+	historySvc ports.HistoryService,
+	preferences ports.PreferenceService,
+	events ports.EventNotifier,
+	drafts ports.DraftService,
+	clk ports.Clock,
 	help string,
 ) *command.Handler {
 	return command.NewHandler(
@@ -81,6 +130,15 @@ func (prodBuilder) NewCommandHandler(
 		ch,
 		listSvc,
 		scheduleSvc,
+		feedSvc,
+		adminSvc,
+		policySvc,
+		historySvc,
+		preferences,
+		&cli.Frontend,
+		events,
+		drafts,
+		clk,
 		help,
 	)
 }
@@ -92,14 +150,39 @@ func (prodBuilder) NewAPIHandler(
 	command command.Interface,
 	listStervice ports.ListService,
 	scheduleService *command.ScheduleService,
+	feedService ports.FeedService,
+	webhookDeliveries ports.WebhookDeliveryStore,
+	integrations ports.IntegrationService,
+	idempotency ports.IdempotencyStore,
+	admin ports.AdminService,
+	audit ports.AuditService,
+	metrics ports.Metrics,
+	membership ports.MembershipService,
+	reminders ports.ReminderService,
+	failures ports.FailureService,
+	preferences ports.PreferenceService,
+	drafts ports.DraftService,
 ) *api.Handler {
 	return api.NewHandler(
 		&cli.Log,
 		poster,
+		&cli.User,
 		channel,
 		command,
 		listStervice,
 		scheduleService,
+		feedService,
+		webhookDeliveries,
+		integrations,
+		idempotency,
+		admin,
+		audit,
+		metrics,
+		membership,
+		reminders,
+		failures,
+		preferences,
+		drafts,
 	)
 }
 
@@ -109,18 +192,27 @@ type Plugin struct {
 	configurationLock sync.RWMutex
 	// configuration is the active plugin configuration. Consult getConfiguration and
 	// setConfiguration for usage.
-	configuration          *configuration
-	client                 *pluginapi.Client
-	BotID                  string
-	Scheduler              *scheduler.Scheduler
-	Store                  ports.Store
-	Channel                ports.ChannelService
-	Command                command.Interface
-	defaultMaxUserMessages int
-	helpText               string
-	logger                 ports.Logger
-	poster                 ports.PostService
-	api                    api.Interface
+	configuration   *configuration
+	client          *pluginapi.Client
+	BotID           string
+	Scheduler       *scheduler.Scheduler
+	Store           ports.Store
+	Channel         ports.ChannelService
+	Command         command.Interface
+	scheduleService *command.ScheduleService
+	helpText        string
+	logger          ports.Logger
+	poster          ports.PostService
+	api             api.Interface
+	webhooks        *webhook.Dispatcher
+	audit           *audit.Log
+	history         *history.Service
+	metrics         *metrics.Registry
+	events          ports.EventNotifier
+	policy          *policy.Service
+	deactivation    *deactivation.Service
+	membership      *membership.Service
+	confirmations   *confirmation.Service
 }
 
 func (p *Plugin) loadHelpText(text string) (string, error) {
@@ -209,6 +301,22 @@ func (p *Plugin) OnDeactivate() error {
 	} else {
 		p.API.LogWarn("Scheduler was nil during deactivation")
 	}
+	if p.webhooks != nil {
+		p.API.LogDebug("Waiting for in-flight webhook deliveries")
+		p.webhooks.Close()
+	}
+	if p.audit != nil {
+		p.API.LogDebug("Waiting for audit log pruning")
+		p.audit.Close()
+	}
+	if p.deactivation != nil {
+		p.API.LogDebug("Stopping reactivation checks")
+		p.deactivation.Close()
+	}
+	if p.confirmations != nil {
+		p.API.LogDebug("Sending queued delivery confirmations")
+		p.confirmations.Close()
+	}
 	p.API.LogInfo("Scheduled Messages plugin deactivated.")
 	return nil
 }
@@ -216,22 +324,85 @@ func (p *Plugin) OnDeactivate() error {
 func (p *Plugin) initialize(botID string, clk ports.Clock, builder AppBuilder) error {
 	p.API.LogDebug("Initializing plugin components", "bot_id", botID)
 	p.BotID = botID
-	p.defaultMaxUserMessages = constants.MaxUserMessages
 	p.logger = &p.client.Log
 	p.poster = &p.client.Post
 
+	p.logger.Debug("Initializing webhook dispatcher")
+	webhookDeliveries := store.NewWebhookDeliveryStore(p.logger, &p.client.KV, constants.WebhookDeliveryLogSize)
+	p.webhooks = webhook.New(p.logger, &http.Client{}, webhookDeliveries, clk, constants.WebhookInitialBackoff)
+	limits := p.getConfiguration().limits()
+
+	p.logger.Debug("Initializing audit log")
+	auditEntries := store.NewAuditStore(p.logger, &p.client.KV, mm.ListMatchingService{})
+	p.audit = audit.New(p.logger, auditEntries, clk, p.getConfiguration().AuditRetentionDays)
+	p.logger.Debug("Initializing sent message history")
+	historyEntries := store.NewHistoryStore(p.logger, &p.client.KV, constants.HistoryMaxEntries)
+	p.history = history.New(p.logger, historyEntries, &p.client.Configuration, clk, p.getConfiguration().HistoryRetentionDays)
+	p.metrics = metrics.New()
 	p.logger.Debug("Initializing Channel service")
 	p.Channel = builder.NewChannel(p.client)
-	p.logger.Debug("Initializing Store service", "max_user_messages", p.defaultMaxUserMessages)
-	p.Store = builder.NewStore(p.client, p.defaultMaxUserMessages)
-	p.logger.Debug("Initializing Scheduler service", "bot_id", p.BotID)
-	p.Scheduler = builder.NewScheduler(p.client, p.Store, p.Channel, p.BotID, clk)
+	prefs := store.NewPreferenceStore(p.logger, &p.client.KV)
+	p.logger.Debug("Initializing delivery confirmations")
+	p.confirmations = confirmation.New(p.logger, prefs, p.poster, p.Channel, p.history, p.BotID, constants.DeliveryConfirmationWindow)
+	p.events = eventFanout{p.audit, p.history, p.metrics, p.webhooks, p.confirmations}
+
+	p.logger.Debug("Initializing Store service", "max_user_messages", limits.MaxUserMessages)
+	p.Store = builder.NewStore(p.client, limits.MaxUserMessages, p.metrics, clk)
+	if err := store.BuildScopeIndexes(p.logger, &p.client.KV, p.Store, &p.client.Channel); err != nil {
+		p.logger.Error("Failed to build channel and team message indexes, policy caps may undercount until the next start", "error", err)
+	}
+	p.logger.Debug("Initializing Policy service")
+	channelPolicies := store.NewChannelPolicyStore(p.logger, &p.client.KV)
+	pending := store.NewPendingCounter(p.logger, &p.client.KV)
+	p.policy = policy.New(p.logger, pending, channelPolicies, &p.client.Channel, &p.client.User, p.getConfiguration().policy())
+	p.logger.Debug("Initializing Pause service")
+	pauseService := pause.New(p.logger, store.NewPauseStore(p.logger, &p.client.KV), p.Store, p.poster, p.Channel, p.events, p.BotID, clk)
 
 	p.logger.Debug("Initializing List service")
-	listService := command.NewListService(p.logger, p.Store, p.Channel)
+	listService := command.NewListService(p.logger, p.Store, p.Channel, prefs)
+
+	p.logger.Debug("Initializing Schedule service", "max_user_messages", limits.MaxUserMessages)
+	scheduleService := command.NewScheduleService(p.logger, &p.client.User, p.Store, p.Channel, clk, p.events, p.policy, pauseService, prefs, limits)
+	p.scheduleService = scheduleService
+	p.applyConfiguration(p.getConfiguration())
+
+	p.logger.Debug("Initializing Digest service")
+	digestService := digest.New(p.logger, prefs, listService, scheduleService, p.poster, p.BotID)
+	p.logger.Debug("Initializing Scheduler service", "bot_id", p.BotID)
+	failedMessages := store.NewFailedMessageStore(p.logger, &p.client.KV, constants.FailedMessageTTL)
+	p.Scheduler = builder.NewScheduler(p.client, p.Store, p.Channel, p.BotID, clk, p.events, p.policy, p.metrics, pauseService, digestService, failedMessages, prefs)
+
+	p.logger.Debug("Initializing Reminder service")
+	reminderService := reminder.New(p.logger, p.Store, p.Scheduler, p.events)
+	p.logger.Debug("Initializing Draft service")
+	draftService := draft.New(p.logger, p.Store, &p.client.Frontend, p.events)
+	p.logger.Debug("Initializing Failure service")
+	failureService := failure.New(p.logger, p.Store, failedMessages, &p.client.User, p.Scheduler, p.policy, scheduleService, draftService, clk, p.events)
 
-	p.logger.Debug("Initializing Schedule service", "max_user_messages", p.defaultMaxUserMessages)
-	scheduleService := command.NewScheduleService(p.logger, &p.client.User, p.Store, p.Channel, clk, p.defaultMaxUserMessages)
+	p.logger.Debug("Initializing Feed service")
+	feedTokens := store.NewFeedTokenStore(p.logger, &p.client.KV)
+	feedService := feed.New(p.logger, feedTokens, p.Store, p.Channel, &p.client.Configuration, clk)
+
+	p.logger.Debug("Initializing Integration service")
+	integrationTokens := store.NewIntegrationTokenStore(p.logger, &p.client.KV)
+	integrationService := integration.New(p.logger, integrationTokens, p.Store, &p.client.User, p.BotID, clk)
+
+	p.logger.Debug("Initializing Idempotency store")
+	idempotency := store.NewIdempotencyStore(p.logger, &p.client.KV, constants.IdempotencyKeyTTL)
+
+	p.logger.Debug("Initializing Deactivation service")
+	heldMessages := store.NewHeldMessageStore(p.logger, &p.client.KV, mm.ListMatchingService{})
+	p.deactivation = deactivation.New(p.logger, p.Store, heldMessages, &p.client.User, p.poster, p.Channel, p.events, p.BotID, clk, p.getConfiguration().deactivationMode())
+
+	p.logger.Debug("Initializing Membership service")
+	p.membership = membership.New(p.logger, p.Store, &p.client.User, &p.client.Channel, p.Channel, p.poster, p.policy, scheduleService, p.events, p.BotID)
+
+	p.logger.Debug("Initializing Admin service")
+	keyCounter := store.NewKeyCounter(p.logger, &p.client.KV, mm.ListMatchingService{})
+	adminService := admin.New(p.logger, p.Store, p.poster, p.Channel, p.events, p.BotID, p.Scheduler, keyCounter, clk, pauseService)
+
+	p.logger.Debug("Initializing Preferences service")
+	preferenceService := preferences.New(p.logger, prefs)
 
 	p.logger.Debug("Initializing Command handler")
 	p.Command = builder.NewCommandHandler(
@@ -240,6 +411,14 @@ func (p *Plugin) initialize(botID string, clk ports.Clock, builder AppBuilder) e
 		p.Channel,
 		listService,
 		scheduleService,
+		feedService,
+		adminService,
+		p.policy,
+		p.history,
+		preferenceService,
+		p.events,
+		draftService,
+		clk,
 		p.helpText,
 	)
 
@@ -251,6 +430,18 @@ func (p *Plugin) initialize(botID string, clk ports.Clock, builder AppBuilder) e
 		p.Command,
 		listService,
 		scheduleService,
+		feedService,
+		webhookDeliveries,
+		integrationService,
+		idempotency,
+		adminService,
+		p.audit,
+		p.metrics,
+		p.membership,
+		reminderService,
+		failureService,
+		preferenceService,
+		draftService,
 	)
 
 	p.logger.Debug("Registering command handler")
@@ -262,6 +453,7 @@ func (p *Plugin) initialize(botID string, clk ports.Clock, builder AppBuilder) e
 
 	p.logger.Info("Starting scheduler goroutine")
 	go p.Scheduler.Start()
+	p.deactivation.Start()
 
 	p.logger.Debug("Plugin initialization complete")
 	return nil
@@ -278,6 +470,16 @@ func (p *Plugin) ExecuteCommand(c *plugin.Context, args *model.CommandArgs) (*mo
 	return resp, appErr
 }
 
+func (p *Plugin) UserHasBeenDeactivated(c *plugin.Context, user *model.User) {
+	p.logger.Debug("UserHasBeenDeactivated hook triggered", "user_id", user.Id)
+	p.deactivation.UserDeactivated(user)
+}
+
+func (p *Plugin) UserHasLeftChannel(c *plugin.Context, channelMember *model.ChannelMember, actor *model.User) {
+	p.logger.Debug("UserHasLeftChannel hook triggered", "user_id", channelMember.UserId, "channel_id", channelMember.ChannelId)
+	p.membership.UserLeftChannel(channelMember.UserId, channelMember.ChannelId)
+}
+
 func (p *Plugin) ServeHTTP(c *plugin.Context, w http.ResponseWriter, r *http.Request) {
 	p.logger.Debug("Received HTTP request", "method", r.Method, "url", r.URL.String())
 	p.api.ServeHTTP(c, w, r)
@@ -24,6 +24,7 @@ func pluginTestAPI() *plugintest.API {
 	api.On("LogWarn", mock.Anything).Maybe()
 	api.On("LogDebug", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
 	api.On("LogError", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	api.On("KVGet", constants.ScopeIndexVersionKey).Return([]byte("1"), nil).Maybe()
 	return api
 }
 
@@ -102,7 +103,8 @@ func TestOnActivateWithSuccess(t *testing.T) {
 	require.NotNil(t, pl.Scheduler)
 	require.NotNil(t, pl.Command)
 	require.Equal(t, "bot-id", pl.BotID)
-	require.Equal(t, constants.MaxUserMessages, pl.defaultMaxUserMessages)
+	require.NotNil(t, pl.scheduleService)
+	require.Equal(t, constants.MaxUserMessages, pl.getConfiguration().limits().MaxUserMessages)
 	require.Equal(t, &pl.client.Log, pl.logger)
 	require.Equal(t, &pl.client.Post, pl.poster)
 	require.NoError(t, pl.OnDeactivate())
@@ -0,0 +1,206 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var (
+	// ErrNotChannelAdmin is returned when someone other than a channel admin
+	// tries to change a channel's rule.
+	ErrNotChannelAdmin = errors.New(constants.PolicyPermissionDenied)
+	// ErrChannelAdminRulesOff is returned to channel admins when the System
+	// Console reserves channel rules for System Admins.
+	ErrChannelAdminRulesOff = errors.New(constants.ErrPolicyChannelAdminsOff)
+)
+
+// Service evaluates the scheduling policy: the System Console allow/deny lists
+// and caps, plus the per-channel rules kept in the KV store.
+type Service struct {
+	logger   ports.Logger
+	counter  ports.PendingCounter
+	rules    ports.ChannelPolicyStore
+	channels ports.ChannelDataService
+	user     ports.UserService
+	mu       sync.RWMutex
+	policy   types.Policy
+}
+
+func New(
+	logger ports.Logger,
+	counter ports.PendingCounter,
+	rules ports.ChannelPolicyStore,
+	channels ports.ChannelDataService,
+	user ports.UserService,
+	policy types.Policy,
+) *Service {
+	logger.Debug("Creating new policy Service")
+	return &Service{
+		logger:   logger,
+		counter:  counter,
+		rules:    rules,
+		channels: channels,
+		user:     user,
+		policy:   policy,
+	}
+}
+
+// Configure replaces the System Console policy.
+func (s *Service) Configure(policy types.Policy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.policy = policy
+	s.logger.Debug("Scheduling policy configured", "max_channel_messages", policy.MaxChannelMessages, "max_team_messages", policy.MaxTeamMessages)
+}
+
+func (s *Service) current() types.Policy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.policy
+}
+
+// CheckSchedule reports whether a new message may be scheduled into channelID,
+// including the pending-message caps.
+func (s *Service) CheckSchedule(channelID string) error {
+	s.logger.Debug("Checking scheduling policy", "channel_id", channelID)
+	policy := s.current()
+	rule, teamID, err := s.checkRules(policy, channelID)
+	if err != nil {
+		return err
+	}
+
+	channelCap := policy.MaxChannelMessages
+	if rule != nil && rule.MaxPending > 0 && (channelCap == 0 || rule.MaxPending < channelCap) {
+		channelCap = rule.MaxPending
+	}
+	teamCap := policy.MaxTeamMessages
+	if teamID == "" {
+		teamCap = 0
+	}
+	if channelCap == 0 && teamCap == 0 {
+		return nil
+	}
+
+	channelCount, teamCount := 0, 0
+	if channelCap > 0 {
+		if channelCount, err = s.counter.CountChannelMessages(channelID); err != nil {
+			s.logger.Error("Failed to count channel messages for policy caps", "channel_id", channelID, "error", err)
+			return fmt.Errorf("failed to check scheduling limits: %w", err)
+		}
+	}
+	if teamCap > 0 {
+		if teamCount, err = s.counter.CountTeamMessages(teamID); err != nil {
+			s.logger.Error("Failed to count team messages for policy caps", "channel_id", channelID, "team_id", teamID, "error", err)
+			return fmt.Errorf("failed to check scheduling limits: %w", err)
+		}
+	}
+	s.logger.Debug("Counted pending messages for policy caps", "channel_id", channelID, "channel_count", channelCount, "team_count", teamCount)
+	if channelCap > 0 && channelCount >= channelCap {
+		return &types.PolicyDeniedError{Reason: fmt.Sprintf(constants.ErrPolicyChannelCapReached, channelCount)}
+	}
+	if teamCap > 0 && teamCount >= teamCap {
+		return &types.PolicyDeniedError{Reason: fmt.Sprintf(constants.ErrPolicyTeamCapReached, teamCount)}
+	}
+	return nil
+}
+
+// CheckSend re-evaluates the allow/deny rules for a message that is due, in
+// case they changed after it was scheduled. Caps are not checked again.
+func (s *Service) CheckSend(msg *types.ScheduledMessage) error {
+	s.logger.Debug("Checking scheduling policy at send time", "message_id", msg.ID, "channel_id", msg.ChannelID)
+	if msg.AsBot {
+		if err := s.CheckBotDelivery(); err != nil {
+			return err
+		}
+	}
+	_, _, err := s.checkRules(s.current(), msg.ChannelID)
+	return err
+}
+
+// CheckBotDelivery reports whether messages may be posted as the bot.
+func (s *Service) CheckBotDelivery() error {
+	if !s.current().BotDelivery {
+		s.logger.Debug("Bot delivery is turned off")
+		return &types.PolicyDeniedError{Reason: constants.ErrPolicyBotDeliveryOff}
+	}
+	return nil
+}
+
+func (s *Service) GetChannelPolicy(channelID string) (*types.ChannelPolicy, error) {
+	return s.rules.GetChannelPolicy(channelID)
+}
+
+// SetChannelPolicy saves a channel's rule on behalf of userID, who must be a
+// System Admin, or a channel admin when channel rules are enabled. A rule that
+// restricts nothing is deleted.
+func (s *Service) SetChannelPolicy(userID string, rule *types.ChannelPolicy) error {
+	s.logger.Debug("Setting channel policy", "user_id", userID, "channel_id", rule.ChannelID, "disabled", rule.Disabled, "max_pending", rule.MaxPending)
+	if !s.user.HasPermissionTo(userID, model.PermissionManageSystem) {
+		if !s.current().ChannelAdminRules {
+			return ErrChannelAdminRulesOff
+		}
+		if !s.user.HasPermissionToChannel(userID, rule.ChannelID, model.PermissionManageChannelRoles) {
+			s.logger.Warn("Non channel admin attempted to change channel policy", "user_id", userID, "channel_id", rule.ChannelID)
+			return ErrNotChannelAdmin
+		}
+	}
+	if rule.MaxPending < 0 {
+		return errors.New(constants.ErrPolicyInvalidChannelCap)
+	}
+	if !rule.Disabled && rule.MaxPending == 0 {
+		return s.rules.DeleteChannelPolicy(rule.ChannelID)
+	}
+	rule.UpdatedBy = userID
+	if err := s.rules.SaveChannelPolicy(rule); err != nil {
+		return err
+	}
+	s.logger.Info("Channel policy updated", "user_id", userID, "channel_id", rule.ChannelID)
+	return nil
+}
+
+// checkRules applies the allow/deny lists and the channel's own rule, and
+// returns that rule and the channel's team for the cap checks. Direct and
+// group messages have no team, so team lists do not apply to them.
+func (s *Service) checkRules(policy types.Policy, channelID string) (*types.ChannelPolicy, string, error) {
+	if len(policy.AllowedChannelIDs) > 0 && !slices.Contains(policy.AllowedChannelIDs, channelID) {
+		return nil, "", &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelNotAllowed}
+	}
+	if slices.Contains(policy.DeniedChannelIDs, channelID) {
+		return nil, "", &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelNotAllowed}
+	}
+
+	var teamID string
+	if len(policy.AllowedTeamIDs) > 0 || len(policy.DeniedTeamIDs) > 0 || policy.MaxTeamMessages > 0 {
+		channel, err := s.channels.Get(channelID)
+		if err != nil {
+			s.logger.Error("Failed to get channel for policy check", "channel_id", channelID, "error", err)
+			return nil, "", fmt.Errorf("failed to check scheduling policy: %w", err)
+		}
+		teamID = channel.TeamId
+	}
+	if teamID != "" {
+		if len(policy.AllowedTeamIDs) > 0 && !slices.Contains(policy.AllowedTeamIDs, teamID) {
+			return nil, "", &types.PolicyDeniedError{Reason: constants.ErrPolicyTeamNotAllowed}
+		}
+		if slices.Contains(policy.DeniedTeamIDs, teamID) {
+			return nil, "", &types.PolicyDeniedError{Reason: constants.ErrPolicyTeamNotAllowed}
+		}
+	}
+
+	rule, err := s.rules.GetChannelPolicy(channelID)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to check scheduling policy: %w", err)
+	}
+	if rule != nil && rule.Disabled {
+		return nil, "", &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelDisabled}
+	}
+	return rule, teamID, nil
+}
@@ -0,0 +1,171 @@
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type policyMocks struct {
+	counter  *mock.MockPendingCounter
+	rules    *mock.MockChannelPolicyStore
+	channels *mock.MockChannelDataService
+	user     *mock.MockUserService
+}
+
+func setupService(t *testing.T, policy types.Policy) (*Service, *policyMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &policyMocks{
+		counter:  mock.NewMockPendingCounter(ctrl),
+		rules:    mock.NewMockChannelPolicyStore(ctrl),
+		channels: mock.NewMockChannelDataService(ctrl),
+		user:     mock.NewMockUserService(ctrl),
+	}
+	return New(testutil.FakeLogger{}, m.counter, m.rules, m.channels, m.user, policy), m
+}
+
+func requireDenied(t *testing.T, err error, reason string) {
+	t.Helper()
+	require.ErrorIs(t, err, types.ErrPolicyDenied)
+	assert.Equal(t, reason, err.Error())
+}
+
+func TestCheckSchedule_NoPolicy(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, nil)
+	require.NoError(t, svc.CheckSchedule("c1"))
+}
+
+func TestCheckSchedule_ChannelLists(t *testing.T) {
+	svc, _ := setupService(t, types.Policy{DeniedChannelIDs: []string{"incident"}})
+	requireDenied(t, svc.CheckSchedule("incident"), constants.ErrPolicyChannelNotAllowed)
+
+	svc, _ = setupService(t, types.Policy{AllowedChannelIDs: []string{"c1"}})
+	requireDenied(t, svc.CheckSchedule("c2"), constants.ErrPolicyChannelNotAllowed)
+}
+
+func TestCheckSchedule_TeamLists(t *testing.T) {
+	svc, m := setupService(t, types.Policy{DeniedTeamIDs: []string{"t1"}})
+	m.channels.EXPECT().Get("c1").Return(&model.Channel{Id: "c1", TeamId: "t1"}, nil)
+	requireDenied(t, svc.CheckSchedule("c1"), constants.ErrPolicyTeamNotAllowed)
+}
+
+func TestCheckSchedule_TeamListsIgnoreDirectMessages(t *testing.T) {
+	svc, m := setupService(t, types.Policy{AllowedTeamIDs: []string{"t1"}})
+	m.channels.EXPECT().Get("dm").Return(&model.Channel{Id: "dm"}, nil)
+	m.rules.EXPECT().GetChannelPolicy("dm").Return(nil, nil)
+	require.NoError(t, svc.CheckSchedule("dm"))
+}
+
+func TestCheckSchedule_ChannelDisabled(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(&types.ChannelPolicy{ChannelID: "c1", Disabled: true}, nil)
+	requireDenied(t, svc.CheckSchedule("c1"), constants.ErrPolicyChannelDisabled)
+}
+
+func TestCheckSchedule_ChannelCapUsesTighterLimit(t *testing.T) {
+	svc, m := setupService(t, types.Policy{MaxChannelMessages: 5})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(&types.ChannelPolicy{ChannelID: "c1", MaxPending: 2}, nil)
+	m.counter.EXPECT().CountChannelMessages("c1").Return(2, nil)
+	err := svc.CheckSchedule("c1")
+	require.ErrorIs(t, err, types.ErrPolicyDenied)
+	assert.Contains(t, err.Error(), "already has 2 pending")
+}
+
+func TestCheckSchedule_TeamCap(t *testing.T) {
+	svc, m := setupService(t, types.Policy{MaxTeamMessages: 2})
+	m.channels.EXPECT().Get("c1").Return(&model.Channel{Id: "c1", TeamId: "t1"}, nil)
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, nil)
+	m.counter.EXPECT().CountTeamMessages("t1").Return(3, nil)
+	err := svc.CheckSchedule("c1")
+	require.ErrorIs(t, err, types.ErrPolicyDenied)
+	assert.Contains(t, err.Error(), "team already has 3 pending")
+}
+
+func TestCheckSchedule_UnderCap(t *testing.T) {
+	svc, m := setupService(t, types.Policy{MaxChannelMessages: 2})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, nil)
+	m.counter.EXPECT().CountChannelMessages("c1").Return(1, nil)
+	require.NoError(t, svc.CheckSchedule("c1"))
+}
+
+func TestCheckSchedule_CountError(t *testing.T) {
+	svc, m := setupService(t, types.Policy{MaxChannelMessages: 2})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, nil)
+	m.counter.EXPECT().CountChannelMessages("c1").Return(0, errors.New("kv down"))
+	assert.ErrorContains(t, svc.CheckSchedule("c1"), "failed to check scheduling limits: kv down")
+}
+
+func TestCheckSend_IgnoresCaps(t *testing.T) {
+	svc, m := setupService(t, types.Policy{MaxChannelMessages: 1})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, nil)
+	require.NoError(t, svc.CheckSend(&types.ScheduledMessage{ID: "a", ChannelID: "c1"}))
+
+	svc.Configure(types.Policy{DeniedChannelIDs: []string{"c1"}})
+	requireDenied(t, svc.CheckSend(&types.ScheduledMessage{ID: "a", ChannelID: "c1"}), constants.ErrPolicyChannelNotAllowed)
+}
+
+func TestCheckSend_BotDelivery(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	requireDenied(t, svc.CheckBotDelivery(), constants.ErrPolicyBotDeliveryOff)
+	requireDenied(t, svc.CheckSend(&types.ScheduledMessage{ID: "a", ChannelID: "c1", AsBot: true}), constants.ErrPolicyBotDeliveryOff)
+
+	svc.Configure(types.Policy{BotDelivery: true})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, nil)
+	require.NoError(t, svc.CheckBotDelivery())
+	require.NoError(t, svc.CheckSend(&types.ScheduledMessage{ID: "a", ChannelID: "c1", AsBot: true}))
+}
+
+func TestSetChannelPolicy_SystemAdmin(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	rule := &types.ChannelPolicy{ChannelID: "c1", MaxPending: 3}
+	m.user.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(true)
+	m.rules.EXPECT().SaveChannelPolicy(rule).Return(nil)
+
+	require.NoError(t, svc.SetChannelPolicy("admin", rule))
+	assert.Equal(t, "admin", rule.UpdatedBy)
+}
+
+func TestSetChannelPolicy_ChannelAdminRulesOff(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	m.user.EXPECT().HasPermissionTo("u1", model.PermissionManageSystem).Return(false)
+
+	err := svc.SetChannelPolicy("u1", &types.ChannelPolicy{ChannelID: "c1", Disabled: true})
+	require.ErrorIs(t, err, ErrChannelAdminRulesOff)
+}
+
+func TestSetChannelPolicy_ChannelAdmin(t *testing.T) {
+	svc, m := setupService(t, types.Policy{ChannelAdminRules: true})
+	m.user.EXPECT().HasPermissionTo("u1", model.PermissionManageSystem).Return(false).Times(2)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c1", model.PermissionManageChannelRoles).Return(true)
+	m.user.EXPECT().HasPermissionToChannel("u1", "c2", model.PermissionManageChannelRoles).Return(false)
+	m.rules.EXPECT().DeleteChannelPolicy("c1").Return(nil)
+
+	require.NoError(t, svc.SetChannelPolicy("u1", &types.ChannelPolicy{ChannelID: "c1"}))
+	err := svc.SetChannelPolicy("u1", &types.ChannelPolicy{ChannelID: "c2", Disabled: true})
+	require.ErrorIs(t, err, ErrNotChannelAdmin)
+}
+
+func TestSetChannelPolicy_NegativeCap(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	m.user.EXPECT().HasPermissionTo("admin", model.PermissionManageSystem).Return(true)
+	require.Error(t, svc.SetChannelPolicy("admin", &types.ChannelPolicy{ChannelID: "c1", MaxPending: -1}))
+}
+
+func TestCheckSchedule_RuleStoreError(t *testing.T) {
+	svc, m := setupService(t, types.Policy{})
+	m.rules.EXPECT().GetChannelPolicy("c1").Return(nil, errors.New("kv down"))
+	err := svc.CheckSchedule("c1")
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, types.ErrPolicyDenied)
+}
@@ -0,0 +1,136 @@
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// maxQuietHoursSteps bounds the search for the next allowed time, so a rule
+// that is quiet around the clock cannot loop forever.
+const maxQuietHoursSteps = 400
+
+// ErrAlwaysQuiet is returned by NextAllowed when no allowed time exists within
+// a reasonable search window.
+var ErrAlwaysQuiet = errors.New("quiet hours leave no time to send messages")
+
+// QuietHours returns the quiet hours for channelID: the channel's own rule,
+// else its team's, else nil. The System Console holidays are attached to the
+// returned copy.
+func (s *Service) QuietHours(channelID string) (*types.QuietHours, error) {
+	policy := s.current()
+	if len(policy.QuietHours) == 0 {
+		return nil, nil
+	}
+	rule, ok := policy.QuietHours[channelID]
+	if !ok {
+		channel, err := s.channels.Get(channelID)
+		if err != nil {
+			s.logger.Error("Failed to get channel for quiet hours", "channel_id", channelID, "error", err)
+			return nil, fmt.Errorf("failed to check quiet hours: %w", err)
+		}
+		if rule, ok = policy.QuietHours[channel.TeamId]; !ok || channel.TeamId == "" {
+			return nil, nil
+		}
+	}
+	rule.Holidays = policy.Holidays
+	s.logger.Debug("Quiet hours apply to channel", "channel_id", channelID, "start", rule.Start, "end", rule.End)
+	return &rule, nil
+}
+
+// ParseQuietHours parses one System Console line of the form
+// "<team or channel ID> [HH:MM-HH:MM] [weekends] [shift|reject] [tz:Area/City]".
+func ParseQuietHours(line string) (string, types.QuietHours, error) {
+	fields := strings.Fields(line)
+	rule := types.QuietHours{Action: types.QuietHoursReject}
+	if len(fields) < 2 {
+		return "", rule, fmt.Errorf("quiet hours %q need an ID followed by hours or \"weekends\"", line)
+	}
+	for _, field := range fields[1:] {
+		lower := strings.ToLower(field)
+		switch {
+		case lower == constants.QuietHoursWeekends:
+			rule.Weekends = true
+		case lower == string(types.QuietHoursShift), lower == string(types.QuietHoursReject):
+			rule.Action = types.QuietHoursAction(lower)
+		case strings.HasPrefix(lower, constants.QuietHoursTimezonePrefix):
+			rule.Timezone = field[len(constants.QuietHoursTimezonePrefix):]
+			if _, err := time.LoadLocation(rule.Timezone); err != nil {
+				return "", rule, fmt.Errorf("quiet hours timezone %q is not a valid IANA timezone", rule.Timezone)
+			}
+		case strings.Contains(field, "-"):
+			start, end, _ := strings.Cut(field, "-")
+			if _, err := time.Parse(constants.QuietHoursClockLayout, start); err != nil {
+				return "", rule, fmt.Errorf("quiet hours start %q must look like 22:00", start)
+			}
+			if _, err := time.Parse(constants.QuietHoursClockLayout, end); err != nil {
+				return "", rule, fmt.Errorf("quiet hours end %q must look like 07:00", end)
+			}
+			rule.Start, rule.End = start, end
+		default:
+			return "", rule, fmt.Errorf("unknown quiet hours option %q", field)
+		}
+	}
+	if rule.Start == "" && !rule.Weekends {
+		return "", rule, fmt.Errorf("quiet hours %q need hours like 22:00-07:00 or \"weekends\"", line)
+	}
+	return fields[0], rule, nil
+}
+
+// NextAllowed returns t if it is outside the quiet hours, or the first time
+// after t that is. Rules without a timezone are read in defaultLoc.
+func NextAllowed(rule *types.QuietHours, t time.Time, defaultLoc *time.Location) (time.Time, error) {
+	loc := defaultLoc
+	if rule.Timezone != "" {
+		if ruleLoc, err := time.LoadLocation(rule.Timezone); err == nil {
+			loc = ruleLoc
+		}
+	}
+	start, hasWindow := clockMinutes(rule.Start)
+	end, _ := clockMinutes(rule.End)
+	hasWindow = hasWindow && start != end
+
+	local := t.In(loc)
+	for i := 0; i < maxQuietHoursSteps; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+		if quietDay(rule, local) {
+			local = day.AddDate(0, 0, 1)
+			continue
+		}
+		if !hasWindow {
+			return local, nil
+		}
+		minute := local.Hour()*60 + local.Minute()
+		switch {
+		case start < end && minute >= start && minute < end:
+			local = day.Add(time.Duration(end) * time.Minute)
+		case start > end && minute < end:
+			local = day.Add(time.Duration(end) * time.Minute)
+		case start > end && minute >= start:
+			local = day.AddDate(0, 0, 1).Add(time.Duration(end) * time.Minute)
+		default:
+			return local, nil
+		}
+	}
+	return time.Time{}, ErrAlwaysQuiet
+}
+
+func quietDay(rule *types.QuietHours, t time.Time) bool {
+	if rule.Weekends && (t.Weekday() == time.Saturday || t.Weekday() == time.Sunday) {
+		return true
+	}
+	return slices.Contains(rule.Holidays, t.Format(constants.DateParseLayoutYYYYMMDD))
+}
+
+func clockMinutes(clock string) (int, bool) {
+	parsed, err := time.Parse(constants.QuietHoursClockLayout, clock)
+	if err != nil {
+		return 0, false
+	}
+	return parsed.Hour()*60 + parsed.Minute(), true
+}
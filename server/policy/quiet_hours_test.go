@@ -0,0 +1,103 @@
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestParseQuietHours(t *testing.T) {
+	id, rule, err := ParseQuietHours("team1 22:00-07:00 weekends shift tz:Europe/Berlin")
+	require.NoError(t, err)
+	assert.Equal(t, "team1", id)
+	assert.Equal(t, types.QuietHours{Start: "22:00", End: "07:00", Weekends: true, Action: types.QuietHoursShift, Timezone: "Europe/Berlin"}, rule)
+
+	_, rule, err = ParseQuietHours("chan1 weekends")
+	require.NoError(t, err)
+	assert.Equal(t, types.QuietHoursReject, rule.Action)
+
+	for _, bad := range []string{"team1", "team1 shift", "team1 25:00-07:00", "team1 22:00-7am", "team1 22:00-07:00 tz:Mars/Olympus", "team1 22:00-07:00 loudly"} {
+		_, _, err := ParseQuietHours(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestNextAllowed(t *testing.T) {
+	utc := time.UTC
+	// 2025-01-03 is a Friday.
+	at := func(day, hour, minute int) time.Time { return time.Date(2025, 1, day, hour, minute, 0, 0, utc) }
+	overnight := &types.QuietHours{Start: "22:00", End: "07:00"}
+	daytime := &types.QuietHours{Start: "12:00", End: "13:30"}
+
+	tests := []struct {
+		name string
+		rule *types.QuietHours
+		in   time.Time
+		want time.Time
+	}{
+		{"outside overnight window", overnight, at(3, 9, 0), at(3, 9, 0)},
+		{"after midnight", overnight, at(3, 3, 0), at(3, 7, 0)},
+		{"before midnight", overnight, at(3, 23, 15), at(4, 7, 0)},
+		{"end is allowed", overnight, at(3, 7, 0), at(3, 7, 0)},
+		{"inside daytime window", daytime, at(3, 12, 45), at(3, 13, 30)},
+		{"weekend skipped to Monday morning", &types.QuietHours{Start: "22:00", End: "07:00", Weekends: true}, at(3, 23, 0), at(6, 7, 0)},
+		{"holiday skipped", &types.QuietHours{Weekends: true, Holidays: []string{"2025-01-06"}}, at(4, 10, 0), at(7, 0, 0)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NextAllowed(tc.rule, tc.in, utc)
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got), "got %v, want %v", got, tc.want)
+		})
+	}
+}
+
+func TestNextAllowed_RuleTimezone(t *testing.T) {
+	berlin := testutil.MustLoadLocation(t, "Europe/Berlin")
+	rule := &types.QuietHours{Start: "22:00", End: "07:00", Timezone: "Europe/Berlin"}
+
+	// 05:00 UTC is 06:00 in Berlin in winter.
+	got, err := NextAllowed(rule, time.Date(2025, 1, 3, 5, 0, 0, 0, time.UTC), time.UTC)
+	require.NoError(t, err)
+	assert.True(t, time.Date(2025, 1, 3, 7, 0, 0, 0, berlin).Equal(got))
+}
+
+func TestNextAllowed_AlwaysQuiet(t *testing.T) {
+	days := make([]string, 0, 500)
+	for d := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC); len(days) < 500; d = d.AddDate(0, 0, 1) {
+		days = append(days, d.Format("2006-01-02"))
+	}
+	_, err := NextAllowed(&types.QuietHours{Holidays: days}, time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC), time.UTC)
+	require.ErrorIs(t, err, ErrAlwaysQuiet)
+}
+
+func TestServiceQuietHours_ChannelOverridesTeam(t *testing.T) {
+	svc, m := setupService(t, types.Policy{
+		QuietHours: map[string]types.QuietHours{
+			"team1": {Start: "22:00", End: "07:00"},
+			"chan1": {Weekends: true},
+		},
+		Holidays: []string{"2025-12-25"},
+	})
+
+	rule, err := svc.QuietHours("chan1")
+	require.NoError(t, err)
+	assert.True(t, rule.Weekends)
+	assert.Equal(t, []string{"2025-12-25"}, rule.Holidays)
+
+	m.channels.EXPECT().Get("chan2").Return(&model.Channel{Id: "chan2", TeamId: "team1"}, nil)
+	rule, err = svc.QuietHours("chan2")
+	require.NoError(t, err)
+	assert.Equal(t, "22:00", rule.Start)
+
+	m.channels.EXPECT().Get("dm").Return(&model.Channel{Id: "dm"}, nil)
+	rule, err = svc.QuietHours("dm")
+	require.NoError(t, err)
+	assert.Nil(t, rule)
+}
@@ -0,0 +1,243 @@
+package preferences
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service edits a user's preferences. Both the JSON API and the settings
+// dialog go through Update, so they are validated the same way.
+type Service struct {
+	logger ports.Logger
+	store  ports.PreferenceStore
+}
+
+func New(logger ports.Logger, store ports.PreferenceStore) *Service {
+	logger.Debug("Creating new preferences Service")
+	return &Service{
+		logger: logger,
+		store:  store,
+	}
+}
+
+// Get returns the user's preferences.
+func (s *Service) Get(userID string) (*types.UserPreferences, error) {
+	s.logger.Debug("Getting user preferences", "user_id", userID)
+	return s.store.GetPreferences(userID)
+}
+
+// Update validates prefs and saves them in place of the user's current
+// preferences.
+func (s *Service) Update(userID string, prefs *types.UserPreferences) (*types.UserPreferences, error) {
+	s.logger.Debug("Updating user preferences", "user_id", userID)
+	if err := normalize(prefs); err != nil {
+		s.logger.Debug("Rejected invalid preferences", "user_id", userID, "error", err)
+		return nil, err
+	}
+	if err := s.store.SavePreferences(userID, prefs); err != nil {
+		return nil, fmt.Errorf("failed to save preferences: %w", err)
+	}
+	s.logger.Info("User changed preferences", "user_id", userID)
+	return prefs, nil
+}
+
+// UpdateFromDialog saves the preferences submitted from the settings dialog.
+func (s *Service) UpdateFromDialog(userID string, submission map[string]any) (*types.UserPreferences, error) {
+	prefs, err := fromSubmission(submission)
+	if err != nil {
+		return nil, err
+	}
+	return s.Update(userID, prefs)
+}
+
+// Dialog builds the settings dialog, filled in with the user's current
+// preferences.
+func (s *Service) Dialog(userID string) (*model.Dialog, error) {
+	prefs, err := s.store.GetPreferences(userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get preferences: %w", err)
+	}
+	clock := constants.PreferenceClock12Hour
+	if prefs.Use24Hour {
+		clock = constants.PreferenceClock24Hour
+	}
+	digest := constants.SettingsOff
+	if prefs.Digest != types.DigestOff {
+		digest = string(prefs.Digest)
+	}
+	return &model.Dialog{
+		Title:       constants.PreferencesDialogTitle,
+		SubmitLabel: constants.PreferencesDialogSubmit,
+		Elements: []model.DialogElement{
+			{
+				DisplayName: "Default time",
+				Name:        constants.PreferenceDefaultTime,
+				Type:        "text",
+				Optional:    true,
+				Default:     prefs.DefaultTime,
+				Placeholder: constants.DefaultScheduleTime,
+				HelpText:    "Used when you schedule with `on <date>` but no `at <time>`.",
+			},
+			{
+				DisplayName: "Clock",
+				Name:        constants.PreferenceClock,
+				Type:        "radio",
+				Default:     clock,
+				Options: []*model.PostActionOptions{
+					{Text: "12-hour (3:04 PM)", Value: constants.PreferenceClock12Hour},
+					{Text: "24-hour (15:04)", Value: constants.PreferenceClock24Hour},
+				},
+			},
+			{
+				DisplayName: "Timezone",
+				Name:        constants.PreferenceTimezone,
+				Type:        "text",
+				Optional:    true,
+				Default:     prefs.Timezone,
+				Placeholder: "From your profile",
+				HelpText:    "A timezone name like Europe/Berlin, to use instead of the one in your profile.",
+			},
+			{
+				DisplayName: "List page size",
+				Name:        constants.PreferenceListPageSize,
+				Type:        "text",
+				SubType:     "number",
+				Optional:    true,
+				Default:     optionalNumber(prefs.ListPageSize),
+				Placeholder: strconv.Itoa(constants.DefaultQueryLimit),
+			},
+			{
+				DisplayName: "Reminder (minutes before)",
+				Name:        constants.PreferenceReminder,
+				Type:        "text",
+				SubType:     "number",
+				Optional:    true,
+				Default:     optionalNumber(prefs.ReminderMinutes),
+				Placeholder: "No reminder",
+			},
+			{
+				DisplayName: "Delivery confirmations",
+				Name:        constants.PreferenceConfirmations,
+				Type:        "bool",
+				Optional:    true,
+				Default:     strconv.FormatBool(prefs.ConfirmDelivery),
+				Placeholder: "DM me a link once each message is posted",
+			},
+			{
+				DisplayName: "Digest",
+				Name:        constants.PreferenceDigest,
+				Type:        "select",
+				Default:     digest,
+				Options: []*model.PostActionOptions{
+					{Text: "Off", Value: constants.SettingsOff},
+					{Text: "Daily", Value: string(types.DigestDaily)},
+					{Text: "Weekly", Value: string(types.DigestWeekly)},
+				},
+			},
+		},
+	}, nil
+}
+
+// normalize checks prefs and rewrites the default time as "15:04".
+func normalize(prefs *types.UserPreferences) error {
+	if prefs.DefaultTime != "" {
+		parsed, ok := parseClock(prefs.DefaultTime)
+		if !ok {
+			return &types.PreferenceError{Field: constants.PreferenceDefaultTime, Message: constants.ErrPreferenceDefaultTime}
+		}
+		prefs.DefaultTime = parsed.Format(constants.PreferencesClockLayout)
+	}
+	if prefs.Timezone != "" {
+		if _, err := time.LoadLocation(prefs.Timezone); err != nil {
+			return &types.PreferenceError{Field: constants.PreferenceTimezone, Message: fmt.Sprintf(constants.ErrPreferenceTimezone, prefs.Timezone)}
+		}
+	}
+	if prefs.ListPageSize < 0 || prefs.ListPageSize > constants.MaxQueryLimit {
+		return &types.PreferenceError{Field: constants.PreferenceListPageSize, Message: fmt.Sprintf(constants.ErrPreferencePageSize, constants.MaxQueryLimit)}
+	}
+	if prefs.ReminderMinutes < 0 || prefs.ReminderMinutes > constants.MaxReminderMinutes {
+		return &types.PreferenceError{Field: constants.PreferenceReminder, Message: fmt.Sprintf(constants.ErrReminderInvalid, constants.MaxReminderMinutes)}
+	}
+	switch prefs.Digest {
+	case types.DigestOff, types.DigestDaily, types.DigestWeekly:
+	default:
+		return &types.PreferenceError{Field: constants.PreferenceDigest, Message: constants.ErrPreferenceDigest}
+	}
+	return nil
+}
+
+func parseClock(value string) (time.Time, bool) {
+	value = strings.ReplaceAll(strings.TrimSpace(value), " ", "")
+	for _, layout := range constants.TimeParseLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// fromSubmission reads the settings dialog's fields. Text fields arrive as
+// strings, though number fields may arrive as numbers.
+func fromSubmission(submission map[string]any) (*types.UserPreferences, error) {
+	prefs := &types.UserPreferences{
+		DefaultTime: submittedString(submission, constants.PreferenceDefaultTime),
+		Use24Hour:   submittedString(submission, constants.PreferenceClock) == constants.PreferenceClock24Hour,
+		Timezone:    submittedString(submission, constants.PreferenceTimezone),
+	}
+	var err error
+	if prefs.ListPageSize, err = submittedNumber(submission, constants.PreferenceListPageSize); err != nil {
+		return nil, &types.PreferenceError{Field: constants.PreferenceListPageSize, Message: fmt.Sprintf(constants.ErrPreferencePageSize, constants.MaxQueryLimit)}
+	}
+	if prefs.ReminderMinutes, err = submittedNumber(submission, constants.PreferenceReminder); err != nil {
+		return nil, &types.PreferenceError{Field: constants.PreferenceReminder, Message: fmt.Sprintf(constants.ErrReminderInvalid, constants.MaxReminderMinutes)}
+	}
+	switch confirm := submission[constants.PreferenceConfirmations].(type) {
+	case bool:
+		prefs.ConfirmDelivery = confirm
+	case string:
+		prefs.ConfirmDelivery = confirm == "true"
+	}
+	if digest := submittedString(submission, constants.PreferenceDigest); digest != constants.SettingsOff {
+		prefs.Digest = types.DigestFrequency(digest)
+	}
+	return prefs, nil
+}
+
+func submittedString(submission map[string]any, name string) string {
+	value, _ := submission[name].(string)
+	return strings.TrimSpace(value)
+}
+
+func submittedNumber(submission map[string]any, name string) (int, error) {
+	switch value := submission[name].(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		if value != float64(int(value)) {
+			return 0, fmt.Errorf("%v is not a whole number", value)
+		}
+		return int(value), nil
+	case string:
+		if value = strings.TrimSpace(value); value == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(value)
+	default:
+		return 0, fmt.Errorf("unexpected value %v", value)
+	}
+}
+
+func optionalNumber(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
@@ -0,0 +1,106 @@
+package preferences
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestUpdate_NormalizesAndReplaces(t *testing.T) {
+	store := &testutil.FakePreferences{Prefs: map[string]*types.UserPreferences{"u1": {ReminderMinutes: 10}}}
+	svc := New(testutil.FakeLogger{}, store)
+
+	saved, err := svc.Update("u1", &types.UserPreferences{DefaultTime: "5:30pm", Timezone: "Europe/Berlin", ListPageSize: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, "17:30", saved.DefaultTime)
+	assert.Zero(t, store.Prefs["u1"].ReminderMinutes)
+	assert.Equal(t, "Europe/Berlin", store.Prefs["u1"].Timezone)
+}
+
+func TestUpdate_RejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		prefs *types.UserPreferences
+		field string
+	}{
+		{"default time", &types.UserPreferences{DefaultTime: "noon"}, constants.PreferenceDefaultTime},
+		{"timezone", &types.UserPreferences{Timezone: "Mars/Olympus"}, constants.PreferenceTimezone},
+		{"page size", &types.UserPreferences{ListPageSize: constants.MaxQueryLimit + 1}, constants.PreferenceListPageSize},
+		{"reminder", &types.UserPreferences{ReminderMinutes: -1}, constants.PreferenceReminder},
+		{"digest", &types.UserPreferences{Digest: "hourly"}, constants.PreferenceDigest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &testutil.FakePreferences{}
+			svc := New(testutil.FakeLogger{}, store)
+
+			_, err := svc.Update("u1", tc.prefs)
+
+			var prefErr *types.PreferenceError
+			require.True(t, errors.As(err, &prefErr))
+			assert.Equal(t, tc.field, prefErr.Field)
+			assert.Empty(t, store.Prefs)
+		})
+	}
+}
+
+func TestUpdateFromDialog(t *testing.T) {
+	store := &testutil.FakePreferences{}
+	svc := New(testutil.FakeLogger{}, store)
+
+	saved, err := svc.UpdateFromDialog("u1", map[string]any{
+		constants.PreferenceDefaultTime:   "08:00",
+		constants.PreferenceClock:         constants.PreferenceClock24Hour,
+		constants.PreferenceTimezone:      " Asia/Seoul ",
+		constants.PreferenceListPageSize:  float64(15),
+		constants.PreferenceReminder:      "30",
+		constants.PreferenceConfirmations: true,
+		constants.PreferenceDigest:        "weekly",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &types.UserPreferences{
+		DefaultTime:     "08:00",
+		Use24Hour:       true,
+		Timezone:        "Asia/Seoul",
+		ListPageSize:    15,
+		ReminderMinutes: 30,
+		ConfirmDelivery: true,
+		Digest:          types.DigestWeekly,
+	}, saved)
+}
+
+func TestUpdateFromDialog_InvalidNumber(t *testing.T) {
+	svc := New(testutil.FakeLogger{}, &testutil.FakePreferences{})
+
+	_, err := svc.UpdateFromDialog("u1", map[string]any{constants.PreferenceListPageSize: "lots"})
+
+	var prefErr *types.PreferenceError
+	require.True(t, errors.As(err, &prefErr))
+	assert.Equal(t, constants.PreferenceListPageSize, prefErr.Field)
+}
+
+func TestDialog_FilledWithCurrentPreferences(t *testing.T) {
+	store := &testutil.FakePreferences{Prefs: map[string]*types.UserPreferences{"u1": {Use24Hour: true, ListPageSize: 5, Digest: types.DigestDaily}}}
+	svc := New(testutil.FakeLogger{}, store)
+
+	dialog, err := svc.Dialog("u1")
+
+	require.NoError(t, err)
+	defaults := map[string]string{}
+	for _, element := range dialog.Elements {
+		defaults[element.Name] = element.Default
+	}
+	assert.Equal(t, constants.PreferenceClock24Hour, defaults[constants.PreferenceClock])
+	assert.Equal(t, "5", defaults[constants.PreferenceListPageSize])
+	assert.Equal(t, "", defaults[constants.PreferenceReminder])
+	assert.Equal(t, "daily", defaults[constants.PreferenceDigest])
+	assert.Equal(t, "false", defaults[constants.PreferenceConfirmations])
+}
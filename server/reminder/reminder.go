@@ -0,0 +1,110 @@
+package reminder
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/model"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Service carries out what the owner picks from a pre-send reminder: send
+// the message now or cancel it. Postponing goes through the schedule
+// service, so it gets the same checks as scheduling. The reminders themselves
+// are sent by the scheduler, which already looks at every pending message
+// each minute.
+type Service struct {
+	logger ports.Logger
+	store  ports.Store
+	sender ports.MessageSender
+	events ports.EventNotifier
+}
+
+func New(logger ports.Logger, store ports.Store, sender ports.MessageSender, events ports.EventNotifier) *Service {
+	logger.Debug("Creating new reminder Service")
+	return &Service{logger: logger, store: store, sender: sender, events: events}
+}
+
+func (s *Service) SendNow(userID, msgID string) (*types.ScheduledMessage, error) {
+	if _, err := s.ownedMessage(userID, msgID); err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Owner asked to send message now", "user_id", userID, "message_id", msgID)
+	return s.sender.SendNow(msgID)
+}
+
+func (s *Service) Cancel(userID, msgID string) (*types.ScheduledMessage, error) {
+	msg, err := s.ownedMessage(userID, msgID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteScheduledMessage(userID, msgID); err != nil {
+		return nil, fmt.Errorf("failed to cancel message: %w", err)
+	}
+	s.logger.Info("Cancelled scheduled message from reminder", "user_id", userID, "message_id", msgID)
+	s.events.Notify(types.NewLifecycleEvent(types.EventCancelled, msg, userID))
+	return msg, nil
+}
+
+// ownedMessage loads msgID, reporting someone else's message as not found.
+func (s *Service) ownedMessage(userID, msgID string) (*types.ScheduledMessage, error) {
+	msg, err := s.store.GetScheduledMessage(msgID)
+	if err != nil {
+		return nil, err
+	}
+	if msg.UserID != userID {
+		s.logger.Warn("User tried to act on someone else's reminder", "user_id", userID, "message_id", msgID, "owner_user_id", msg.UserID)
+		return nil, types.ErrMessageNotFound
+	}
+	return msg, nil
+}
+
+// NewPost builds the reminder DM for msg, with buttons to send it now,
+// postpone it or cancel it. Times are shown in layout.
+func NewPost(msg *types.ScheduledMessage, layout, channelLink string) *model.Post {
+	loc, err := time.LoadLocation(msg.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	action := func(name string) *model.PostActionIntegration {
+		return &model.PostActionIntegration{
+			URL:     constants.ReminderActionURL,
+			Context: map[string]any{"action": name, "id": msg.ID},
+		}
+	}
+	options := make([]*model.PostActionOptions, 0, len(constants.ReminderPostponeOptions))
+	for _, option := range constants.ReminderPostponeOptions {
+		options = append(options, &model.PostActionOptions{Text: option.Text, Value: option.Value})
+	}
+	post := &model.Post{Message: formatter.FormatReminderNotice(msg.PostAt.In(loc), layout, loc.String(), channelLink, msg.MessageContent)}
+	model.ParseSlackAttachment(post, []*model.SlackAttachment{{
+		Actions: []*model.PostAction{
+			{
+				Id:          constants.ReminderActionSend,
+				Name:        "Send now",
+				Type:        model.PostActionTypeButton,
+				Style:       "primary",
+				Integration: action(constants.ReminderActionSend),
+			},
+			{
+				Id:          constants.ReminderActionPostpone,
+				Name:        "Postpone by...",
+				Type:        model.PostActionTypeSelect,
+				Options:     options,
+				Integration: action(constants.ReminderActionPostpone),
+			},
+			{
+				Id:          constants.ReminderActionCancel,
+				Name:        "Cancel message",
+				Type:        model.PostActionTypeButton,
+				Style:       "danger",
+				Integration: action(constants.ReminderActionCancel),
+			},
+		},
+	}})
+	return post
+}
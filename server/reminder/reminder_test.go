@@ -0,0 +1,79 @@
+package reminder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	store  *mock.MockStore
+	sender *mock.MockMessageSender
+	events *testutil.FakeNotifier
+}
+
+func setupService(t *testing.T) (*Service, *mocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		store:  mock.NewMockStore(ctrl),
+		sender: mock.NewMockMessageSender(ctrl),
+		events: &testutil.FakeNotifier{},
+	}
+	return New(testutil.FakeLogger{}, m.store, m.sender, m.events), m
+}
+
+func testMessage() *types.ScheduledMessage {
+	reminded := testNow.Add(-5 * time.Minute)
+	return &types.ScheduledMessage{ID: "m1", UserID: "u1", ChannelID: "c1", PostAt: testNow.Add(10 * time.Minute), ReminderMinutes: 15, RemindedAt: &reminded}
+}
+
+func TestSendNow(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+	m.sender.EXPECT().SendNow("m1").Return(testMessage(), nil)
+
+	msg, err := svc.SendNow("u1", "m1")
+	require.NoError(t, err)
+	assert.Equal(t, "m1", msg.ID)
+}
+
+func TestSendNow_NotOwner(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+
+	_, err := svc.SendNow("u2", "m1")
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+}
+
+func TestCancel(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(nil)
+
+	_, err := svc.Cancel("u1", "m1")
+	require.NoError(t, err)
+	events := m.events.Events()
+	require.Len(t, events, 1)
+	assert.Equal(t, types.EventCancelled, events[0].Type)
+}
+
+func TestCancel_DeleteError(t *testing.T) {
+	svc, m := setupService(t)
+	m.store.EXPECT().GetScheduledMessage("m1").Return(testMessage(), nil)
+	m.store.EXPECT().DeleteScheduledMessage("u1", "m1").Return(errors.New("kv down"))
+
+	_, err := svc.Cancel("u1", "m1")
+	require.Error(t, err)
+	assert.Empty(t, m.events.Events())
+}
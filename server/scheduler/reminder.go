@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	"errors"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/reminder"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// remindIfDue DMs the owner of a pending message once its reminder time has
+// come. The message is marked as reminded before the DM is sent, so a failed
+// save never leads to a reminder every minute.
+func (s *Scheduler) remindIfDue(msg *types.ScheduledMessage, now time.Time) {
+	remindAt, ok := msg.ReminderAt()
+	if !ok || msg.RemindedAt != nil || remindAt.After(now) {
+		return
+	}
+	// The mark is a compare-and-set on the stored message, so one the owner
+	// cancelled, sent or postponed since the listing is not written back.
+	current, err := s.store.MarkReminded(msg.ID, now)
+	if err != nil {
+		if errors.Is(err, types.ErrMessageNotFound) || errors.Is(err, types.ErrMessageChanged) {
+			s.logger.Debug("Skipping reminder for message that changed since the listing", "message_id", msg.ID, "reason", err)
+			return
+		}
+		s.logger.Error("Failed to mark message as reminded", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+		s.recordError(msg.ID, err)
+		return
+	}
+	s.logger.Debug("Sending reminder for pending message", "message_id", current.ID, "user_id", current.UserID, "post_at", current.PostAt)
+	channelLink := s.linker.MakeChannelLink(s.linker.GetInfoOrUnknown(current.ChannelID))
+	if err := s.poster.DM(s.botID, current.UserID, reminder.NewPost(current, s.timeLayout(current.UserID), channelLink)); err != nil {
+		s.logger.Error("Failed to send reminder DM", "message_id", current.ID, "user_id", current.UserID, "error", err)
+	}
+}
+
+// SendNow posts a pending message straight away instead of waiting for its
+// PostAt. It returns ErrDeliveryPaused while an admin has paused delivery.
+func (s *Scheduler) SendNow(msgID string) (*types.ScheduledMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pause.DeliveryPaused() {
+		return nil, types.ErrDeliveryPaused
+	}
+	msg, err := s.store.GetScheduledMessage(msgID)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Sending message ahead of schedule", "message_id", msg.ID, "user_id", msg.UserID, "post_at", msg.PostAt)
+	if err := s.handleDueMessage(msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// timeLayout is the layout the user wants times shown in, falling back to the
+// 12-hour clock when their preferences cannot be read.
+func (s *Scheduler) timeLayout(userID string) string {
+	prefs, err := s.prefs.GetPreferences(userID)
+	if err != nil {
+		s.logger.Warn("Failed to get user preferences, using the 12-hour clock", "user_id", userID, "error", err)
+		return constants.TimeLayout
+	}
+	return formatter.TimeLayout(prefs.Use24Hour)
+}
@@ -0,0 +1,146 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestProcessDueMessages_SendsReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPoster := mock.NewMockPostService(ctrl)
+	mockStore := mock.NewMockStore(ctrl)
+	mockChannel := mock.NewMockChannelService(ctrl)
+	clk := testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)}
+	prefs := &testutil.FakePreferences{Prefs: map[string]*types.UserPreferences{"user": {Use24Hour: true}}}
+	s := New(testutil.FakeLogger{}, mockPoster, mockStore, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, prefs, nil)
+
+	due := &types.ScheduledMessage{ID: "soon", UserID: "user", ChannelID: "chan", PostAt: clk.NowTime.Add(10 * time.Minute), ReminderMinutes: 15, Timezone: "UTC"}
+	later := &types.ScheduledMessage{ID: "later", UserID: "user", PostAt: clk.NowTime.Add(time.Hour), ReminderMinutes: 15}
+	reminded := clk.NowTime.Add(-time.Minute)
+	done := &types.ScheduledMessage{ID: "done", UserID: "user", PostAt: clk.NowTime.Add(5 * time.Minute), ReminderMinutes: 15, RemindedAt: &reminded}
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{due, later, done}, nil)
+	stored := *due
+	stored.RemindedAt = &clk.NowTime
+	mockStore.EXPECT().MarkReminded("soon", clk.NowTime).Return(&stored, nil)
+	info := &ports.ChannelInfo{ChannelID: "chan"}
+	mockChannel.EXPECT().GetInfoOrUnknown("chan").Return(info)
+	mockChannel.EXPECT().MakeChannelLink(info).Return("in channel: ~chan")
+	mockPoster.EXPECT().DM("bot", "user", gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		assert.Contains(t, post.Message, "Reminder")
+		assert.Contains(t, post.Message, "Jan 1, 2025 09:10")
+		actions := post.Attachments()[0].Actions
+		require.Len(t, actions, 3)
+		assert.Equal(t, constants.ReminderActionURL, actions[0].Integration.URL)
+		assert.Equal(t, "soon", actions[0].Integration.Context["id"])
+		return nil
+	})
+
+	s.processDueMessages()
+}
+
+func TestProcessDueMessages_PausedRemindsOnlyUpcomingMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPoster := mock.NewMockPostService(ctrl)
+	mockStore := mock.NewMockStore(ctrl)
+	mockChannel := mock.NewMockChannelService(ctrl)
+	clk := testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)}
+	pause := &testutil.FakePause{Current: &types.PauseState{PausedBy: "admin", PausedAt: clk.NowTime.Add(-time.Hour)}}
+	s := New(testutil.FakeLogger{}, mockPoster, mockStore, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, pause, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	held := &types.ScheduledMessage{ID: "held", UserID: "user", ChannelID: "chan", PostAt: clk.NowTime.Add(-10 * time.Minute), ReminderMinutes: 15}
+	soon := &types.ScheduledMessage{ID: "soon", UserID: "user", ChannelID: "chan", PostAt: clk.NowTime.Add(10 * time.Minute), ReminderMinutes: 15, Timezone: "UTC"}
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{held, soon}, nil)
+	stored := *soon
+	stored.RemindedAt = &clk.NowTime
+	mockStore.EXPECT().MarkReminded("soon", clk.NowTime).Return(&stored, nil)
+	mockChannel.EXPECT().GetInfoOrUnknown("chan").Return(&ports.ChannelInfo{ChannelID: "chan"})
+	mockChannel.EXPECT().MakeChannelLink(gomock.Any()).Return("in channel: ~chan")
+	mockPoster.EXPECT().DM("bot", "user", gomock.Any()).Return(nil)
+
+	s.processDueMessages()
+}
+
+func TestProcessDueMessages_ReminderForCancelledMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mock.NewMockStore(ctrl)
+	clk := testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)}
+	s := New(testutil.FakeLogger{}, nil, mockStore, nil, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	due := &types.ScheduledMessage{ID: "soon", UserID: "user", PostAt: clk.NowTime.Add(10 * time.Minute), ReminderMinutes: 15}
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{due}, nil)
+	mockStore.EXPECT().MarkReminded("soon", clk.NowTime).Return(nil, types.ErrMessageNotFound)
+
+	s.processDueMessages()
+
+	assert.Empty(t, s.Health().RecentErrors)
+}
+
+func TestProcessDueMessages_ReminderForChangedMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mock.NewMockStore(ctrl)
+	clk := testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)}
+	s := New(testutil.FakeLogger{}, nil, mockStore, nil, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	due := &types.ScheduledMessage{ID: "soon", UserID: "user", PostAt: clk.NowTime.Add(10 * time.Minute), ReminderMinutes: 15}
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{due}, nil)
+	mockStore.EXPECT().MarkReminded("soon", clk.NowTime).Return(nil, types.ErrMessageChanged)
+
+	s.processDueMessages()
+
+	assert.Empty(t, s.Health().RecentErrors)
+}
+
+func TestProcessDueMessages_ReminderMarkFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStore := mock.NewMockStore(ctrl)
+	clk := testutil.FakeClock{NowTime: time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)}
+	s := New(testutil.FakeLogger{}, nil, mockStore, nil, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	due := &types.ScheduledMessage{ID: "soon", UserID: "user", PostAt: clk.NowTime.Add(10 * time.Minute), ReminderMinutes: 15}
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{due}, nil)
+	mockStore.EXPECT().MarkReminded("soon", clk.NowTime).Return(nil, errors.New("kv down"))
+
+	s.processDueMessages()
+
+	assert.Len(t, s.Health().RecentErrors, 1)
+}
+
+func TestSendNow(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockPoster := mock.NewMockPostService(ctrl)
+	mockStore := mock.NewMockStore(ctrl)
+	events := &testutil.FakeNotifier{}
+	s := New(testutil.FakeLogger{}, mockPoster, mockStore, nil, "bot", testutil.FakeClock{NowTime: time.Now()}, events, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "user", ChannelID: "chan", PostAt: time.Now().Add(time.Hour), MessageContent: "hi"}
+	mockStore.EXPECT().GetScheduledMessage("m1").Return(msg, nil)
+	mockStore.EXPECT().DeleteScheduledMessage("user", "m1").Return(nil)
+	mockPoster.EXPECT().CreatePost(gomock.Any()).Return(nil)
+
+	sent, err := s.SendNow("m1")
+	require.NoError(t, err)
+	assert.Equal(t, "m1", sent.ID)
+	got := events.Events()
+	require.Len(t, got, 1)
+	assert.Equal(t, types.EventSent, got[0].Type)
+}
+
+func TestSendNow_Paused(t *testing.T) {
+	pause := &testutil.FakePause{Current: &types.PauseState{PausedBy: "admin"}}
+	s := New(testutil.FakeLogger{}, nil, nil, nil, "bot", testutil.FakeClock{NowTime: time.Now()}, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, pause, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	_, err := s.SendNow("m1")
+	assert.ErrorIs(t, err, types.ErrDeliveryPaused)
+}
@@ -3,39 +3,80 @@ package scheduler
 import (
 	"context"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mattermost/mattermost/server/public/model"
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/failure"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
 
 type Scheduler struct {
-	logger ports.Logger
-	poster ports.PostService
-	store  ports.Store
-	linker ports.ChannelService
-	botID  string
-	clock  ports.Clock
-	ctx    context.Context
-	cancel context.CancelFunc
-	mu     sync.Mutex
+	logger  ports.Logger
+	poster  ports.PostService
+	store   ports.Store
+	linker  ports.ChannelService
+	botID   string
+	clock   ports.Clock
+	events  ports.EventNotifier
+	policy  ports.PolicyService
+	metrics ports.Metrics
+	pause   ports.PauseService
+	digests ports.DigestService
+	failed  ports.FailedMessageStore
+	prefs   ports.PreferenceStore
+	bot     ports.BotDeliveryService
+	ctx     context.Context
+	cancel  context.CancelFunc
+	mu      sync.Mutex
+
+	// running and the fields below are reported by Health.
+	running          atomic.Bool
+	healthMu         sync.Mutex
+	lastTickAt       time.Time
+	lastTickDuration time.Duration
+	recentErrors     []types.SchedulerError
 }
 
-func New(logger ports.Logger, poster ports.PostService, store ports.Store, linker ports.ChannelService, botID string, clk ports.Clock) *Scheduler {
+func New(
+	logger ports.Logger,
+	poster ports.PostService,
+	store ports.Store,
+	linker ports.ChannelService,
+	botID string,
+	clk ports.Clock,
+	events ports.EventNotifier,
+	policy ports.PolicyService,
+	metrics ports.Metrics,
+	pause ports.PauseService,
+	digests ports.DigestService,
+	failed ports.FailedMessageStore,
+	prefs ports.PreferenceStore,
+	bot ports.BotDeliveryService,
+) *Scheduler {
 	logger.Debug("Creating new scheduler instance")
 	ctx, cancel := context.WithCancel(context.Background())
 	return &Scheduler{
-		logger: logger,
-		poster: poster,
-		store:  store,
-		linker: linker,
-		botID:  botID,
-		clock:  clk,
-		ctx:    ctx,
-		cancel: cancel,
+		logger:  logger,
+		poster:  poster,
+		store:   store,
+		linker:  linker,
+		botID:   botID,
+		clock:   clk,
+		events:  events,
+		policy:  policy,
+		metrics: metrics,
+		pause:   pause,
+		digests: digests,
+		failed:  failed,
+		prefs:   prefs,
+		bot:     bot,
+		ctx:     ctx,
+		cancel:  cancel,
 	}
 }
 
@@ -50,9 +91,30 @@ func (s *Scheduler) Stop() {
 	s.logger.Info("Scheduler stopped")
 }
 
+// Health reports whether the run loop is alive, when it last ticked and the
+// most recent delivery errors, newest first.
+func (s *Scheduler) Health() types.SchedulerHealth {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+	errs := make([]types.SchedulerError, len(s.recentErrors))
+	for i, e := range s.recentErrors {
+		errs[len(errs)-1-i] = e
+	}
+	return types.SchedulerHealth{
+		Running:            s.running.Load(),
+		LastTickAt:         s.lastTickAt,
+		LastTickDurationMS: s.lastTickDuration.Milliseconds(),
+		RecentErrors:       errs,
+	}
+}
+
 func (s *Scheduler) run() {
 	s.logger.Debug("Scheduler run loop started")
-	defer s.logger.Info("Scheduler run loop exited")
+	s.running.Store(true)
+	defer func() {
+		s.running.Store(false)
+		s.logger.Info("Scheduler run loop exited")
+	}()
 
 	for {
 		now := s.clock.Now()
@@ -97,9 +159,13 @@ func (s *Scheduler) processDueMessages() {
 	nowUnix := now.Unix()
 	s.logger.Debug("Current time for due check", "time_utc", now, "time_unix", nowUnix)
 
+	// Checked first: resuming a pause can delete overdue messages.
+	paused := s.pause.DeliveryPaused()
 	messages, err := s.getAllScheduledMessages()
 	if err != nil {
 		s.logger.Error("Failed to list scheduled messages", "error", err)
+		s.recordError("", err)
+		s.recordTick(now)
 		return
 	}
 	s.logger.Debug("Retrieved scheduled messages", "count", len(messages))
@@ -107,16 +173,58 @@ func (s *Scheduler) processDueMessages() {
 	processedCount := 0
 	skippedCount := 0
 	for _, msg := range messages {
-		if msg.PostAt.Unix() > nowUnix {
+		if paused || msg.PostAt.Unix() > nowUnix {
 			// s.logger.Debug("Skipping message, not due yet", "message_id", msg.ID, "post_at_unix", msg.PostAt.Unix(), "now_unix", nowUnix)
+			// A message held back by a pause is overdue, so a reminder would
+			// promise a post that is not coming and offer a Send now that
+			// would be refused.
+			if msg.PostAt.Unix() > nowUnix {
+				s.remindIfDue(msg, now)
+			}
 			skippedCount++
 			continue
 		}
 		s.logger.Debug("Message is due, processing", "message_id", msg.ID, "post_at_unix", msg.PostAt.Unix(), "now_unix", nowUnix)
-		s.handleDueMessage(msg)
+		_ = s.handleDueMessage(msg)
 		processedCount++
 	}
-	s.logger.Debug("Finished processing potential messages", "processed", processedCount, "skipped_not_due", skippedCount, "total_candidates", len(messages))
+	s.logger.Debug("Finished processing potential messages", "processed", processedCount, "skipped", skippedCount, "paused", paused, "total_candidates", len(messages))
+	s.digests.SendDue(messageOwners(messages), now)
+	s.metrics.ObserveTick(s.recordTick(now), skippedCount)
+}
+
+// recordTick stores when the pass that started at start finished and returns
+// how long it took.
+func (s *Scheduler) recordTick(start time.Time) time.Duration {
+	duration := s.clock.Now().Sub(start)
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+	s.lastTickAt = start
+	s.lastTickDuration = duration
+	return duration
+}
+
+func (s *Scheduler) recordError(msgID string, err error) {
+	s.healthMu.Lock()
+	defer s.healthMu.Unlock()
+	s.recentErrors = append(s.recentErrors, types.SchedulerError{At: s.clock.Now().UTC(), MessageID: msgID, Error: err.Error()})
+	if len(s.recentErrors) > constants.SchedulerRecentErrors {
+		s.recentErrors = s.recentErrors[len(s.recentErrors)-constants.SchedulerRecentErrors:]
+	}
+}
+
+// messageOwners returns the distinct owners of messages, in the order they
+// first appear.
+func messageOwners(messages []*types.ScheduledMessage) []string {
+	seen := make(map[string]bool, len(messages))
+	owners := []string{}
+	for _, msg := range messages {
+		if !seen[msg.UserID] {
+			seen[msg.UserID] = true
+			owners = append(owners, msg.UserID)
+		}
+	}
+	return owners
 }
 
 func (s *Scheduler) getAllScheduledMessages() ([]*types.ScheduledMessage, error) {
@@ -128,18 +236,36 @@ func (s *Scheduler) getAllScheduledMessages() ([]*types.ScheduledMessage, error)
 	return messages, err
 }
 
-func (s *Scheduler) handleDueMessage(msg *types.ScheduledMessage) {
+// handleDueMessage posts msg and returns why it could not be posted, if it
+// could not. The owner has already been told about a failure by DM.
+func (s *Scheduler) handleDueMessage(msg *types.ScheduledMessage) error {
 	s.logger.Debug("Handling due message", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID)
-	if s.deleteSchedule(msg) != nil {
+	if err := s.deleteSchedule(msg); err != nil {
 		s.logger.Error("Halting processing for message due to delete failure", "message_id", msg.ID)
-		return
+		s.recordError(msg.ID, err)
+		return err
+	}
+	var post *model.Post
+	err := s.policy.CheckSend(msg)
+	if err != nil {
+		s.logger.Warn("Scheduling policy blocked due message", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID, "error", err)
+	} else {
+		post, err = s.postMessage(msg)
 	}
-	if err := s.postMessage(msg); err != nil {
+	if err != nil {
 		s.logger.Warn("Message posting failed, attempting to DM user", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+		s.recordError(msg.ID, err)
+		event := types.NewLifecycleEvent(types.EventFailed, msg, s.botID)
+		event.Error = err.Error()
+		s.events.Notify(event)
 		s.dmUserOnFailedMessage(msg, err)
-	} else {
-		s.logger.Info("Successfully posted scheduled message", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID, "post_at", msg.PostAt)
+		return err
 	}
+	s.logger.Info("Successfully posted scheduled message", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID, "post_at", msg.PostAt)
+	event := types.NewLifecycleEvent(types.EventSent, msg, s.botID)
+	event.PostID = post.Id
+	s.events.Notify(event)
+	return nil
 }
 
 func (s *Scheduler) deleteSchedule(msg *types.ScheduledMessage) error {
@@ -152,7 +278,7 @@ func (s *Scheduler) deleteSchedule(msg *types.ScheduledMessage) error {
 	return err
 }
 
-func (s *Scheduler) postMessage(msg *types.ScheduledMessage) error {
+func (s *Scheduler) postMessage(msg *types.ScheduledMessage) (*model.Post, error) {
 	s.logger.Debug("Attempting to post scheduled message", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID)
 	post := &model.Post{
 		ChannelId: msg.ChannelID,
@@ -160,22 +286,37 @@ func (s *Scheduler) postMessage(msg *types.ScheduledMessage) error {
 		UserId:    msg.UserID,
 		FileIds:   msg.FileIDs,
 	}
+	msg.Metadata.ApplyTo(post)
+	if msg.AsBot {
+		if err := s.bot.Prepare(msg, post); err != nil {
+			s.logger.Error("Failed to prepare scheduled message to be posted as the bot", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID, "error", err)
+			return post, err
+		}
+	}
 	postErr := s.poster.CreatePost(post)
 	if postErr != nil {
 		s.logger.Error("Failed to post scheduled message via PostService", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID, "error", postErr)
 	}
 	s.logger.Debug("Successfully created post via PostService", "message_id", msg.ID, "user_id", msg.UserID, "channel_id", msg.ChannelID)
-	return postErr
+	return post, postErr
 }
 
+// dmUserOnFailedMessage keeps the failed message and DMs its owner why it
+// failed, with buttons to recover it. If the message cannot be kept, the DM
+// carries its full text instead so nothing is lost.
 func (s *Scheduler) dmUserOnFailedMessage(msg *types.ScheduledMessage, postErr error) {
 	s.logger.Debug("Attempting to DM user about failed message", "message_id", msg.ID, "user_id", msg.UserID, "original_channel_id", msg.ChannelID, "post_error", postErr)
 	channelInfo := s.linker.MakeChannelLink(s.linker.GetInfoOrUnknown(msg.ChannelID))
-	message := formatter.FormatSchedulerFailure(channelInfo, postErr, msg.MessageContent)
-	post := &model.Post{
-		Message: message,
-		FileIds: msg.FileIDs,
+	failed := failure.NewFailedMessage(msg, postErr, s.clock.Now().UTC())
+	post := failure.NewPost(failed, channelInfo)
+	if err := s.failed.SaveFailedMessage(failed); err != nil {
+		s.logger.Error("Failed to keep failed message, sending its text instead", "message_id", msg.ID, "user_id", msg.UserID, "error", err)
+		post = &model.Post{
+			Message: formatter.FormatSchedulerFailure(channelInfo, postErr, msg.MessageContent),
+			FileIds: msg.FileIDs,
+		}
 	}
+	s.logger.Debug("Classified failed message", "message_id", msg.ID, "reason", failed.Reason)
 	dmErr := s.poster.DM(s.botID, msg.UserID, post)
 	if dmErr != nil {
 		s.logger.Error("Failed to send DM alert to user about failed scheduled message", "message_id", msg.ID, "user_id", msg.UserID, "dm_error", dmErr, "original_post_error", postErr)
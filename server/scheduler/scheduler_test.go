@@ -2,18 +2,22 @@ package scheduler
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mm"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/formatter"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/store"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
 )
@@ -28,7 +32,9 @@ func TestProcessDueMessages_PostSuccess(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	events := &testutil.FakeNotifier{}
+	metrics := &testutil.FakeMetrics{}
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, events, &testutil.FakePolicy{}, metrics, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	now := clk.Now()
 	msg := &types.ScheduledMessage{
@@ -51,9 +57,16 @@ func TestProcessDueMessages_PostSuccess(t *testing.T) {
 		ChannelId: msg.ChannelID,
 		Message:   msg.MessageContent,
 		UserId:    msg.UserID,
-	})).Return(nil)
+	})).Do(func(post *model.Post) { post.Id = "post-1" }).Return(nil)
 
 	s.processDueMessages()
+
+	got := events.Events()
+	require.Len(t, got, 1)
+	assert.Equal(t, types.EventSent, got[0].Type)
+	assert.Equal(t, msg.ID, got[0].MessageID)
+	assert.Equal(t, "post-1", got[0].PostID)
+	assert.Equal(t, []int{0}, metrics.Pending)
 }
 
 func TestProcessDueMessages_PostFailure(t *testing.T) {
@@ -66,7 +79,8 @@ func TestProcessDueMessages_PostFailure(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	events := &testutil.FakeNotifier{}
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, events, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	now := clk.Now()
 	msg := &types.ScheduledMessage{
@@ -93,6 +107,17 @@ func TestProcessDueMessages_PostFailure(t *testing.T) {
 	mockPoster.EXPECT().DM("bot", msg.UserID, gomock.Any()).Return(nil)
 
 	s.processDueMessages()
+
+	got := events.Events()
+	require.Len(t, got, 1)
+	assert.Equal(t, types.EventFailed, got[0].Type)
+	assert.Equal(t, postErr.Error(), got[0].Error)
+
+	health := s.Health()
+	require.Len(t, health.RecentErrors, 1)
+	assert.Equal(t, msg.ID, health.RecentErrors[0].MessageID)
+	assert.Equal(t, postErr.Error(), health.RecentErrors[0].Error)
+	assert.Equal(t, now, health.LastTickAt)
 }
 
 func TestProcessDueMessages_NotDueYet(t *testing.T) {
@@ -105,7 +130,7 @@ func TestProcessDueMessages_NotDueYet(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	now := clk.Now()
 	msg := &types.ScheduledMessage{
@@ -134,11 +159,16 @@ func TestProcessDueMessages_ListError(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	mockKV.EXPECT().ListKeys(0, constants.MaxFetchScheduledMessages, gomock.Any()).Return(nil, errors.New("boom"))
 
 	s.processDueMessages()
+
+	health := s.Health()
+	require.Len(t, health.RecentErrors, 1)
+	assert.Empty(t, health.RecentErrors[0].MessageID)
+	assert.Equal(t, clk.NowTime, health.LastTickAt)
 }
 
 func TestScheduler_StartAndStop(t *testing.T) {
@@ -151,7 +181,7 @@ func TestScheduler_StartAndStop(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Date(2023, 1, 1, 10, 30, 59, 950*1000*1000, time.UTC)}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	now := clk.Now()
 	msg := &types.ScheduledMessage{
@@ -180,8 +210,23 @@ func TestScheduler_StartAndStop(t *testing.T) {
 	}()
 
 	time.Sleep(100 * time.Millisecond)
+	assert.True(t, s.Health().Running)
 	s.Stop()
 	wg.Wait()
+	assert.False(t, s.Health().Running)
+}
+
+func TestHealth_KeepsNewestErrors(t *testing.T) {
+	s := New(testutil.FakeLogger{}, nil, nil, nil, "bot", testutil.FakeClock{NowTime: time.Now()}, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+	for i := 0; i < constants.SchedulerRecentErrors+5; i++ {
+		s.recordError(fmt.Sprint(i), errors.New("boom"))
+	}
+
+	health := s.Health()
+	require.Len(t, health.RecentErrors, constants.SchedulerRecentErrors)
+	assert.Equal(t, fmt.Sprint(constants.SchedulerRecentErrors+4), health.RecentErrors[0].MessageID)
+	assert.Equal(t, "5", health.RecentErrors[len(health.RecentErrors)-1].MessageID)
+	assert.False(t, health.Running)
 }
 
 func TestProcessDueMessages_LoadMessageError(t *testing.T) {
@@ -194,7 +239,7 @@ func TestProcessDueMessages_LoadMessageError(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	msgID := "uuid-5"
 	msgKey := testutil.SchedKey(msgID)
@@ -220,7 +265,7 @@ func TestProcessDueMessages_DeleteScheduleError(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	now := clk.Now()
 	msg := &types.ScheduledMessage{
@@ -246,7 +291,7 @@ func TestProcessDueMessages_DMError(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	now := clk.Now()
 	msg := &types.ScheduledMessage{
@@ -282,9 +327,155 @@ func TestProcessDueMessages_EmptyIDMap(t *testing.T) {
 
 	st := store.NewKVStore(testutil.FakeLogger{}, mockKV, mm.ListMatchingService{}, constants.MaxUserMessages)
 	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
-	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk)
+	s := New(testutil.FakeLogger{}, mockPoster, st, mockChannel, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
 
 	mockKV.EXPECT().ListKeys(0, constants.MaxFetchScheduledMessages, gomock.Any()).Return([]string{}, nil)
 
 	s.processDueMessages()
 }
+
+func TestHandleDueMessage_PolicyBlocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPoster := mock.NewMockPostService(ctrl)
+	mockStore := mock.NewMockStore(ctrl)
+	mockChannel := mock.NewMockChannelService(ctrl)
+	events := &testutil.FakeNotifier{}
+	denied := &types.PolicyDeniedError{Reason: constants.ErrPolicyChannelNotAllowed}
+	failed := &testutil.FakeFailedMessages{}
+	s := New(testutil.FakeLogger{}, mockPoster, mockStore, mockChannel, "bot", testutil.FakeClock{NowTime: time.Now()}, events, &testutil.FakePolicy{SendErr: denied}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, failed, &testutil.FakePreferences{}, nil)
+
+	msg := &types.ScheduledMessage{ID: "uuid-3", UserID: "user", ChannelID: "chan", MessageContent: "hi"}
+	channelInfo := &ports.ChannelInfo{ChannelID: msg.ChannelID}
+	mockStore.EXPECT().DeleteScheduledMessage(msg.UserID, msg.ID).Return(nil)
+	mockChannel.EXPECT().GetInfoOrUnknown(msg.ChannelID).Return(channelInfo)
+	mockChannel.EXPECT().MakeChannelLink(channelInfo).Return("in channel: ~chan")
+	mockPoster.EXPECT().DM("bot", msg.UserID, gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		assert.Contains(t, post.Message, "you are not allowed to post there")
+		assert.Contains(t, post.Message, constants.ErrPolicyChannelNotAllowed)
+		require.Len(t, post.Attachments(), 1)
+		assert.Len(t, post.Attachments()[0].Actions, 4)
+		return nil
+	})
+
+	s.handleDueMessage(msg)
+
+	got := events.Events()
+	require.Len(t, got, 1)
+	assert.Equal(t, types.EventFailed, got[0].Type)
+	assert.Equal(t, constants.ErrPolicyChannelNotAllowed, got[0].Error)
+	require.Contains(t, failed.Messages, msg.ID)
+	assert.Equal(t, types.FailureNoPermission, failed.Messages[msg.ID].Reason)
+	assert.Equal(t, "hi", failed.Messages[msg.ID].Message.MessageContent)
+}
+
+func TestHandleDueMessage_FailedMessageNotKept(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPoster := mock.NewMockPostService(ctrl)
+	mockStore := mock.NewMockStore(ctrl)
+	mockChannel := mock.NewMockChannelService(ctrl)
+	failed := &testutil.FakeFailedMessages{SaveErr: errors.New("kv down")}
+	s := New(testutil.FakeLogger{}, mockPoster, mockStore, mockChannel, "bot", testutil.FakeClock{NowTime: time.Now()}, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, failed, &testutil.FakePreferences{}, nil)
+
+	msg := &types.ScheduledMessage{ID: "uuid-4", UserID: "user", ChannelID: "chan", MessageContent: "the whole message", Timezone: "UTC"}
+	channelInfo := &ports.ChannelInfo{ChannelID: msg.ChannelID}
+	mockStore.EXPECT().DeleteScheduledMessage(msg.UserID, msg.ID).Return(nil)
+	mockPoster.EXPECT().CreatePost(gomock.Any()).Return(errors.New("post fail"))
+	mockChannel.EXPECT().GetInfoOrUnknown(msg.ChannelID).Return(channelInfo)
+	mockChannel.EXPECT().MakeChannelLink(channelInfo).Return("in channel: ~chan")
+	mockPoster.EXPECT().DM("bot", msg.UserID, gomock.Any()).DoAndReturn(func(_, _ string, post *model.Post) error {
+		assert.Equal(t, formatter.FormatSchedulerFailure("in channel: ~chan", errors.New("post fail"), msg.MessageContent), post.Message)
+		assert.Empty(t, post.Attachments())
+		return nil
+	})
+
+	assert.Error(t, s.handleDueMessage(msg))
+}
+
+func TestProcessDueMessages_Paused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockStore(ctrl)
+	metrics := &testutil.FakeMetrics{}
+	pause := &testutil.FakePause{Current: &types.PauseState{PausedBy: "admin", PausedAt: time.Now()}}
+	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
+	s := New(testutil.FakeLogger{}, nil, mockStore, nil, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, metrics, pause, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	due := &types.ScheduledMessage{ID: "due", UserID: "user", PostAt: clk.Now().Add(-time.Minute)}
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{due}, nil)
+
+	s.processDueMessages()
+
+	assert.Equal(t, []int{1}, metrics.Pending)
+	assert.False(t, s.Health().LastTickAt.IsZero())
+}
+
+func TestProcessDueMessages_SendsDigestsToOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mock.NewMockStore(ctrl)
+	digests := &testutil.FakeDigests{}
+	pause := &testutil.FakePause{Current: &types.PauseState{PausedBy: "admin", PausedAt: time.Now()}}
+	clk := testutil.FakeClock{NowTime: time.Now().UTC()}
+	s := New(testutil.FakeLogger{}, nil, mockStore, nil, "bot", clk, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, pause, digests, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+
+	mockStore.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{
+		{ID: "m1", UserID: "u1", PostAt: clk.Now().Add(time.Hour)},
+		{ID: "m2", UserID: "u2", PostAt: clk.Now().Add(time.Hour)},
+		{ID: "m3", UserID: "u1", PostAt: clk.Now().Add(2 * time.Hour)},
+	}, nil)
+
+	s.processDueMessages()
+
+	assert.Equal(t, [][]string{{"u1", "u2"}}, digests.Calls)
+}
+
+func TestPostMessage_AsBot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPoster := mock.NewMockPostService(ctrl)
+	bot := mock.NewMockBotDeliveryService(ctrl)
+	s := New(testutil.FakeLogger{}, mockPoster, nil, nil, "bot", testutil.FakeClock{NowTime: time.Now()}, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, bot)
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "user", ChannelID: "chan", MessageContent: "hi", AsBot: true}
+
+	bot.EXPECT().Prepare(msg, gomock.Any()).Do(func(_ *types.ScheduledMessage, post *model.Post) {
+		post.UserId = "bot"
+	})
+	mockPoster.EXPECT().CreatePost(gomock.Any()).Do(func(post *model.Post) {
+		assert.Equal(t, "bot", post.UserId)
+	})
+
+	_, err := s.postMessage(msg)
+	require.NoError(t, err)
+
+	bot.EXPECT().Prepare(msg, gomock.Any()).Return(errors.New("the bot could not join the channel"))
+	_, err = s.postMessage(msg)
+	require.Error(t, err)
+}
+
+func TestPostMessage_AppliesMetadata(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPoster := mock.NewMockPostService(ctrl)
+	s := New(testutil.FakeLogger{}, mockPoster, nil, nil, "bot", testutil.FakeClock{NowTime: time.Now()}, &testutil.FakeNotifier{}, &testutil.FakePolicy{}, &testutil.FakeMetrics{}, &testutil.FakePause{}, &testutil.FakeDigests{}, &testutil.FakeFailedMessages{}, &testutil.FakePreferences{}, nil)
+	msg := &types.ScheduledMessage{ID: "m1", UserID: "user", ChannelID: "chan", MessageContent: "hi", Metadata: &types.PostMetadata{
+		Priority:    types.PriorityImportant,
+		Attachments: []*model.SlackAttachment{{Title: "Release"}},
+	}}
+
+	mockPoster.EXPECT().CreatePost(gomock.Any()).Do(func(post *model.Post) {
+		assert.Equal(t, "user", post.UserId)
+		assert.Equal(t, types.PriorityImportant, *post.GetPriority().Priority)
+		assert.Len(t, post.Attachments(), 1)
+	})
+
+	_, err := s.postMessage(msg)
+	require.NoError(t, err)
+}
@@ -0,0 +1,135 @@
+package store
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// kvAuditStore keeps one KV entry per audit record. Keys embed a zero-padded
+// timestamp so they sort chronologically and time filters and pruning can be
+// applied from the key alone.
+type kvAuditStore struct {
+	logger              ports.Logger
+	kv                  ports.KVService
+	listMatchingService ports.ListMatchingService
+}
+
+func NewAuditStore(logger ports.Logger, kv ports.KVService, listMatchingService ports.ListMatchingService) ports.AuditStore {
+	logger.Debug("Creating new AuditStore instance")
+	return &kvAuditStore{logger: logger, kv: kv, listMatchingService: listMatchingService}
+}
+
+func (s *kvAuditStore) SaveAuditEntry(entry *types.AuditEntry) error {
+	key := auditKey(entry.Timestamp, entry.ID)
+	s.logger.Debug("Saving audit entry", "key", key, "action", entry.Action, "message_id", entry.MessageID)
+	if _, err := s.kv.Set(key, entry); err != nil {
+		s.logger.Error("Failed to save audit entry", "key", key, "error", err)
+		return fmt.Errorf("kv.Set failed for audit key %s: %w", key, err)
+	}
+	return nil
+}
+
+// ListAuditEntries returns matching entries newest first, stopping once
+// query.Limit entries have been found. A zero limit returns every match.
+func (s *kvAuditStore) ListAuditEntries(query *types.AuditQuery) ([]*types.AuditEntry, error) {
+	keys, err := s.listKeys()
+	if err != nil {
+		return nil, err
+	}
+	sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+
+	entries := []*types.AuditEntry{}
+	for _, key := range keys {
+		ts, ok := auditKeyTime(key)
+		if !ok {
+			s.logger.Warn("Skipping malformed audit key", "key", key)
+			continue
+		}
+		if !query.To.IsZero() && !ts.Before(query.To) {
+			continue
+		}
+		if !query.From.IsZero() && ts.Before(query.From) {
+			break
+		}
+		var entry types.AuditEntry
+		if getErr := s.kv.Get(key, &entry); getErr != nil {
+			s.logger.Warn("Failed to get audit entry during list operation", "key", key, "error", getErr)
+			continue
+		}
+		if entry.ID == "" || !query.Matches(&entry) {
+			continue
+		}
+		entries = append(entries, &entry)
+		if query.Limit > 0 && len(entries) >= query.Limit {
+			break
+		}
+	}
+	s.logger.Debug("Listed audit entries", "total_keys", len(keys), "matched", len(entries))
+	return entries, nil
+}
+
+// DeleteAuditEntriesBefore removes every entry recorded before cutoff and
+// returns how many were deleted.
+func (s *kvAuditStore) DeleteAuditEntriesBefore(cutoff time.Time) (int, error) {
+	keys, err := s.listKeys()
+	if err != nil {
+		return 0, err
+	}
+	deleted := 0
+	for _, key := range keys {
+		ts, ok := auditKeyTime(key)
+		if !ok || !ts.Before(cutoff) {
+			continue
+		}
+		if delErr := s.kv.Delete(key); delErr != nil {
+			s.logger.Warn("Failed to delete expired audit entry", "key", key, "error", delErr)
+			continue
+		}
+		deleted++
+	}
+	s.logger.Debug("Deleted expired audit entries", "cutoff", cutoff, "deleted", deleted)
+	return deleted, nil
+}
+
+func (s *kvAuditStore) listKeys() ([]string, error) {
+	prefix := constants.AuditPrefix
+	var keys []string
+	for page := constants.DefaultPage; ; page++ {
+		pageKeys, err := s.kv.ListKeys(page, constants.KVKeysPerPage, s.listMatchingService.WithPrefix(prefix))
+		if err != nil {
+			s.logger.Error("Failed to list audit keys from KV store", "prefix", prefix, "page", page, "error", err)
+			return nil, fmt.Errorf("kv.ListKeys failed for prefix %s: %w", prefix, err)
+		}
+		keys = append(keys, pageKeys...)
+		if len(pageKeys) < constants.KVKeysPerPage {
+			return keys, nil
+		}
+	}
+}
+
+func auditKey(ts time.Time, id string) string {
+	return fmt.Sprintf("%s%020d:%s", constants.AuditPrefix, ts.UnixNano(), id)
+}
+
+func auditKeyTime(key string) (time.Time, bool) {
+	rest, ok := strings.CutPrefix(key, constants.AuditPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	nanos, _, ok := strings.Cut(rest, ":")
+	if !ok {
+		return time.Time{}, false
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(0, n).UTC(), true
+}
@@ -0,0 +1,130 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func auditFixture(id string, ts time.Time, action types.EventType, channelID string) *types.AuditEntry {
+	return &types.AuditEntry{ID: id, Timestamp: ts, Action: action, ChannelID: channelID, Outcome: types.AuditSuccess}
+}
+
+func TestAuditStore_SaveAuditEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewAuditStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	ts := time.Unix(0, 42)
+	entry := auditFixture("a1", ts, types.EventScheduled, "c1")
+	kvMock.EXPECT().Set(constants.AuditPrefix+"00000000000000000042:a1", entry).Return(true, nil)
+
+	require.NoError(t, st.SaveAuditEntry(entry))
+}
+
+func TestAuditStore_ListAuditEntries_FiltersNewestFirst(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	lm := &fakeListMatching{}
+	st := NewAuditStore(testutil.FakeLogger{}, kvMock, lm)
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	entries := []*types.AuditEntry{
+		auditFixture("a1", base, types.EventScheduled, "c1"),
+		auditFixture("a2", base.Add(time.Hour), types.EventSent, "c1"),
+		auditFixture("a3", base.Add(2*time.Hour), types.EventScheduled, "c2"),
+		auditFixture("a4", base.Add(3*time.Hour), types.EventScheduled, "c1"),
+	}
+	var keys []string
+	for _, e := range entries {
+		keys = append(keys, auditKey(e.Timestamp, e.ID))
+	}
+	kvMock.EXPECT().ListKeys(constants.DefaultPage, constants.KVKeysPerPage, gomock.Any()).Return(keys, nil)
+	// a4 is outside the range and never read; a1 is before From and ends the scan.
+	for _, e := range entries[1:3] {
+		kvMock.EXPECT().Get(auditKey(e.Timestamp, e.ID), gomock.Any()).SetArg(1, *e).Return(nil)
+	}
+
+	got, err := st.ListAuditEntries(&types.AuditQuery{
+		From:      base.Add(30 * time.Minute),
+		To:        base.Add(3 * time.Hour),
+		ChannelID: "c1",
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "a2", got[0].ID)
+	assert.Equal(t, constants.AuditPrefix, lm.prefixCalled)
+}
+
+func TestAuditStore_ListAuditEntries_Limit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewAuditStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	older := auditFixture("a1", base, types.EventScheduled, "c1")
+	newer := auditFixture("a2", base.Add(time.Minute), types.EventScheduled, "c1")
+	kvMock.EXPECT().ListKeys(constants.DefaultPage, constants.KVKeysPerPage, gomock.Any()).
+		Return([]string{auditKey(older.Timestamp, older.ID), auditKey(newer.Timestamp, newer.ID)}, nil)
+	kvMock.EXPECT().Get(auditKey(newer.Timestamp, newer.ID), gomock.Any()).SetArg(1, *newer).Return(nil)
+
+	got, err := st.ListAuditEntries(&types.AuditQuery{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "a2", got[0].ID)
+}
+
+func TestAuditStore_ListAuditEntries_Pages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewAuditStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	full := make([]string, constants.KVKeysPerPage)
+	for i := range full {
+		full[i] = "audit:bad"
+	}
+	gomock.InOrder(
+		kvMock.EXPECT().ListKeys(0, constants.KVKeysPerPage, gomock.Any()).Return(full, nil),
+		kvMock.EXPECT().ListKeys(1, constants.KVKeysPerPage, gomock.Any()).Return(nil, nil),
+	)
+
+	got, err := st.ListAuditEntries(&types.AuditQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestAuditStore_ListAuditEntries_ListKeysError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewAuditStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	kvMock.EXPECT().ListKeys(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, err := st.ListAuditEntries(&types.AuditQuery{})
+	require.Error(t, err)
+}
+
+func TestAuditStore_DeleteAuditEntriesBefore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewAuditStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldKey := auditKey(cutoff.Add(-time.Second), "old")
+	newKey := auditKey(cutoff, "new")
+	kvMock.EXPECT().ListKeys(gomock.Any(), gomock.Any(), gomock.Any()).Return([]string{oldKey, newKey}, nil)
+	kvMock.EXPECT().Delete(oldKey).Return(nil)
+
+	deleted, err := st.DeleteAuditEntriesBefore(cutoff)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
@@ -0,0 +1,58 @@
+package store
+
+import (
+	"fmt"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvChannelPolicyStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+}
+
+func NewChannelPolicyStore(logger ports.Logger, kv ports.KVService) ports.ChannelPolicyStore {
+	logger.Debug("Creating new ChannelPolicyStore instance")
+	return &kvChannelPolicyStore{logger: logger, kv: kv}
+}
+
+// GetChannelPolicy returns the channel's rule, or nil if none has been set.
+func (s *kvChannelPolicyStore) GetChannelPolicy(channelID string) (*types.ChannelPolicy, error) {
+	key := channelPolicyKey(channelID)
+	s.logger.Debug("Getting channel policy", "channel_id", channelID, "key", key)
+	var policy types.ChannelPolicy
+	if err := s.kv.Get(key, &policy); err != nil {
+		s.logger.Error("Failed to get channel policy from KV store", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for channel policy key %s: %w", key, err)
+	}
+	if policy.ChannelID == "" {
+		return nil, nil
+	}
+	return &policy, nil
+}
+
+func (s *kvChannelPolicyStore) SaveChannelPolicy(policy *types.ChannelPolicy) error {
+	key := channelPolicyKey(policy.ChannelID)
+	s.logger.Debug("Saving channel policy", "channel_id", policy.ChannelID, "disabled", policy.Disabled, "max_pending", policy.MaxPending)
+	if _, err := s.kv.Set(key, policy); err != nil {
+		s.logger.Error("Failed to save channel policy to KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Set failed for channel policy key %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *kvChannelPolicyStore) DeleteChannelPolicy(channelID string) error {
+	key := channelPolicyKey(channelID)
+	s.logger.Debug("Deleting channel policy", "channel_id", channelID, "key", key)
+	if err := s.kv.Delete(key); err != nil {
+		s.logger.Error("Failed to delete channel policy from KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Delete failed for channel policy key %s: %w", key, err)
+	}
+	return nil
+}
+
+func channelPolicyKey(channelID string) string {
+	return fmt.Sprintf("%s%s", constants.ChannelPolicyPrefix, channelID)
+}
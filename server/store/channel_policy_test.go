@@ -0,0 +1,65 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestChannelPolicyStore_GetChannelPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewChannelPolicyStore(testutil.FakeLogger{}, kvMock)
+
+	stored := types.ChannelPolicy{ChannelID: "c1", Disabled: true}
+	kvMock.EXPECT().Get(constants.ChannelPolicyPrefix+"c1", gomock.Any()).SetArg(1, stored).Return(nil)
+
+	policy, err := st.GetChannelPolicy("c1")
+	require.NoError(t, err)
+	require.NotNil(t, policy)
+	assert.True(t, policy.Disabled)
+}
+
+func TestChannelPolicyStore_GetChannelPolicy_NotSet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewChannelPolicyStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.ChannelPolicyPrefix+"c1", gomock.Any()).Return(nil)
+
+	policy, err := st.GetChannelPolicy("c1")
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+}
+
+func TestChannelPolicyStore_GetChannelPolicy_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewChannelPolicyStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.ChannelPolicyPrefix+"c1", gomock.Any()).Return(errors.New("boom"))
+
+	_, err := st.GetChannelPolicy("c1")
+	require.Error(t, err)
+}
+
+func TestChannelPolicyStore_SaveAndDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewChannelPolicyStore(testutil.FakeLogger{}, kvMock)
+
+	policy := &types.ChannelPolicy{ChannelID: "c1", MaxPending: 3}
+	kvMock.EXPECT().Set(constants.ChannelPolicyPrefix+"c1", policy).Return(true, nil)
+	kvMock.EXPECT().Delete(constants.ChannelPolicyPrefix + "c1").Return(nil)
+
+	require.NoError(t, st.SaveChannelPolicy(policy))
+	require.NoError(t, st.DeleteChannelPolicy("c1"))
+}
@@ -0,0 +1,65 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvFailedMessageStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+	ttl    time.Duration
+}
+
+// NewFailedMessageStore keeps messages that could not be posted for ttl, after
+// which the buttons on their failure DM stop working.
+func NewFailedMessageStore(logger ports.Logger, kv ports.KVService, ttl time.Duration) ports.FailedMessageStore {
+	logger.Debug("Creating new FailedMessageStore instance", "ttl", ttl)
+	return &kvFailedMessageStore{logger: logger, kv: kv, ttl: ttl}
+}
+
+func (s *kvFailedMessageStore) SaveFailedMessage(failed *types.FailedMessage) error {
+	key := failedKey(failed.Message.ID)
+	s.logger.Debug("Saving failed message", "user_id", failed.Message.UserID, "message_id", failed.Message.ID, "reason", failed.Reason)
+	if _, err := s.kv.Set(key, failed, pluginapi.SetExpiry(s.ttl)); err != nil {
+		s.logger.Error("Failed to save failed message to KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Set failed for failed message key %s: %w", key, err)
+	}
+	return nil
+}
+
+// GetFailedMessage returns ErrMessageNotFound once the message has been dealt
+// with or has expired.
+func (s *kvFailedMessageStore) GetFailedMessage(msgID string) (*types.FailedMessage, error) {
+	key := failedKey(msgID)
+	s.logger.Debug("Getting failed message", "message_id", msgID, "key", key)
+	var failed types.FailedMessage
+	if err := s.kv.Get(key, &failed); err != nil {
+		s.logger.Error("Failed to get failed message from KV store", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for failed message key %s: %w", key, err)
+	}
+	if failed.Message == nil {
+		return nil, types.ErrMessageNotFound
+	}
+	return &failed, nil
+}
+
+func (s *kvFailedMessageStore) DeleteFailedMessage(msgID string) error {
+	key := failedKey(msgID)
+	s.logger.Debug("Deleting failed message", "message_id", msgID, "key", key)
+	if err := s.kv.Delete(key); err != nil {
+		s.logger.Error("Failed to delete failed message from KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Delete failed for failed message key %s: %w", key, err)
+	}
+	return nil
+}
+
+func failedKey(msgID string) string {
+	return constants.FailedPrefix + msgID
+}
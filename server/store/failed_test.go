@@ -0,0 +1,62 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestFailedMessageStore_Save(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFailedMessageStore(testutil.FakeLogger{}, kvMock, time.Hour)
+	failed := &types.FailedMessage{Message: &types.ScheduledMessage{ID: "m1", UserID: "u1"}, Reason: types.FailureTooLong}
+
+	kvMock.EXPECT().Set(constants.FailedPrefix+"m1", failed, gomock.Any()).Return(true, nil)
+
+	require.NoError(t, st.SaveFailedMessage(failed))
+}
+
+func TestFailedMessageStore_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFailedMessageStore(testutil.FakeLogger{}, kvMock, time.Hour)
+	stored := types.FailedMessage{Message: &types.ScheduledMessage{ID: "m1", UserID: "u1"}, Reason: types.FailureTooLong}
+
+	kvMock.EXPECT().Get(constants.FailedPrefix+"m1", gomock.Any()).SetArg(1, stored).Return(nil)
+
+	failed, err := st.GetFailedMessage("m1")
+	require.NoError(t, err)
+	assert.Equal(t, "u1", failed.Message.UserID)
+	assert.Equal(t, types.FailureTooLong, failed.Reason)
+}
+
+func TestFailedMessageStore_Get_Missing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFailedMessageStore(testutil.FakeLogger{}, kvMock, time.Hour)
+
+	kvMock.EXPECT().Get(constants.FailedPrefix+"m1", gomock.Any()).Return(nil)
+
+	_, err := st.GetFailedMessage("m1")
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+}
+
+func TestFailedMessageStore_Delete_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFailedMessageStore(testutil.FakeLogger{}, kvMock, time.Hour)
+
+	kvMock.EXPECT().Delete(constants.FailedPrefix + "m1").Return(errors.New("boom"))
+
+	assert.Error(t, st.DeleteFailedMessage("m1"))
+}
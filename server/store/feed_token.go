@@ -0,0 +1,80 @@
+package store
+
+import (
+	"fmt"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+)
+
+type kvFeedTokenStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+}
+
+func NewFeedTokenStore(logger ports.Logger, kv ports.KVService) ports.FeedTokenStore {
+	logger.Debug("Creating new FeedTokenStore instance")
+	return &kvFeedTokenStore{logger: logger, kv: kv}
+}
+
+func (s *kvFeedTokenStore) GetFeedToken(userID string) (string, error) {
+	key := feedTokenKey(userID)
+	s.logger.Debug("Getting calendar feed token", "user_id", userID, "key", key)
+	var token string
+	if err := s.kv.Get(key, &token); err != nil {
+		s.logger.Error("Failed to get calendar feed token from KV store", "key", key, "error", err)
+		return "", fmt.Errorf("kv.Get failed for feed token key %s: %w", key, err)
+	}
+	return token, nil
+}
+
+func (s *kvFeedTokenStore) SaveFeedToken(userID string, token string) error {
+	s.logger.Debug("Attempting to save calendar feed token", "user_id", userID)
+	oldToken, err := s.GetFeedToken(userID)
+	if err != nil {
+		return err
+	}
+
+	ownerKey := feedOwnerKey(token)
+	s.logger.Debug("Calling KV Set for feed owner", "user_id", userID)
+	if _, err := s.kv.Set(ownerKey, userID); err != nil {
+		s.logger.Error("Failed to set calendar feed owner in KV store", "user_id", userID, "error", err)
+		return fmt.Errorf("kv.Set failed for feed owner: %w", err)
+	}
+
+	tokenKey := feedTokenKey(userID)
+	s.logger.Debug("Calling KV Set for feed token", "key", tokenKey)
+	if _, err := s.kv.Set(tokenKey, token); err != nil {
+		s.logger.Error("Failed to set calendar feed token in KV store", "key", tokenKey, "error", err)
+		return fmt.Errorf("kv.Set failed for feed token key %s: %w", tokenKey, err)
+	}
+
+	if oldToken != "" && oldToken != token {
+		s.logger.Debug("Deleting previous calendar feed owner mapping", "user_id", userID)
+		if err := s.kv.Delete(feedOwnerKey(oldToken)); err != nil {
+			s.logger.Error("Failed to delete previous calendar feed owner", "user_id", userID, "error", err)
+			return fmt.Errorf("kv.Delete failed for previous feed owner: %w", err)
+		}
+	}
+	s.logger.Info("Successfully saved calendar feed token", "user_id", userID)
+	return nil
+}
+
+func (s *kvFeedTokenStore) GetFeedTokenOwner(token string) (string, error) {
+	s.logger.Debug("Looking up calendar feed token owner")
+	var userID string
+	if err := s.kv.Get(feedOwnerKey(token), &userID); err != nil {
+		s.logger.Error("Failed to get calendar feed owner from KV store", "error", err)
+		return "", fmt.Errorf("kv.Get failed for feed owner: %w", err)
+	}
+	s.logger.Debug("Calendar feed owner lookup complete", "user_id", userID)
+	return userID, nil
+}
+
+func feedTokenKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.FeedTokenPrefix, userID)
+}
+
+func feedOwnerKey(token string) string {
+	return fmt.Sprintf("%s%s", constants.FeedOwnerPrefix, token)
+}
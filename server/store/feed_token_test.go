@@ -0,0 +1,98 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+)
+
+func TestFeedTokenStore_GetFeedToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFeedTokenStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.FeedTokenPrefix+"user", gomock.Any()).SetArg(1, "tok").Return(nil)
+
+	token, err := st.GetFeedToken("user")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", token)
+}
+
+func TestFeedTokenStore_GetFeedToken_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFeedTokenStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.FeedTokenPrefix+"user", gomock.Any()).Return(errors.New("boom"))
+
+	_, err := st.GetFeedToken("user")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestFeedTokenStore_SaveFeedToken_First(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFeedTokenStore(testutil.FakeLogger{}, kvMock)
+
+	gomock.InOrder(
+		kvMock.EXPECT().Get(constants.FeedTokenPrefix+"user", gomock.Any()).Return(nil),
+		kvMock.EXPECT().Set(constants.FeedOwnerPrefix+"new", "user").Return(true, nil),
+		kvMock.EXPECT().Set(constants.FeedTokenPrefix+"user", "new").Return(true, nil),
+	)
+
+	require.NoError(t, st.SaveFeedToken("user", "new"))
+}
+
+func TestFeedTokenStore_SaveFeedToken_RotatesOldOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFeedTokenStore(testutil.FakeLogger{}, kvMock)
+
+	gomock.InOrder(
+		kvMock.EXPECT().Get(constants.FeedTokenPrefix+"user", gomock.Any()).SetArg(1, "old").Return(nil),
+		kvMock.EXPECT().Set(constants.FeedOwnerPrefix+"new", "user").Return(true, nil),
+		kvMock.EXPECT().Set(constants.FeedTokenPrefix+"user", "new").Return(true, nil),
+		kvMock.EXPECT().Delete(constants.FeedOwnerPrefix+"old").Return(nil),
+	)
+
+	require.NoError(t, st.SaveFeedToken("user", "new"))
+}
+
+func TestFeedTokenStore_SaveFeedToken_SetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFeedTokenStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.FeedTokenPrefix+"user", gomock.Any()).Return(nil)
+	kvMock.EXPECT().Set(constants.FeedOwnerPrefix+"new", "user").Return(false, errors.New("set failed"))
+
+	err := st.SaveFeedToken("user", "new")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "set failed")
+}
+
+func TestFeedTokenStore_GetFeedTokenOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewFeedTokenStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.FeedOwnerPrefix+"tok", gomock.Any()).SetArg(1, "user").Return(nil)
+
+	owner, err := st.GetFeedTokenOwner("tok")
+	require.NoError(t, err)
+	assert.Equal(t, "user", owner)
+}
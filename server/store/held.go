@@ -0,0 +1,76 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvHeldMessageStore struct {
+	logger              ports.Logger
+	kv                  ports.KVService
+	listMatchingService ports.ListMatchingService
+}
+
+func NewHeldMessageStore(logger ports.Logger, kv ports.KVService, listMatchingService ports.ListMatchingService) ports.HeldMessageStore {
+	logger.Debug("Creating new HeldMessageStore instance")
+	return &kvHeldMessageStore{logger: logger, kv: kv, listMatchingService: listMatchingService}
+}
+
+func (s *kvHeldMessageStore) SaveHeldMessages(userID string, msgs []*types.ScheduledMessage) error {
+	key := heldKey(userID)
+	s.logger.Debug("Saving held messages", "user_id", userID, "count", len(msgs))
+	if _, err := s.kv.Set(key, msgs); err != nil {
+		s.logger.Error("Failed to save held messages to KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Set failed for held messages key %s: %w", key, err)
+	}
+	return nil
+}
+
+// GetHeldMessages returns the user's held messages, or nil if none are held.
+func (s *kvHeldMessageStore) GetHeldMessages(userID string) ([]*types.ScheduledMessage, error) {
+	key := heldKey(userID)
+	s.logger.Debug("Getting held messages", "user_id", userID, "key", key)
+	var msgs []*types.ScheduledMessage
+	if err := s.kv.Get(key, &msgs); err != nil {
+		s.logger.Error("Failed to get held messages from KV store", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for held messages key %s: %w", key, err)
+	}
+	return msgs, nil
+}
+
+func (s *kvHeldMessageStore) DeleteHeldMessages(userID string) error {
+	key := heldKey(userID)
+	s.logger.Debug("Deleting held messages", "user_id", userID, "key", key)
+	if err := s.kv.Delete(key); err != nil {
+		s.logger.Error("Failed to delete held messages from KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Delete failed for held messages key %s: %w", key, err)
+	}
+	return nil
+}
+
+// ListHeldUserIDs returns every user who has held messages.
+func (s *kvHeldMessageStore) ListHeldUserIDs() ([]string, error) {
+	var userIDs []string
+	for page := constants.DefaultPage; ; page++ {
+		keys, err := s.kv.ListKeys(page, constants.KVKeysPerPage, s.listMatchingService.WithPrefix(constants.HeldPrefix))
+		if err != nil {
+			s.logger.Error("Failed to list held message keys from KV store", "page", page, "error", err)
+			return nil, fmt.Errorf("kv.ListKeys failed for held messages: %w", err)
+		}
+		for _, key := range keys {
+			userIDs = append(userIDs, strings.TrimPrefix(key, constants.HeldPrefix))
+		}
+		if len(keys) < constants.KVKeysPerPage {
+			s.logger.Debug("Listed users with held messages", "count", len(userIDs))
+			return userIDs, nil
+		}
+	}
+}
+
+func heldKey(userID string) string {
+	return fmt.Sprintf("%s%s", constants.HeldPrefix, userID)
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestHeldMessageStore_SaveAndGet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHeldMessageStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	msgs := []*types.ScheduledMessage{{ID: "m1", UserID: "u1"}}
+	kvMock.EXPECT().Set(constants.HeldPrefix+"u1", msgs).Return(true, nil)
+	kvMock.EXPECT().Get(constants.HeldPrefix+"u1", gomock.Any()).SetArg(1, msgs).Return(nil)
+
+	require.NoError(t, st.SaveHeldMessages("u1", msgs))
+	got, err := st.GetHeldMessages("u1")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "m1", got[0].ID)
+}
+
+func TestHeldMessageStore_GetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHeldMessageStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+	kvMock.EXPECT().Get(constants.HeldPrefix+"u1", gomock.Any()).Return(errors.New("boom"))
+
+	_, err := st.GetHeldMessages("u1")
+	require.Error(t, err)
+}
+
+func TestHeldMessageStore_ListHeldUserIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHeldMessageStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+	kvMock.EXPECT().ListKeys(constants.DefaultPage, constants.KVKeysPerPage, gomock.Any()).Return([]string{constants.HeldPrefix + "u1", constants.HeldPrefix + "u2"}, nil)
+
+	ids, err := st.ListHeldUserIDs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u1", "u2"}, ids)
+}
+
+func TestHeldMessageStore_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHeldMessageStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+	kvMock.EXPECT().Delete(constants.HeldPrefix + "u1").Return(nil)
+
+	require.NoError(t, st.DeleteHeldMessages("u1"))
+}
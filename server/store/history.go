@@ -0,0 +1,72 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// kvHistoryStore keeps each user's delivery history as one newest-first list.
+type kvHistoryStore struct {
+	logger  ports.Logger
+	kv      ports.KVService
+	maxSize int
+	mu      sync.Mutex
+}
+
+func NewHistoryStore(logger ports.Logger, kv ports.KVService, maxSize int) ports.HistoryStore {
+	logger.Debug("Creating new HistoryStore instance")
+	return &kvHistoryStore{logger: logger, kv: kv, maxSize: maxSize}
+}
+
+// SaveHistoryEntry prepends the entry to the user's history, dropping entries
+// sent before cutoff and the oldest entries beyond maxSize. A zero cutoff keeps
+// entries regardless of age.
+func (s *kvHistoryStore) SaveHistoryEntry(userID string, entry *types.HistoryEntry, cutoff time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := historyKey(userID)
+	s.logger.Debug("Saving history entry", "user_id", userID, "message_id", entry.MessageID, "status", entry.Status)
+	existing, err := s.list(key)
+	if err != nil {
+		return err
+	}
+	entries := []*types.HistoryEntry{entry}
+	for _, e := range existing {
+		if len(entries) >= s.maxSize {
+			break
+		}
+		if cutoff.IsZero() || !e.SentAt.Before(cutoff) {
+			entries = append(entries, e)
+		}
+	}
+	if _, err := s.kv.Set(key, entries); err != nil {
+		s.logger.Error("Failed to save history", "key", key, "error", err)
+		return fmt.Errorf("kv.Set failed for key %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *kvHistoryStore) ListHistory(userID string) ([]*types.HistoryEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list(historyKey(userID))
+}
+
+func (s *kvHistoryStore) list(key string) ([]*types.HistoryEntry, error) {
+	var entries []*types.HistoryEntry
+	if err := s.kv.Get(key, &entries); err != nil {
+		s.logger.Error("Failed to get history", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for key %s: %w", key, err)
+	}
+	return entries, nil
+}
+
+func historyKey(userID string) string {
+	return constants.HistoryPrefix + userID
+}
@@ -0,0 +1,76 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestHistoryStore_SaveHistoryEntry_PrependsCapsAndExpires(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHistoryStore(testutil.FakeLogger{}, kvMock, 2)
+
+	cutoff := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	existing := []*types.HistoryEntry{
+		{MessageID: "b", SentAt: cutoff.Add(time.Hour)},
+		{MessageID: "a", SentAt: cutoff.Add(-time.Hour)},
+	}
+	kvMock.EXPECT().Get(constants.HistoryPrefix+"u1", gomock.Any()).SetArg(1, existing).Return(nil)
+	kvMock.EXPECT().Set(constants.HistoryPrefix+"u1", gomock.Any()).DoAndReturn(func(_ string, v any, _ ...any) (bool, error) {
+		saved := v.([]*types.HistoryEntry)
+		require.Len(t, saved, 2)
+		assert.Equal(t, "c", saved[0].MessageID)
+		assert.Equal(t, "b", saved[1].MessageID)
+		return true, nil
+	})
+
+	require.NoError(t, st.SaveHistoryEntry("u1", &types.HistoryEntry{MessageID: "c", SentAt: cutoff.Add(2 * time.Hour)}, cutoff))
+}
+
+func TestHistoryStore_SaveHistoryEntry_ZeroCutoffKeepsOld(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHistoryStore(testutil.FakeLogger{}, kvMock, 10)
+
+	existing := []*types.HistoryEntry{{MessageID: "a", SentAt: time.Unix(0, 0)}}
+	kvMock.EXPECT().Get(constants.HistoryPrefix+"u1", gomock.Any()).SetArg(1, existing).Return(nil)
+	kvMock.EXPECT().Set(constants.HistoryPrefix+"u1", gomock.Any()).DoAndReturn(func(_ string, v any, _ ...any) (bool, error) {
+		assert.Len(t, v.([]*types.HistoryEntry), 2)
+		return true, nil
+	})
+
+	require.NoError(t, st.SaveHistoryEntry("u1", &types.HistoryEntry{MessageID: "b"}, time.Time{}))
+}
+
+func TestHistoryStore_SaveHistoryEntry_GetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHistoryStore(testutil.FakeLogger{}, kvMock, 10)
+
+	kvMock.EXPECT().Get(constants.HistoryPrefix+"u1", gomock.Any()).Return(errors.New("boom"))
+
+	require.Error(t, st.SaveHistoryEntry("u1", &types.HistoryEntry{MessageID: "b"}, time.Time{}))
+}
+
+func TestHistoryStore_ListHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewHistoryStore(testutil.FakeLogger{}, kvMock, 10)
+
+	kvMock.EXPECT().Get(constants.HistoryPrefix+"u1", gomock.Any()).SetArg(1, []*types.HistoryEntry{{MessageID: "a"}}).Return(nil)
+
+	got, err := st.ListHistory("u1")
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "a", got[0].MessageID)
+}
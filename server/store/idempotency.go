@@ -0,0 +1,89 @@
+package store
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// reserveAttempts bounds how often Reserve retries when a record expires
+// between the failed claim and the follow-up read.
+const reserveAttempts = 3
+
+type kvIdempotencyStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+	ttl    time.Duration
+}
+
+func NewIdempotencyStore(logger ports.Logger, kv ports.KVService, ttl time.Duration) ports.IdempotencyStore {
+	logger.Debug("Creating new IdempotencyStore instance", "ttl", ttl)
+	return &kvIdempotencyStore{logger: logger, kv: kv, ttl: ttl}
+}
+
+// Reserve atomically claims key for userID by storing record. If the key was
+// already claimed it returns false and the record stored by the first request.
+func (s *kvIdempotencyStore) Reserve(userID string, key string, record *types.IdempotencyRecord) (bool, *types.IdempotencyRecord, error) {
+	kvKey := idempotencyKey(userID, key)
+	s.logger.Debug("Reserving idempotency key", "user_id", userID, "key", kvKey)
+	for attempt := 1; attempt <= reserveAttempts; attempt++ {
+		set, err := s.kv.Set(kvKey, record, pluginapi.SetAtomic(nil), pluginapi.SetExpiry(s.ttl))
+		if err != nil {
+			s.logger.Error("Failed to reserve idempotency key in KV store", "user_id", userID, "key", kvKey, "error", err)
+			return false, nil, fmt.Errorf("kv.Set failed for idempotency key %s: %w", kvKey, err)
+		}
+		if set {
+			s.logger.Debug("Idempotency key reserved", "user_id", userID, "key", kvKey)
+			return true, nil, nil
+		}
+
+		var existing *types.IdempotencyRecord
+		if err := s.kv.Get(kvKey, &existing); err != nil {
+			s.logger.Error("Failed to get idempotency record from KV store", "user_id", userID, "key", kvKey, "error", err)
+			return false, nil, fmt.Errorf("kv.Get failed for idempotency key %s: %w", kvKey, err)
+		}
+		if existing != nil {
+			s.logger.Debug("Idempotency key already reserved", "user_id", userID, "key", kvKey, "completed", existing.Message != nil)
+			return false, existing, nil
+		}
+		s.logger.Debug("Idempotency record expired before it could be read, retrying", "user_id", userID, "key", kvKey, "attempt", attempt)
+	}
+	return false, nil, errors.New("failed to reserve idempotency key")
+}
+
+// Complete stores the final record for a reserved key and restarts its expiry.
+func (s *kvIdempotencyStore) Complete(userID string, key string, record *types.IdempotencyRecord) error {
+	kvKey := idempotencyKey(userID, key)
+	s.logger.Debug("Completing idempotency record", "user_id", userID, "key", kvKey)
+	if _, err := s.kv.Set(kvKey, record, pluginapi.SetExpiry(s.ttl)); err != nil {
+		s.logger.Error("Failed to save idempotency record in KV store", "user_id", userID, "key", kvKey, "error", err)
+		return fmt.Errorf("kv.Set failed for idempotency key %s: %w", kvKey, err)
+	}
+	return nil
+}
+
+// Release forgets a reserved key so the request can be retried with it.
+func (s *kvIdempotencyStore) Release(userID string, key string) error {
+	kvKey := idempotencyKey(userID, key)
+	s.logger.Debug("Releasing idempotency key", "user_id", userID, "key", kvKey)
+	if err := s.kv.Delete(kvKey); err != nil {
+		s.logger.Error("Failed to delete idempotency key from KV store", "user_id", userID, "key", kvKey, "error", err)
+		return fmt.Errorf("kv.Delete failed for idempotency key %s: %w", kvKey, err)
+	}
+	return nil
+}
+
+// idempotencyKey hashes the client-supplied key so arbitrary keys fit within
+// the KV store's key length limit.
+func idempotencyKey(userID string, key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return fmt.Sprintf("%s%s:%s", constants.IdempotencyPrefix, userID, hex.EncodeToString(sum[:]))
+}
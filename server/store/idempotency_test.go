@@ -0,0 +1,93 @@
+package store
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func setupIdempotencyStore(t *testing.T) (*mock.MockKVService, *kvIdempotencyStore) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewIdempotencyStore(testutil.FakeLogger{}, kvMock, time.Hour).(*kvIdempotencyStore)
+	return kvMock, st
+}
+
+func TestIdempotencyStore_Reserve_NewKey(t *testing.T) {
+	kvMock, st := setupIdempotencyStore(t)
+	record := &types.IdempotencyRecord{Fingerprint: "fp"}
+	kvMock.EXPECT().Set(idempotencyKey("user", "abc"), record, gomock.Any(), gomock.Any()).Return(true, nil)
+
+	reserved, existing, err := st.Reserve("user", "abc", record)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+	assert.Nil(t, existing)
+}
+
+func TestIdempotencyStore_Reserve_ExistingKey(t *testing.T) {
+	kvMock, st := setupIdempotencyStore(t)
+	stored := &types.IdempotencyRecord{Fingerprint: "fp", Message: &types.ScheduledMessage{ID: "msg1"}}
+	key := idempotencyKey("user", "abc")
+	gomock.InOrder(
+		kvMock.EXPECT().Set(key, gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil),
+		kvMock.EXPECT().Get(key, gomock.Any()).SetArg(1, stored).Return(nil),
+	)
+
+	reserved, existing, err := st.Reserve("user", "abc", &types.IdempotencyRecord{Fingerprint: "fp"})
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	require.NotNil(t, existing)
+	assert.Equal(t, "msg1", existing.Message.ID)
+}
+
+func TestIdempotencyStore_Reserve_RetriesWhenRecordExpires(t *testing.T) {
+	kvMock, st := setupIdempotencyStore(t)
+	key := idempotencyKey("user", "abc")
+	gomock.InOrder(
+		kvMock.EXPECT().Set(key, gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil),
+		kvMock.EXPECT().Get(key, gomock.Any()).Return(nil),
+		kvMock.EXPECT().Set(key, gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil),
+	)
+
+	reserved, _, err := st.Reserve("user", "abc", &types.IdempotencyRecord{})
+	require.NoError(t, err)
+	assert.True(t, reserved)
+}
+
+func TestIdempotencyStore_Reserve_SetError(t *testing.T) {
+	kvMock, st := setupIdempotencyStore(t)
+	kvMock.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(false, errors.New("boom"))
+
+	_, _, err := st.Reserve("user", "abc", &types.IdempotencyRecord{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestIdempotencyStore_CompleteAndRelease(t *testing.T) {
+	kvMock, st := setupIdempotencyStore(t)
+	key := idempotencyKey("user", "abc")
+	record := &types.IdempotencyRecord{Fingerprint: "fp", Message: &types.ScheduledMessage{ID: "msg1"}}
+	kvMock.EXPECT().Set(key, record, gomock.Any()).Return(true, nil)
+	kvMock.EXPECT().Delete(key).Return(nil)
+
+	require.NoError(t, st.Complete("user", "abc", record))
+	require.NoError(t, st.Release("user", "abc"))
+}
+
+func TestIdempotencyKey_HashesClientKey(t *testing.T) {
+	key := idempotencyKey("user", strings.Repeat("x", constants.MaxIdempotencyKeyLength))
+	assert.True(t, strings.HasPrefix(key, constants.IdempotencyPrefix+"user:"))
+	assert.Len(t, key, len(constants.IdempotencyPrefix+"user:")+64)
+	assert.NotEqual(t, idempotencyKey("user", "a"), idempotencyKey("other", "a"))
+}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"errors"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// instrumentedStore times every operation of the wrapped store. Missing
+// messages and the per-user limit are expected outcomes, not store errors.
+type instrumentedStore struct {
+	inner   ports.Store
+	metrics ports.Metrics
+	clock   ports.Clock
+}
+
+func NewInstrumentedStore(inner ports.Store, metrics ports.Metrics, clk ports.Clock) ports.Store {
+	return &instrumentedStore{inner: inner, metrics: metrics, clock: clk}
+}
+
+func (s *instrumentedStore) observe(op string, start time.Time, err error) {
+	if errors.Is(err, types.ErrMessageNotFound) || errors.Is(err, ErrUserMessageLimit) {
+		err = nil
+	}
+	s.metrics.ObserveStoreOp(op, s.clock.Now().Sub(start), err)
+}
+
+func (s *instrumentedStore) SaveScheduledMessage(userID string, msg *types.ScheduledMessage) error {
+	start := s.clock.Now()
+	err := s.inner.SaveScheduledMessage(userID, msg)
+	s.observe("save", start, err)
+	return err
+}
+
+func (s *instrumentedStore) DeleteScheduledMessage(userID string, msgID string) error {
+	start := s.clock.Now()
+	err := s.inner.DeleteScheduledMessage(userID, msgID)
+	s.observe("delete", start, err)
+	return err
+}
+
+func (s *instrumentedStore) CleanupMessageFromUserIndex(userID string, msgID string) error {
+	start := s.clock.Now()
+	err := s.inner.CleanupMessageFromUserIndex(userID, msgID)
+	s.observe("cleanup_index", start, err)
+	return err
+}
+
+func (s *instrumentedStore) GetScheduledMessage(msgID string) (*types.ScheduledMessage, error) {
+	start := s.clock.Now()
+	msg, err := s.inner.GetScheduledMessage(msgID)
+	s.observe("get", start, err)
+	return msg, err
+}
+
+func (s *instrumentedStore) MarkReminded(msgID string, remindedAt time.Time) (*types.ScheduledMessage, error) {
+	start := s.clock.Now()
+	msg, err := s.inner.MarkReminded(msgID, remindedAt)
+	s.observe("mark_reminded", start, err)
+	return msg, err
+}
+
+func (s *instrumentedStore) UpdateScheduledMessage(userID, msgID string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+	start := s.clock.Now()
+	msg, err := s.inner.UpdateScheduledMessage(userID, msgID, update)
+	s.observe("update", start, err)
+	return msg, err
+}
+
+func (s *instrumentedStore) ListScheduledMessages() ([]*types.ScheduledMessage, error) {
+	start := s.clock.Now()
+	msgs, err := s.inner.ListScheduledMessages()
+	s.observe("list", start, err)
+	return msgs, err
+}
+
+func (s *instrumentedStore) ListUserMessageIDs(userID string) ([]string, error) {
+	start := s.clock.Now()
+	ids, err := s.inner.ListUserMessageIDs(userID)
+	s.observe("list_user_ids", start, err)
+	return ids, err
+}
+
+func (s *instrumentedStore) ListUserMessages(userID string) ([]*types.ScheduledMessage, error) {
+	start := s.clock.Now()
+	msgs, err := s.inner.ListUserMessages(userID)
+	s.observe("list_user", start, err)
+	return msgs, err
+}
+
+func (s *instrumentedStore) QueryMessages(query *types.MessageQuery) (*types.MessagePage, error) {
+	start := s.clock.Now()
+	page, err := s.inner.QueryMessages(query)
+	s.observe("query", start, err)
+	return page, err
+}
+
+func (s *instrumentedStore) GenerateMessageID() string {
+	return s.inner.GenerateMessageID()
+}
+
+func (s *instrumentedStore) SetMaxUserMessages(limit int) {
+	s.inner.SetMaxUserMessages(limit)
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestInstrumentedStore_RecordsOperationsAndErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	inner := mock.NewMockStore(ctrl)
+	metrics := &testutil.FakeMetrics{}
+	st := NewInstrumentedStore(inner, metrics, testutil.FakeClock{NowTime: time.Now()})
+
+	inner.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{{ID: "m1"}}, nil)
+	inner.EXPECT().DeleteScheduledMessage("u1", "m1").Return(errors.New("boom"))
+	inner.EXPECT().GetScheduledMessage("m2").Return(nil, types.ErrMessageNotFound)
+	inner.EXPECT().SaveScheduledMessage("u1", gomock.Any()).Return(ErrUserMessageLimit)
+	inner.EXPECT().GenerateMessageID().Return("id")
+
+	msgs, err := st.ListScheduledMessages()
+	require.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Error(t, st.DeleteScheduledMessage("u1", "m1"))
+	_, err = st.GetScheduledMessage("m2")
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+	assert.ErrorIs(t, st.SaveScheduledMessage("u1", &types.ScheduledMessage{}), ErrUserMessageLimit)
+	assert.Equal(t, "id", st.GenerateMessageID())
+
+	assert.Equal(t, map[string]int{"list": 1, "delete": 1, "get": 1, "save": 1}, metrics.StoreOps)
+	assert.Equal(t, map[string]int{"delete": 1}, metrics.StoreErrs)
+}
@@ -0,0 +1,106 @@
+package store
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"sync"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvIntegrationTokenStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+	mu     sync.Mutex
+}
+
+func NewIntegrationTokenStore(logger ports.Logger, kv ports.KVService) ports.IntegrationTokenStore {
+	logger.Debug("Creating new IntegrationTokenStore instance")
+	return &kvIntegrationTokenStore{logger: logger, kv: kv}
+}
+
+func (s *kvIntegrationTokenStore) SaveToken(token *types.IntegrationToken) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger.Debug("Saving integration token", "token_id", token.ID, "name", token.Name)
+	tokens, err := s.list()
+	if err != nil {
+		return err
+	}
+	return s.save(append(tokens, token))
+}
+
+func (s *kvIntegrationTokenStore) ListTokens() ([]*types.IntegrationToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list()
+}
+
+// GetTokenByHash returns the token whose secret hashes to hash, or nil if there
+// is none.
+func (s *kvIntegrationTokenStore) GetTokenByHash(hash string) (*types.IntegrationToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger.Debug("Looking up integration token by hash")
+	tokens, err := s.list()
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token.TokenHash), []byte(hash)) == 1 {
+			s.logger.Debug("Integration token found", "token_id", token.ID)
+			return token, nil
+		}
+	}
+	s.logger.Debug("No integration token matches hash")
+	return nil, nil
+}
+
+// DeleteToken removes the token with the given ID. It reports whether a token
+// was removed.
+func (s *kvIntegrationTokenStore) DeleteToken(id string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger.Debug("Deleting integration token", "token_id", id)
+	tokens, err := s.list()
+	if err != nil {
+		return false, err
+	}
+	kept := make([]*types.IntegrationToken, 0, len(tokens))
+	for _, token := range tokens {
+		if token.ID != id {
+			kept = append(kept, token)
+		}
+	}
+	if len(kept) == len(tokens) {
+		s.logger.Debug("Integration token not found for deletion", "token_id", id)
+		return false, nil
+	}
+	if err := s.save(kept); err != nil {
+		return false, err
+	}
+	s.logger.Info("Deleted integration token", "token_id", id)
+	return true, nil
+}
+
+func (s *kvIntegrationTokenStore) list() ([]*types.IntegrationToken, error) {
+	var tokens []*types.IntegrationToken
+	if err := s.kv.Get(constants.IntegrationTokensKey, &tokens); err != nil {
+		s.logger.Error("Failed to get integration tokens", "key", constants.IntegrationTokensKey, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for key %s: %w", constants.IntegrationTokensKey, err)
+	}
+	return tokens, nil
+}
+
+func (s *kvIntegrationTokenStore) save(tokens []*types.IntegrationToken) error {
+	if _, err := s.kv.Set(constants.IntegrationTokensKey, tokens); err != nil {
+		s.logger.Error("Failed to save integration tokens", "key", constants.IntegrationTokensKey, "error", err)
+		return fmt.Errorf("kv.Set failed for key %s: %w", constants.IntegrationTokensKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestIntegrationTokenStore_SaveToken_Appends(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewIntegrationTokenStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.IntegrationTokensKey, gomock.Any()).SetArg(1, []*types.IntegrationToken{{ID: "a"}}).Return(nil)
+	kvMock.EXPECT().Set(constants.IntegrationTokensKey, gomock.Any()).DoAndReturn(func(_ string, v any, _ ...any) (bool, error) {
+		saved := v.([]*types.IntegrationToken)
+		require.Len(t, saved, 2)
+		assert.Equal(t, "b", saved[1].ID)
+		return true, nil
+	})
+
+	require.NoError(t, st.SaveToken(&types.IntegrationToken{ID: "b"}))
+}
+
+func TestIntegrationTokenStore_GetTokenByHash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewIntegrationTokenStore(testutil.FakeLogger{}, kvMock)
+
+	tokens := []*types.IntegrationToken{{ID: "a", TokenHash: "h1"}, {ID: "b", TokenHash: "h2"}}
+	kvMock.EXPECT().Get(constants.IntegrationTokensKey, gomock.Any()).SetArg(1, tokens).Return(nil).Times(2)
+
+	got, err := st.GetTokenByHash("h2")
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, "b", got.ID)
+
+	got, err = st.GetTokenByHash("nope")
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestIntegrationTokenStore_DeleteToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewIntegrationTokenStore(testutil.FakeLogger{}, kvMock)
+
+	tokens := []*types.IntegrationToken{{ID: "a"}, {ID: "b"}}
+	kvMock.EXPECT().Get(constants.IntegrationTokensKey, gomock.Any()).SetArg(1, tokens).Return(nil).Times(2)
+	kvMock.EXPECT().Set(constants.IntegrationTokensKey, gomock.Any()).DoAndReturn(func(_ string, v any, _ ...any) (bool, error) {
+		saved := v.([]*types.IntegrationToken)
+		require.Len(t, saved, 1)
+		assert.Equal(t, "b", saved[0].ID)
+		return true, nil
+	})
+
+	deleted, err := st.DeleteToken("a")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	deleted, err = st.DeleteToken("missing")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+}
@@ -0,0 +1,37 @@
+package store
+
+import (
+	"fmt"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+)
+
+type kvKeyCounter struct {
+	logger              ports.Logger
+	kv                  ports.KVService
+	listMatchingService ports.ListMatchingService
+}
+
+func NewKeyCounter(logger ports.Logger, kv ports.KVService, listMatchingService ports.ListMatchingService) ports.KeyCounter {
+	logger.Debug("Creating new KeyCounter instance")
+	return &kvKeyCounter{logger: logger, kv: kv, listMatchingService: listMatchingService}
+}
+
+// CountKeys pages through every KV key with the prefix and returns how many
+// there are.
+func (c *kvKeyCounter) CountKeys(prefix string) (int, error) {
+	count := 0
+	for page := constants.DefaultPage; ; page++ {
+		keys, err := c.kv.ListKeys(page, constants.KVKeysPerPage, c.listMatchingService.WithPrefix(prefix))
+		if err != nil {
+			c.logger.Error("Failed to list keys from KV store", "prefix", prefix, "page", page, "error", err)
+			return 0, fmt.Errorf("kv.ListKeys failed for prefix %s: %w", prefix, err)
+		}
+		count += len(keys)
+		if len(keys) < constants.KVKeysPerPage {
+			c.logger.Debug("Counted KV keys", "prefix", prefix, "count", count)
+			return count, nil
+		}
+	}
+}
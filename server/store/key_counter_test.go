@@ -0,0 +1,44 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+)
+
+func TestKeyCounter_CountKeys_Pages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	counter := NewKeyCounter(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+
+	full := make([]string, constants.KVKeysPerPage)
+	for i := range full {
+		full[i] = fmt.Sprintf("%s%d", constants.HistoryPrefix, i)
+	}
+	gomock.InOrder(
+		kvMock.EXPECT().ListKeys(constants.DefaultPage, constants.KVKeysPerPage, gomock.Any()).Return(full, nil),
+		kvMock.EXPECT().ListKeys(constants.DefaultPage+1, constants.KVKeysPerPage, gomock.Any()).Return([]string{"history:x"}, nil),
+	)
+
+	count, err := counter.CountKeys(constants.HistoryPrefix)
+	require.NoError(t, err)
+	assert.Equal(t, constants.KVKeysPerPage+1, count)
+}
+
+func TestKeyCounter_CountKeys_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	counter := NewKeyCounter(testutil.FakeLogger{}, kvMock, &fakeListMatching{})
+	kvMock.EXPECT().ListKeys(constants.DefaultPage, constants.KVKeysPerPage, gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, err := counter.CountKeys(constants.HistoryPrefix)
+	require.Error(t, err)
+}
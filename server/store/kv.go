@@ -1,11 +1,15 @@
 package store
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"slices"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mattermost/mattermost/server/public/pluginapi"
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
@@ -16,19 +20,32 @@ type kvStore struct {
 	logger              ports.Logger
 	kv                  ports.KVService
 	listMatchingService ports.ListMatchingService
+	mu                  sync.RWMutex
 	maxUserMessages     int
 }
 
+// ErrUserMessageLimit is returned when saving a message would take a user past
+// the configured number of pending messages.
+var ErrUserMessageLimit = errors.New("user has reached the scheduled message limit")
+
 func NewKVStore(logger ports.Logger, kv ports.KVService, listMatchingService ports.ListMatchingService, maxUserMessages int) ports.Store {
 	logger.Debug("Creating new KVStore instance")
 	return &kvStore{logger: logger, kv: kv, listMatchingService: listMatchingService, maxUserMessages: maxUserMessages}
 }
 
+// SetMaxUserMessages changes the per-user message limit enforced when saving.
+func (s *kvStore) SetMaxUserMessages(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.logger.Debug("Updating max user messages limit", "old_limit", s.maxUserMessages, "new_limit", limit)
+	s.maxUserMessages = limit
+}
+
 func (s *kvStore) SaveScheduledMessage(userID string, msg *types.ScheduledMessage) error {
 	s.logger.Debug("Attempting to save scheduled message", "user_id", userID, "message_id", msg.ID)
 
 	s.logger.Debug("Adding message ID to user index", "user_id", userID, "message_id", msg.ID)
-	_, addIndexErr := s.addUserMessageToIndex(userID, msg.ID)
+	_, addIndexErr := s.addUserMessageToIndex(userID, msg)
 	if addIndexErr != nil {
 		s.logger.Error("Failed to add message ID to user index", "user_id", userID, "message_id", msg.ID, "error", addIndexErr)
 		return fmt.Errorf("failed to update user index: %w", addIndexErr)
@@ -90,12 +107,105 @@ func (s *kvStore) GetScheduledMessage(msgID string) (*types.ScheduledMessage, er
 	}
 	if msg.ID == "" {
 		s.logger.Debug("message not found (possibly already sent)", "message_id", msgID, "key", key)
-		return nil, errors.New("message not found (possibly already sent)")
+		return nil, types.ErrMessageNotFound
 	}
 	s.logger.Debug("Successfully retrieved scheduled message", "message_id", msgID, "key", key)
 	return &msg, nil
 }
 
+// MarkReminded records that the owner of msgID was reminded. The write only
+// goes through if the stored message is unchanged since it was read, so a
+// message deleted, sent or edited in the meantime is never written back;
+// ErrMessageNotFound or ErrMessageChanged is returned instead.
+func (s *kvStore) MarkReminded(msgID string, remindedAt time.Time) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Attempting to mark scheduled message as reminded", "message_id", msgID)
+	msg, err := s.swapMessage(msgID, func(msg *types.ScheduledMessage) error {
+		if msg.RemindedAt != nil {
+			s.logger.Debug("Message was already marked as reminded", "message_id", msgID)
+			return types.ErrMessageChanged
+		}
+		msg.RemindedAt = &remindedAt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Debug("Successfully marked scheduled message as reminded", "message_id", msgID)
+	return msg, nil
+}
+
+// UpdateScheduledMessage applies update to the stored message and saves the
+// result the same way MarkReminded does, only if the stored message is
+// unchanged since it was read. A message the scheduler has already taken for
+// sending is reported as ErrMessageNotFound, so it is never written back. An
+// error from update is returned as is and nothing is saved.
+func (s *kvStore) UpdateScheduledMessage(userID, msgID string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+	s.logger.Debug("Attempting to update scheduled message", "user_id", userID, "message_id", msgID)
+	msg, err := s.swapMessage(msgID, update)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.addUserMessageToIndex(userID, msg); err != nil {
+		s.logger.Error("Failed to update user index for updated message", "user_id", userID, "message_id", msgID, "error", err)
+		return nil, fmt.Errorf("failed to update user index: %w", err)
+	}
+	s.logger.Info("Successfully updated scheduled message", "user_id", userID, "message_id", msgID)
+	return msg, nil
+}
+
+// swapMessage reads msgID, applies update and writes the result back with a
+// compare-and-set against what was read. When the write is refused the message
+// is read again to tell a deleted message from a changed one.
+func (s *kvStore) swapMessage(msgID string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+	key := schedKey(msgID)
+	raw, err := s.getRawMessage(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		s.logger.Debug("message not found (possibly already sent)", "message_id", msgID, "key", key)
+		return nil, types.ErrMessageNotFound
+	}
+	var msg types.ScheduledMessage
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		return nil, fmt.Errorf("failed to decode message %s: %w", msgID, err)
+	}
+	if err := update(&msg); err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode message %s: %w", msgID, err)
+	}
+	saved, err := s.kv.Set(key, data, pluginapi.SetAtomic(raw))
+	if err != nil {
+		s.logger.Error("Failed to save scheduled message atomically", "message_id", msgID, "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Set failed for key %s: %w", key, err)
+	}
+	if saved {
+		return &msg, nil
+	}
+	current, err := s.getRawMessage(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(current) == 0 {
+		s.logger.Debug("Message was taken for sending before it could be saved", "message_id", msgID)
+		return nil, types.ErrMessageNotFound
+	}
+	s.logger.Debug("Message changed before it could be saved", "message_id", msgID)
+	return nil, types.ErrMessageChanged
+}
+
+func (s *kvStore) getRawMessage(key string) ([]byte, error) {
+	var raw []byte
+	if err := s.kv.Get(key, &raw); err != nil {
+		s.logger.Error("Failed to get scheduled message from KV store", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for key %s: %w", key, err)
+	}
+	return raw, nil
+}
+
 func (s *kvStore) ListScheduledMessages() ([]*types.ScheduledMessage, error) {
 	s.logger.Debug("Attempting to list all scheduled messages")
 	var messages []*types.ScheduledMessage
@@ -134,6 +244,9 @@ func (s *kvStore) ListUserMessageIDs(userID string) ([]string, error) {
 		return nil, fmt.Errorf("kv.Get failed for user index key %s: %w", key, err)
 	}
 	s.logger.Debug("Successfully retrieved user message index", "user_id", userID, "key", key, "count", len(ids))
+	for i, entry := range ids {
+		ids[i] = entryID(entry)
+	}
 	return ids, nil
 }
 
@@ -146,7 +259,7 @@ func (s *kvStore) GenerateMessageID() string {
 func (s *kvStore) removeUserMessageFromIndex(userID, msgID string) (bool, error) {
 	s.logger.Debug("Calling modifyUserIndex to remove message ID", "user_id", userID, "message_id", msgID)
 	return s.modifyUserIndex(userID, func(ids []string) ([]string, bool) {
-		idx := slices.Index(ids, msgID)
+		idx := slices.IndexFunc(ids, func(e string) bool { return entryID(e) == msgID })
 		if idx == -1 {
 			s.logger.Warn("Message ID not found in user index for removal", "user_id", userID, "message_id", msgID)
 			return ids, false
@@ -156,16 +269,39 @@ func (s *kvStore) removeUserMessageFromIndex(userID, msgID string) (bool, error)
 	})
 }
 
-func (s *kvStore) addUserMessageToIndex(userID, msgID string) (bool, error) {
-	s.logger.Debug("Calling modifyUserIndex to add message ID", "user_id", userID, "message_id", msgID)
-	return s.modifyUserIndex(userID, func(ids []string) ([]string, bool) {
-		if slices.Contains(ids, msgID) {
-			s.logger.Warn("Message ID already exists in user index", "user_id", userID, "message_id", msgID)
+// addUserMessageToIndex adds msg's entry to the user's index, keeping the
+// index in post time order. A message that is already indexed is moved if its
+// post time changed. Bare ID entries are left alone for QueryMessages to
+// migrate.
+func (s *kvStore) addUserMessageToIndex(userID string, msg *types.ScheduledMessage) (bool, error) {
+	s.mu.RLock()
+	limit := s.maxUserMessages
+	s.mu.RUnlock()
+
+	entry := indexEntry(msg)
+	s.logger.Debug("Calling modifyUserIndex to add message ID", "user_id", userID, "message_id", msg.ID, "limit", limit)
+	var limitReached bool
+	modified, err := s.modifyUserIndex(userID, func(ids []string) ([]string, bool) {
+		if idx := slices.IndexFunc(ids, func(e string) bool { return entryID(e) == msg.ID }); idx != -1 {
+			if _, ok := parseIndexEntry(ids[idx]); !ok || ids[idx] == entry {
+				s.logger.Warn("Message ID already exists in user index", "user_id", userID, "message_id", msg.ID)
+				return ids, false
+			}
+			s.logger.Debug("Message post time changed, moving index entry", "user_id", userID, "message_id", msg.ID)
+			return insertIndexEntry(slices.Delete(ids, idx, idx+1), entry), true
+		}
+		if limit > 0 && len(ids) >= limit {
+			s.logger.Warn("User index is full, refusing to add message ID", "user_id", userID, "message_id", msg.ID, "count", len(ids), "limit", limit)
+			limitReached = true
 			return ids, false
 		}
-		s.logger.Debug("Message ID not in index, preparing addition", "user_id", userID, "message_id", msgID)
-		return append(ids, msgID), true
+		s.logger.Debug("Message ID not in index, preparing addition", "user_id", userID, "message_id", msg.ID)
+		return insertIndexEntry(ids, entry), true
 	})
+	if err == nil && limitReached {
+		return false, ErrUserMessageLimit
+	}
+	return modified, err
 }
 
 func (s *kvStore) saveNewScheduledMessage(msg *types.ScheduledMessage) (bool, error) {
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -11,6 +13,7 @@ import (
 	"github.com/mattermost/mattermost/server/public/pluginapi"
 
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
 	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
@@ -489,3 +492,168 @@ func TestSaveScheduledMessage_DuplicateIDNoIndexWrite(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestSaveScheduledMessage_UserLimitReached(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kvMock := mock.NewMockKVService(ctrl)
+	store := NewKVStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{}, constants.MaxUserMessages)
+	store.SetMaxUserMessages(2)
+
+	userID := "user"
+	msg := sampleMessage(uuid.NewString(), userID, time.Now())
+	kvMock.EXPECT().Get(testutil.IndexKey(userID), gomock.Any()).SetArg(1, []string{"a", "b"}).Return(nil)
+
+	err := store.SaveScheduledMessage(userID, msg)
+	if !errors.Is(err, ErrUserMessageLimit) {
+		t.Fatalf("expected ErrUserMessageLimit, got %v", err)
+	}
+}
+
+func setupMarkReminded(t *testing.T, stored *types.ScheduledMessage) (ports.Store, *mock.MockKVService) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	var raw []byte
+	if stored != nil {
+		var err error
+		raw, err = json.Marshal(stored)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	kvMock.EXPECT().Get(testutil.SchedKey("m1"), gomock.Any()).DoAndReturn(func(_ string, v any) error {
+		*(v.(*[]byte)) = raw
+		return nil
+	})
+	return NewKVStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{}, constants.MaxUserMessages), kvMock
+}
+
+func TestMarkReminded_Success(t *testing.T) {
+	remindedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	store, kvMock := setupMarkReminded(t, sampleMessage("m1", "user", remindedAt.Add(10*time.Minute)))
+	kvMock.EXPECT().Set(testutil.SchedKey("m1"), gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, v any, opts ...pluginapi.KVSetOption) (bool, error) {
+		var written types.ScheduledMessage
+		if err := json.Unmarshal(v.([]byte), &written); err != nil {
+			t.Fatal(err)
+		}
+		if written.RemindedAt == nil || !written.RemindedAt.Equal(remindedAt) {
+			t.Errorf("expected RemindedAt %s, got %v", remindedAt, written.RemindedAt)
+		}
+		var applied pluginapi.KVSetOptions
+		for _, opt := range opts {
+			opt(&applied)
+		}
+		if !applied.Atomic {
+			t.Error("expected an atomic write")
+		}
+		return true, nil
+	})
+
+	msg, err := store.MarkReminded("m1", remindedAt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.RemindedAt == nil || !msg.RemindedAt.Equal(remindedAt) {
+		t.Errorf("expected RemindedAt %s, got %v", remindedAt, msg.RemindedAt)
+	}
+}
+
+func TestMarkReminded_NotFound(t *testing.T) {
+	store, _ := setupMarkReminded(t, nil)
+
+	_, err := store.MarkReminded("m1", time.Now())
+	if !errors.Is(err, types.ErrMessageNotFound) {
+		t.Errorf("expected ErrMessageNotFound, got %v", err)
+	}
+}
+
+func TestMarkReminded_AlreadyReminded(t *testing.T) {
+	reminded := time.Now()
+	msg := sampleMessage("m1", "user", reminded.Add(time.Hour))
+	msg.RemindedAt = &reminded
+	store, _ := setupMarkReminded(t, msg)
+
+	_, err := store.MarkReminded("m1", time.Now())
+	if !errors.Is(err, types.ErrMessageChanged) {
+		t.Errorf("expected ErrMessageChanged, got %v", err)
+	}
+}
+
+func TestMarkReminded_ChangedMeanwhile(t *testing.T) {
+	stored := sampleMessage("m1", "user", time.Now().Add(time.Hour))
+	store, kvMock := setupMarkReminded(t, stored)
+	kvMock.EXPECT().Set(testutil.SchedKey("m1"), gomock.Any(), gomock.Any()).Return(false, nil)
+	kvMock.EXPECT().Get(testutil.SchedKey("m1"), gomock.Any()).SetArg(1, mustMarshal(t, stored)).Return(nil)
+
+	_, err := store.MarkReminded("m1", time.Now())
+	if !errors.Is(err, types.ErrMessageChanged) {
+		t.Errorf("expected ErrMessageChanged, got %v", err)
+	}
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	raw, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestUpdateScheduledMessage_Success(t *testing.T) {
+	postAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	stored := sampleMessage("m1", "user", postAt)
+	store, kvMock := setupMarkReminded(t, stored)
+	later := postAt.Add(time.Hour)
+	var applied pluginapi.KVSetOptions
+	kvMock.EXPECT().Set(testutil.SchedKey("m1"), gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, v any, opts ...pluginapi.KVSetOption) (bool, error) {
+		for _, opt := range opts {
+			opt(&applied)
+		}
+		return true, nil
+	})
+	kvMock.EXPECT().Get(testutil.IndexKey("user"), gomock.Any()).SetArg(1, []string{indexEntry(stored)}).Return(nil)
+	kvMock.EXPECT().Set(testutil.IndexKey("user"), []string{indexEntry(&types.ScheduledMessage{ID: "m1", PostAt: later})}).Return(true, nil)
+
+	msg, err := store.UpdateScheduledMessage("user", "m1", func(msg *types.ScheduledMessage) error {
+		msg.PostAt = later
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !msg.PostAt.Equal(later) {
+		t.Errorf("expected PostAt %s, got %s", later, msg.PostAt)
+	}
+	if !applied.Atomic {
+		t.Error("expected an atomic write")
+	}
+}
+
+func TestUpdateScheduledMessage_TakenForSending(t *testing.T) {
+	store, kvMock := setupMarkReminded(t, sampleMessage("m1", "user", time.Now().Add(-time.Minute)))
+	kvMock.EXPECT().Set(testutil.SchedKey("m1"), gomock.Any(), gomock.Any()).Return(false, nil)
+	kvMock.EXPECT().Get(testutil.SchedKey("m1"), gomock.Any()).Return(nil)
+
+	_, err := store.UpdateScheduledMessage("user", "m1", func(msg *types.ScheduledMessage) error {
+		msg.PostAt = msg.PostAt.Add(time.Hour)
+		return nil
+	})
+	if !errors.Is(err, types.ErrMessageNotFound) {
+		t.Errorf("expected ErrMessageNotFound, got %v", err)
+	}
+}
+
+func TestUpdateScheduledMessage_UpdateError(t *testing.T) {
+	store, _ := setupMarkReminded(t, sampleMessage("m1", "user", time.Now()))
+	refused := errors.New("refused")
+
+	_, err := store.UpdateScheduledMessage("user", "m1", func(*types.ScheduledMessage) error {
+		return refused
+	})
+	if !errors.Is(err, refused) {
+		t.Errorf("expected the update's error, got %v", err)
+	}
+}
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"fmt"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvPauseStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+}
+
+func NewPauseStore(logger ports.Logger, kv ports.KVService) ports.PauseStore {
+	logger.Debug("Creating new PauseStore instance")
+	return &kvPauseStore{logger: logger, kv: kv}
+}
+
+// GetPauseState returns the current delivery pause, or nil if delivery is not
+// paused.
+func (s *kvPauseStore) GetPauseState() (*types.PauseState, error) {
+	s.logger.Debug("Getting delivery pause state", "key", constants.DeliveryPauseKey)
+	var state types.PauseState
+	if err := s.kv.Get(constants.DeliveryPauseKey, &state); err != nil {
+		s.logger.Error("Failed to get delivery pause state from KV store", "key", constants.DeliveryPauseKey, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for delivery pause key %s: %w", constants.DeliveryPauseKey, err)
+	}
+	if state.PausedAt.IsZero() {
+		return nil, nil
+	}
+	return &state, nil
+}
+
+func (s *kvPauseStore) SavePauseState(state *types.PauseState) error {
+	s.logger.Debug("Saving delivery pause state", "paused_by", state.PausedBy, "resume_at", state.ResumeAt, "resume_mode", state.ResumeMode)
+	if _, err := s.kv.Set(constants.DeliveryPauseKey, state); err != nil {
+		s.logger.Error("Failed to save delivery pause state to KV store", "key", constants.DeliveryPauseKey, "error", err)
+		return fmt.Errorf("kv.Set failed for delivery pause key %s: %w", constants.DeliveryPauseKey, err)
+	}
+	return nil
+}
+
+func (s *kvPauseStore) DeletePauseState() error {
+	s.logger.Debug("Deleting delivery pause state", "key", constants.DeliveryPauseKey)
+	if err := s.kv.Delete(constants.DeliveryPauseKey); err != nil {
+		s.logger.Error("Failed to delete delivery pause state from KV store", "key", constants.DeliveryPauseKey, "error", err)
+		return fmt.Errorf("kv.Delete failed for delivery pause key %s: %w", constants.DeliveryPauseKey, err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestPauseStore_GetPauseState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPauseStore(testutil.FakeLogger{}, kvMock)
+
+	stored := types.PauseState{PausedBy: "admin", PausedAt: time.Unix(100, 0), ResumeMode: types.ResumeSkip}
+	kvMock.EXPECT().Get(constants.DeliveryPauseKey, gomock.Any()).SetArg(1, stored).Return(nil)
+
+	state, err := st.GetPauseState()
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, "admin", state.PausedBy)
+	assert.Equal(t, types.ResumeSkip, state.ResumeMode)
+}
+
+func TestPauseStore_GetPauseState_NotPaused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPauseStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.DeliveryPauseKey, gomock.Any()).Return(nil)
+
+	state, err := st.GetPauseState()
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestPauseStore_GetPauseState_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPauseStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.DeliveryPauseKey, gomock.Any()).Return(errors.New("boom"))
+
+	_, err := st.GetPauseState()
+	require.Error(t, err)
+}
+
+func TestPauseStore_SaveAndDelete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPauseStore(testutil.FakeLogger{}, kvMock)
+
+	state := &types.PauseState{PausedBy: "admin", PausedAt: time.Unix(100, 0)}
+	kvMock.EXPECT().Set(constants.DeliveryPauseKey, state).Return(true, nil)
+	kvMock.EXPECT().Delete(constants.DeliveryPauseKey).Return(errors.New("boom"))
+
+	require.NoError(t, st.SavePauseState(state))
+	require.Error(t, st.DeletePauseState())
+}
@@ -0,0 +1,84 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvPreferenceStore struct {
+	logger ports.Logger
+	kv     ports.KVService
+}
+
+func NewPreferenceStore(logger ports.Logger, kv ports.KVService) ports.PreferenceStore {
+	logger.Debug("Creating new PreferenceStore instance")
+	return &kvPreferenceStore{logger: logger, kv: kv}
+}
+
+// GetPreferences returns the user's preferences. A user who has never changed
+// them gets the zero value.
+func (s *kvPreferenceStore) GetPreferences(userID string) (*types.UserPreferences, error) {
+	key := preferencesKey(userID)
+	s.logger.Debug("Getting user preferences", "user_id", userID, "key", key)
+	var prefs types.UserPreferences
+	if err := s.kv.Get(key, &prefs); err != nil {
+		s.logger.Error("Failed to get user preferences from KV store", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for preferences key %s: %w", key, err)
+	}
+	return &prefs, nil
+}
+
+func (s *kvPreferenceStore) SavePreferences(userID string, prefs *types.UserPreferences) error {
+	key := preferencesKey(userID)
+	s.logger.Debug("Saving user preferences", "user_id", userID, "key", key)
+	if _, err := s.kv.Set(key, prefs); err != nil {
+		s.logger.Error("Failed to save user preferences to KV store", "key", key, "error", err)
+		return fmt.Errorf("kv.Set failed for preferences key %s: %w", key, err)
+	}
+	return nil
+}
+
+// MarkDigestSent records that the user's digest for date has been sent. The
+// date has its own key, so it never overwrites a settings change, and is set
+// with a compare-and-set, so only one caller gets true for a date.
+func (s *kvPreferenceStore) MarkDigestSent(userID, date string) (bool, error) {
+	key := digestSentKey(userID)
+	s.logger.Debug("Marking digest as sent", "user_id", userID, "key", key, "date", date)
+	var raw []byte
+	if err := s.kv.Get(key, &raw); err != nil {
+		s.logger.Error("Failed to get digest sent date from KV store", "key", key, "error", err)
+		return false, fmt.Errorf("kv.Get failed for digest key %s: %w", key, err)
+	}
+	if len(raw) > 0 {
+		var sentOn string
+		if err := json.Unmarshal(raw, &sentOn); err != nil {
+			return false, fmt.Errorf("failed to decode digest key %s: %w", key, err)
+		}
+		if sentOn == date {
+			return false, nil
+		}
+	}
+	saved, err := s.kv.Set(key, date, pluginapi.SetAtomic(raw))
+	if err != nil {
+		s.logger.Error("Failed to save digest sent date to KV store", "key", key, "error", err)
+		return false, fmt.Errorf("kv.Set failed for digest key %s: %w", key, err)
+	}
+	if !saved {
+		s.logger.Debug("Digest was marked as sent meanwhile", "user_id", userID, "date", date)
+	}
+	return saved, nil
+}
+
+func digestSentKey(userID string) string {
+	return constants.DigestSentPrefix + userID
+}
+
+func preferencesKey(userID string) string {
+	return constants.PreferencesPrefix + userID
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestPreferenceStore_GetPreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.PreferencesPrefix+"u1", gomock.Any()).SetArg(1, types.UserPreferences{ReminderMinutes: 30}).Return(nil)
+
+	prefs, err := st.GetPreferences("u1")
+	require.NoError(t, err)
+	assert.Equal(t, 30, prefs.ReminderMinutes)
+}
+
+func TestPreferenceStore_GetPreferences_Unset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.PreferencesPrefix+"u1", gomock.Any()).Return(nil)
+
+	prefs, err := st.GetPreferences("u1")
+	require.NoError(t, err)
+	assert.Equal(t, &types.UserPreferences{}, prefs)
+}
+
+func TestPreferenceStore_GetPreferences_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.PreferencesPrefix+"u1", gomock.Any()).Return(errors.New("boom"))
+
+	_, err := st.GetPreferences("u1")
+	require.Error(t, err)
+}
+
+func TestPreferenceStore_SavePreferences(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	prefs := &types.UserPreferences{ReminderMinutes: 15}
+	kvMock.EXPECT().Set(constants.PreferencesPrefix+"u1", prefs).Return(true, nil)
+
+	require.NoError(t, st.SavePreferences("u1", prefs))
+}
+
+func TestPreferenceStore_MarkDigestSent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.DigestSentPrefix+"u1", gomock.Any()).SetArg(1, []byte(`"2025-01-03"`)).Return(nil)
+	kvMock.EXPECT().Set(constants.DigestSentPrefix+"u1", "2025-01-06", gomock.Any()).Return(true, nil)
+
+	marked, err := st.MarkDigestSent("u1", "2025-01-06")
+	require.NoError(t, err)
+	assert.True(t, marked)
+}
+
+func TestPreferenceStore_MarkDigestSent_AlreadySent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.DigestSentPrefix+"u1", gomock.Any()).SetArg(1, []byte(`"2025-01-06"`)).Return(nil)
+
+	marked, err := st.MarkDigestSent("u1", "2025-01-06")
+	require.NoError(t, err)
+	assert.False(t, marked)
+}
+
+func TestPreferenceStore_MarkDigestSent_MarkedMeanwhile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewPreferenceStore(testutil.FakeLogger{}, kvMock)
+
+	kvMock.EXPECT().Get(constants.DigestSentPrefix+"u1", gomock.Any()).Return(nil)
+	kvMock.EXPECT().Set(constants.DigestSentPrefix+"u1", "2025-01-06", gomock.Any()).Return(false, nil)
+
+	marked, err := st.MarkDigestSent("u1", "2025-01-06")
+	require.NoError(t, err)
+	assert.False(t, marked)
+}
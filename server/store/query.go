@@ -0,0 +1,304 @@
+package store
+
+import (
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// QueryMessages returns one page of the user's scheduled messages matching
+// query, ordered by post time and then ID. The cursor is opaque to callers and
+// encodes the sort key of the last message on the previous page, so pages stay
+// stable while messages are added or sent.
+//
+// The user index is kept in the same order, so the cursor, sort and date
+// range are served from the index and only messages on or near the page are
+// loaded. Channel, file and text filters need the message itself and load
+// further, stopping once the page is full.
+func (s *kvStore) QueryMessages(query *types.MessageQuery) (*types.MessagePage, error) {
+	s.logger.Debug("Querying scheduled messages", "user_id", query.UserID, "channel_count", len(query.ChannelIDs), "sort", query.Sort, "limit", query.Limit)
+	after, err := decodeCursor(query.Cursor)
+	if err != nil {
+		s.logger.Debug("Rejecting query with invalid cursor", "user_id", query.UserID, "error", err)
+		return nil, err
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = constants.DefaultQueryLimit
+	}
+	limit = min(limit, constants.MaxQueryLimit)
+	descending := query.Sort == types.SortDescending
+
+	entries, err := s.orderedUserIndex(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if descending {
+		slices.Reverse(entries)
+	}
+
+	matches := make([]*types.ScheduledMessage, 0, limit+1)
+	loaded := 0
+	for _, entry := range entries {
+		pos, ok := parseIndexEntry(entry)
+		if !ok || !pos.inRange(query, after, descending) {
+			continue
+		}
+		loaded++
+		msg, loadErr := s.loadIndexedMessage(query.UserID, pos.id)
+		if loadErr != nil {
+			s.logger.Warn("Skipping scheduled message that could not be loaded", "user_id", query.UserID, "message_id", pos.id, "error", loadErr)
+			continue
+		}
+		if msg == nil || !matchesQuery(msg, query) {
+			continue
+		}
+		matches = append(matches, msg)
+		if len(matches) > limit {
+			break
+		}
+	}
+
+	page := &types.MessagePage{Messages: matches}
+	if len(matches) > limit {
+		page.Messages = matches[:limit]
+		page.NextCursor = encodeCursor(page.Messages[limit-1])
+	}
+	s.logger.Debug("Scheduled message query complete", "user_id", query.UserID, "indexed", len(entries), "loaded", loaded, "returned", len(page.Messages), "has_more", page.NextCursor != "")
+	return page, nil
+}
+
+// ListUserMessages returns every scheduled message of the user in post time
+// order. Messages that fail to load are skipped.
+func (s *kvStore) ListUserMessages(userID string) ([]*types.ScheduledMessage, error) {
+	s.logger.Debug("Listing scheduled messages of user", "user_id", userID)
+	entries, err := s.orderedUserIndex(userID)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]*types.ScheduledMessage, 0, len(entries))
+	for _, entry := range entries {
+		msgID := entryID(entry)
+		msg, err := s.loadIndexedMessage(userID, msgID)
+		if err != nil {
+			s.logger.Warn("Skipping scheduled message that could not be loaded", "user_id", userID, "message_id", msgID, "error", err)
+			continue
+		}
+		if msg != nil {
+			msgs = append(msgs, msg)
+		}
+	}
+	s.logger.Debug("Listed scheduled messages of user", "user_id", userID, "indexed", len(entries), "returned", len(msgs))
+	return msgs, nil
+}
+
+// orderedUserIndex returns the user's index in post time order. Bare ID
+// entries written before the index was ordered are rewritten with their
+// message's post time, and dropped if the message is gone.
+func (s *kvStore) orderedUserIndex(userID string) ([]string, error) {
+	var entries []string
+	key := indexKey(userID)
+	if err := s.kv.Get(key, &entries); err != nil {
+		s.logger.Error("Failed to get user message index from KV store", "key", key, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for user index key %s: %w", key, err)
+	}
+	legacy := map[string]string{}
+	for _, entry := range entries {
+		if _, ok := parseIndexEntry(entry); !ok {
+			legacy[entry] = ""
+		}
+	}
+	if len(legacy) == 0 {
+		return entries, nil
+	}
+
+	s.logger.Info("Migrating user index to post time order", "user_id", userID, "count", len(legacy))
+	for id := range legacy {
+		var msg types.ScheduledMessage
+		if err := s.kv.Get(schedKey(id), &msg); err != nil {
+			return nil, fmt.Errorf("kv.Get failed for key %s: %w", schedKey(id), err)
+		}
+		if msg.ID != "" {
+			legacy[id] = indexEntry(&msg)
+		}
+	}
+	var migrated []string
+	if _, err := s.modifyUserIndex(userID, func(ids []string) ([]string, bool) {
+		migrated = make([]string, 0, len(ids))
+		for _, entry := range ids {
+			replacement, isLegacy := legacy[entry]
+			switch {
+			case !isLegacy:
+				migrated = append(migrated, entry)
+			case replacement != "":
+				migrated = append(migrated, replacement)
+			default:
+				s.logger.Warn("Scheduled message referenced in user index not found, cleaning up", "user_id", userID, "message_id", entry)
+			}
+		}
+		slices.SortStableFunc(migrated, compareIndexEntries)
+		return migrated, true
+	}); err != nil {
+		return nil, fmt.Errorf("failed to migrate user index: %w", err)
+	}
+	return migrated, nil
+}
+
+// loadIndexedMessage loads a message referenced by the user's index. Index
+// entries whose message is gone are cleaned up and reported as nil.
+func (s *kvStore) loadIndexedMessage(userID, msgID string) (*types.ScheduledMessage, error) {
+	var msg types.ScheduledMessage
+	key := schedKey(msgID)
+	if err := s.kv.Get(key, &msg); err != nil {
+		return nil, fmt.Errorf("kv.Get failed for key %s: %w", key, err)
+	}
+	if msg.ID == "" {
+		s.logger.Warn("Scheduled message referenced in user index not found, cleaning up", "user_id", userID, "message_id", msgID)
+		if err := s.CleanupMessageFromUserIndex(userID, msgID); err != nil {
+			s.logger.Error("Failed to cleanup missing message from user index", "user_id", userID, "message_id", msgID, "error", err)
+		}
+		return nil, nil
+	}
+	return &msg, nil
+}
+
+func matchesQuery(msg *types.ScheduledMessage, query *types.MessageQuery) bool {
+	if len(query.ChannelIDs) > 0 && !slices.Contains(query.ChannelIDs, msg.ChannelID) {
+		return false
+	}
+	if !query.From.IsZero() && msg.PostAt.Before(query.From) {
+		return false
+	}
+	if !query.To.IsZero() && !msg.PostAt.Before(query.To) {
+		return false
+	}
+	if query.HasFiles != nil && (len(msg.FileIDs) > 0) != *query.HasFiles {
+		return false
+	}
+	if query.Text != "" && !strings.Contains(strings.ToLower(msg.MessageContent), strings.ToLower(query.Text)) {
+		return false
+	}
+	if query.IntegrationTokenID != "" && msg.IntegrationTokenID != query.IntegrationTokenID {
+		return false
+	}
+	return true
+}
+
+// cursor is a position in post time order: a message's post time and ID.
+type cursor struct {
+	postAt time.Time
+	id     string
+}
+
+func (c *cursor) before(other *cursor) bool {
+	if !c.postAt.Equal(other.postAt) {
+		return c.postAt.Before(other.postAt)
+	}
+	return c.id < other.id
+}
+
+// inRange reports whether the message at c can be on the page: past the
+// cursor in the query's direction and within its date range.
+func (c *cursor) inRange(query *types.MessageQuery, after *cursor, descending bool) bool {
+	if after != nil {
+		if descending && !c.before(after) {
+			return false
+		}
+		if !descending && !after.before(c) {
+			return false
+		}
+	}
+	if !query.From.IsZero() && c.postAt.Before(query.From) {
+		return false
+	}
+	if !query.To.IsZero() && !c.postAt.Before(query.To) {
+		return false
+	}
+	return true
+}
+
+// indexEntry is msg's entry in the user index: its post time in Unix
+// nanoseconds and its ID, the same text a cursor encodes. Entries written
+// before the index was ordered are bare IDs.
+func indexEntry(msg *types.ScheduledMessage) string {
+	return strconv.FormatInt(msg.PostAt.UnixNano(), 10) + ":" + msg.ID
+}
+
+func parseIndexEntry(entry string) (*cursor, bool) {
+	nanos, id, ok := strings.Cut(entry, ":")
+	if !ok || id == "" {
+		return nil, false
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &cursor{postAt: time.Unix(0, n).UTC(), id: id}, true
+}
+
+// entryID returns the message ID of an index entry, ordered or bare.
+func entryID(entry string) string {
+	if _, id, ok := strings.Cut(entry, ":"); ok {
+		return id
+	}
+	return entry
+}
+
+// compareIndexEntries orders entries by post time and then ID, with bare ID
+// entries last.
+func compareIndexEntries(a, b string) int {
+	pa, okA := parseIndexEntry(a)
+	pb, okB := parseIndexEntry(b)
+	switch {
+	case !okA && !okB:
+		return 0
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	case pa.before(pb):
+		return -1
+	case pb.before(pa):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// insertIndexEntry inserts entry in post time order, before the first ordered
+// entry that sorts after it.
+func insertIndexEntry(ids []string, entry string) []string {
+	idx := slices.IndexFunc(ids, func(e string) bool {
+		_, ok := parseIndexEntry(e)
+		return ok && compareIndexEntries(entry, e) < 0
+	})
+	if idx == -1 {
+		return append(ids, entry)
+	}
+	return slices.Insert(ids, idx, entry)
+}
+
+func encodeCursor(msg *types.ScheduledMessage) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(indexEntry(msg)))
+}
+
+func decodeCursor(value string) (*cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", types.ErrInvalidCursor, err)
+	}
+	c, ok := parseIndexEntry(string(raw))
+	if !ok {
+		return nil, types.ErrInvalidCursor
+	}
+	return c, nil
+}
@@ -0,0 +1,280 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var queryBase = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// setupQueryStore backs KV reads with data, decoding through JSON like the real
+// KV service does. The returned slice records every key read.
+func setupQueryStore(t *testing.T, data map[string]any) (*kvStore, *mock.MockKVService, *[]string) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	reads := &[]string{}
+	kvMock.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(key string, v any) error {
+		*reads = append(*reads, key)
+		value, ok := data[key]
+		if !ok {
+			return nil
+		}
+		raw, err := json.Marshal(value)
+		require.NoError(t, err)
+		return json.Unmarshal(raw, v)
+	}).AnyTimes()
+	st := NewKVStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{}, constants.MaxUserMessages).(*kvStore)
+	return st, kvMock, reads
+}
+
+// orderedIndex builds the user index for msgs, in post time order.
+func orderedIndex(msgs ...*types.ScheduledMessage) []string {
+	entries := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		entries = append(entries, indexEntry(m))
+	}
+	slices.SortFunc(entries, compareIndexEntries)
+	return entries
+}
+
+func queryFixture() map[string]any {
+	msgs := []*types.ScheduledMessage{
+		{ID: "a", UserID: "u", ChannelID: "c1", PostAt: queryBase.Add(3 * time.Hour), MessageContent: "Deploy window"},
+		{ID: "b", UserID: "u", ChannelID: "c2", PostAt: queryBase.Add(1 * time.Hour), MessageContent: "standup", FileIDs: []string{"f"}, IntegrationTokenID: "t1"},
+		{ID: "c", UserID: "u", ChannelID: "c1", PostAt: queryBase.Add(2 * time.Hour), MessageContent: "deploy done"},
+		{ID: "d", UserID: "u", ChannelID: "c1", PostAt: queryBase.Add(2 * time.Hour), MessageContent: "lunch"},
+	}
+	data := map[string]any{testutil.IndexKey("u"): orderedIndex(msgs...)}
+	for _, m := range msgs {
+		data[testutil.SchedKey(m.ID)] = m
+	}
+	return data
+}
+
+func ids(page *types.MessagePage) []string {
+	out := make([]string, 0, len(page.Messages))
+	for _, m := range page.Messages {
+		out = append(out, m.ID)
+	}
+	return out
+}
+
+func TestQueryMessages_SortsAndPaginates(t *testing.T) {
+	st, _, _ := setupQueryStore(t, queryFixture())
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u", Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c"}, ids(page))
+	require.NotEmpty(t, page.NextCursor)
+
+	page, err = st.QueryMessages(&types.MessageQuery{UserID: "u", Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"d", "a"}, ids(page))
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestQueryMessages_Descending(t *testing.T) {
+	st, _, _ := setupQueryStore(t, queryFixture())
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u", Sort: types.SortDescending, Limit: 3})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "d", "c"}, ids(page))
+
+	page, err = st.QueryMessages(&types.MessageQuery{UserID: "u", Sort: types.SortDescending, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, ids(page))
+}
+
+func TestQueryMessages_Filters(t *testing.T) {
+	st, _, _ := setupQueryStore(t, queryFixture())
+	yes, no := true, false
+
+	tests := []struct {
+		name  string
+		query types.MessageQuery
+		want  []string
+	}{
+		{"channel", types.MessageQuery{ChannelIDs: []string{"c1"}}, []string{"c", "d", "a"}},
+		{"date range", types.MessageQuery{From: queryBase.Add(2 * time.Hour), To: queryBase.Add(3 * time.Hour)}, []string{"c", "d"}},
+		{"has files", types.MessageQuery{HasFiles: &yes}, []string{"b"}},
+		{"no files", types.MessageQuery{HasFiles: &no}, []string{"c", "d", "a"}},
+		{"text is case-insensitive", types.MessageQuery{Text: "DEPLOY"}, []string{"c", "a"}},
+		{"integration token", types.MessageQuery{IntegrationTokenID: "t1"}, []string{"b"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			q := tc.query
+			q.UserID = "u"
+			page, err := st.QueryMessages(&q)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ids(page))
+		})
+	}
+}
+
+func TestQueryMessages_CleansUpMissingMessages(t *testing.T) {
+	data := queryFixture()
+	a := &types.ScheduledMessage{ID: "a", PostAt: queryBase.Add(3 * time.Hour)}
+	gone := &types.ScheduledMessage{ID: "gone", PostAt: queryBase}
+	data[testutil.IndexKey("u")] = orderedIndex(a, gone)
+	st, kvMock, _ := setupQueryStore(t, data)
+	kvMock.EXPECT().Set(testutil.IndexKey("u"), orderedIndex(a)).Return(true, nil)
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, ids(page))
+}
+
+func TestQueryMessages_CleanupErrorIsIgnored(t *testing.T) {
+	data := queryFixture()
+	a := &types.ScheduledMessage{ID: "a", PostAt: queryBase.Add(3 * time.Hour)}
+	gone := &types.ScheduledMessage{ID: "gone", PostAt: queryBase}
+	data[testutil.IndexKey("u")] = orderedIndex(a, gone)
+	st, kvMock, _ := setupQueryStore(t, data)
+	kvMock.EXPECT().Set(testutil.IndexKey("u"), gomock.Any()).Return(false, errors.New("cleanup failed"))
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, ids(page))
+}
+
+func TestQueryMessages_SkipsMessagesThatFailToLoad(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewKVStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{}, constants.MaxUserMessages).(*kvStore)
+	okMsg := &types.ScheduledMessage{ID: "ok", UserID: "u", PostAt: queryBase.Add(time.Hour)}
+	broken := &types.ScheduledMessage{ID: "broken", PostAt: queryBase}
+	kvMock.EXPECT().Get(testutil.IndexKey("u"), gomock.Any()).SetArg(1, orderedIndex(okMsg, broken)).Return(nil)
+	kvMock.EXPECT().Get(testutil.SchedKey("broken"), gomock.Any()).Return(errors.New("get error"))
+	kvMock.EXPECT().Get(testutil.SchedKey("ok"), gomock.Any()).SetArg(1, *okMsg).Return(nil)
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ok"}, ids(page))
+}
+
+func TestQueryMessages_IndexError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewKVStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{}, constants.MaxUserMessages).(*kvStore)
+	kvMock.EXPECT().Get(testutil.IndexKey("u"), gomock.Any()).Return(errors.New("list error"))
+
+	_, err := st.QueryMessages(&types.MessageQuery{UserID: "u"})
+	assert.ErrorContains(t, err, "list error")
+}
+
+func TestQueryMessages_NoMessages(t *testing.T) {
+	st, _, _ := setupQueryStore(t, map[string]any{})
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u"})
+	require.NoError(t, err)
+	assert.Empty(t, page.Messages)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestQueryMessages_ReadsOnlyThePage(t *testing.T) {
+	data := map[string]any{}
+	msgs := make([]*types.ScheduledMessage, 0, 50)
+	for i := range 50 {
+		m := &types.ScheduledMessage{ID: fmt.Sprintf("m%02d", i), UserID: "u", ChannelID: "c1", PostAt: queryBase.Add(time.Duration(i) * time.Minute)}
+		msgs = append(msgs, m)
+		data[testutil.SchedKey(m.ID)] = m
+	}
+	data[testutil.IndexKey("u")] = orderedIndex(msgs...)
+	st, _, reads := setupQueryStore(t, data)
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u", Limit: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m00", "m01", "m02", "m03", "m04"}, ids(page))
+	assert.Len(t, *reads, 1+6, "the index and one message past the page")
+
+	*reads = nil
+	page, err = st.QueryMessages(&types.MessageQuery{UserID: "u", Limit: 5, Cursor: page.NextCursor, From: queryBase.Add(30 * time.Minute)})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"m30", "m31", "m32", "m33", "m34"}, ids(page))
+	assert.Len(t, *reads, 1+6)
+}
+
+func TestQueryMessages_MigratesBareIDIndex(t *testing.T) {
+	data := queryFixture()
+	data[testutil.IndexKey("u")] = []string{"a", "b", "gone", "c", "d"}
+	st, kvMock, _ := setupQueryStore(t, data)
+	var migrated []string
+	kvMock.EXPECT().Set(testutil.IndexKey("u"), gomock.Any()).DoAndReturn(func(_ string, v any, _ ...pluginapi.KVSetOption) (bool, error) {
+		migrated = v.([]string)
+		return true, nil
+	})
+
+	page, err := st.QueryMessages(&types.MessageQuery{UserID: "u"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "d", "a"}, ids(page))
+	require.Len(t, migrated, 4)
+	for i, id := range []string{"b", "c", "d", "a"} {
+		assert.Equal(t, id, entryID(migrated[i]))
+	}
+}
+
+func TestSaveScheduledMessage_KeepsIndexInPostTimeOrder(t *testing.T) {
+	data := queryFixture()
+	st, kvMock, _ := setupQueryStore(t, data)
+	var saved []string
+	kvMock.EXPECT().Set(testutil.IndexKey("u"), gomock.Any()).DoAndReturn(func(_ string, v any, _ ...pluginapi.KVSetOption) (bool, error) {
+		saved = v.([]string)
+		return true, nil
+	})
+	moved := &types.ScheduledMessage{ID: "a", UserID: "u", ChannelID: "c1", PostAt: queryBase}
+	kvMock.EXPECT().Set(testutil.SchedKey("a"), moved).Return(true, nil)
+
+	require.NoError(t, st.SaveScheduledMessage("u", moved))
+	got := make([]string, 0, len(saved))
+	for _, entry := range saved {
+		got = append(got, entryID(entry))
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, got)
+}
+
+func TestQueryMessages_InvalidCursor(t *testing.T) {
+	st, _, _ := setupQueryStore(t, queryFixture())
+
+	_, err := st.QueryMessages(&types.MessageQuery{UserID: "u", Cursor: "!!"})
+	assert.ErrorIs(t, err, types.ErrInvalidCursor)
+}
+
+func TestListUserMessages_PostTimeOrder(t *testing.T) {
+	data := queryFixture()
+	a := &types.ScheduledMessage{ID: "a", PostAt: queryBase.Add(3 * time.Hour)}
+	b := &types.ScheduledMessage{ID: "b", PostAt: queryBase.Add(1 * time.Hour)}
+	gone := &types.ScheduledMessage{ID: "gone", PostAt: queryBase}
+	data[testutil.IndexKey("u")] = orderedIndex(a, b, gone)
+	st, kvMock, _ := setupQueryStore(t, data)
+	kvMock.EXPECT().Set(testutil.IndexKey("u"), orderedIndex(a, b)).Return(true, nil)
+
+	msgs, err := st.ListUserMessages("u")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "a"}, ids(&types.MessagePage{Messages: msgs}))
+}
+
+func TestListUserMessages_IndexError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewKVStore(testutil.FakeLogger{}, kvMock, &fakeListMatching{}, constants.MaxUserMessages).(*kvStore)
+	kvMock.EXPECT().Get(testutil.IndexKey("u"), gomock.Any()).Return(errors.New("list error"))
+
+	_, err := st.ListUserMessages("u")
+	assert.ErrorContains(t, err, "list error")
+}
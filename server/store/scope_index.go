@@ -0,0 +1,232 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// scopeIndexStore keeps, next to every message saved or deleted through the
+// wrapped store, the IDs of the pending messages in each channel and team, so
+// the policy caps can be checked without listing every message. Direct and
+// group messages belong to no team and are only indexed by channel.
+type scopeIndexStore struct {
+	ports.Store
+	logger   ports.Logger
+	kv       ports.KVService
+	channels ports.ChannelDataService
+}
+
+func NewScopeIndexStore(logger ports.Logger, inner ports.Store, kv ports.KVService, channels ports.ChannelDataService) ports.Store {
+	logger.Debug("Creating new ScopeIndexStore instance")
+	return &scopeIndexStore{Store: inner, logger: logger, kv: kv, channels: channels}
+}
+
+// SaveScheduledMessage saves msg and adds it to its channel and team index. A
+// message moved to another channel is taken out of the old channel's indexes.
+// The message is saved even if an index cannot be updated.
+func (s *scopeIndexStore) SaveScheduledMessage(userID string, msg *types.ScheduledMessage) error {
+	old, err := s.Store.GetScheduledMessage(msg.ID)
+	if err != nil && !errors.Is(err, types.ErrMessageNotFound) {
+		s.logger.Warn("Failed to get previous message for scope index", "message_id", msg.ID, "error", err)
+	}
+	if err := s.Store.SaveScheduledMessage(userID, msg); err != nil {
+		return err
+	}
+	if old != nil && old.ChannelID != msg.ChannelID {
+		s.updateScopes(old.ChannelID, msg.ID, removeScopeID)
+	}
+	s.updateScopes(msg.ChannelID, msg.ID, addScopeID)
+	return nil
+}
+
+// UpdateScheduledMessage updates the message and, if update moved it to
+// another channel, moves it between the channel and team indexes.
+func (s *scopeIndexStore) UpdateScheduledMessage(userID, msgID string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+	var oldChannelID string
+	msg, err := s.Store.UpdateScheduledMessage(userID, msgID, func(msg *types.ScheduledMessage) error {
+		oldChannelID = msg.ChannelID
+		return update(msg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if oldChannelID != msg.ChannelID {
+		s.updateScopes(oldChannelID, msgID, removeScopeID)
+		s.updateScopes(msg.ChannelID, msgID, addScopeID)
+	}
+	return msg, nil
+}
+
+// DeleteScheduledMessage deletes the message and takes it out of its channel
+// and team index.
+func (s *scopeIndexStore) DeleteScheduledMessage(userID string, msgID string) error {
+	msg, err := s.Store.GetScheduledMessage(msgID)
+	if err != nil && !errors.Is(err, types.ErrMessageNotFound) {
+		s.logger.Warn("Failed to get message for scope index", "message_id", msgID, "error", err)
+	}
+	if err := s.Store.DeleteScheduledMessage(userID, msgID); err != nil {
+		return err
+	}
+	if msg != nil {
+		s.updateScopes(msg.ChannelID, msgID, removeScopeID)
+	}
+	return nil
+}
+
+func (s *scopeIndexStore) updateScopes(channelID, msgID string, fn func([]string, string) ([]string, bool)) {
+	if err := modifyScopeIndex(s.kv, channelIndexKey(channelID), msgID, fn); err != nil {
+		s.logger.Error("Failed to update channel message index", "channel_id", channelID, "message_id", msgID, "error", err)
+	}
+	channel, err := s.channels.Get(channelID)
+	if err != nil {
+		s.logger.Warn("Failed to get channel for team message index", "channel_id", channelID, "message_id", msgID, "error", err)
+		return
+	}
+	if channel.TeamId == "" {
+		return
+	}
+	if err := modifyScopeIndex(s.kv, teamIndexKey(channel.TeamId), msgID, fn); err != nil {
+		s.logger.Error("Failed to update team message index", "team_id", channel.TeamId, "message_id", msgID, "error", err)
+	}
+}
+
+// BuildScopeIndexes builds the channel and team indexes from the messages
+// already pending, unless this version of them has been built before. It
+// reads every message once, when the plugin is first started with them.
+func BuildScopeIndexes(logger ports.Logger, kv ports.KVService, st ports.Store, channels ports.ChannelDataService) error {
+	var version int
+	if err := kv.Get(constants.ScopeIndexVersionKey, &version); err != nil {
+		return fmt.Errorf("kv.Get failed for key %s: %w", constants.ScopeIndexVersionKey, err)
+	}
+	if version >= constants.ScopeIndexVersion {
+		logger.Debug("Channel and team message indexes are up to date", "version", version)
+		return nil
+	}
+	logger.Info("Building channel and team message indexes", "version", constants.ScopeIndexVersion)
+	msgs, err := st.ListScheduledMessages()
+	if err != nil {
+		return fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+	indexes := map[string][]string{}
+	teams := map[string]string{}
+	for _, msg := range msgs {
+		indexes[channelIndexKey(msg.ChannelID)] = append(indexes[channelIndexKey(msg.ChannelID)], msg.ID)
+		teamID, ok := teams[msg.ChannelID]
+		if !ok {
+			if channel, err := channels.Get(msg.ChannelID); err == nil {
+				teamID = channel.TeamId
+			} else {
+				logger.Warn("Failed to get channel while building team message index", "channel_id", msg.ChannelID, "error", err)
+			}
+			teams[msg.ChannelID] = teamID
+		}
+		if teamID != "" {
+			indexes[teamIndexKey(teamID)] = append(indexes[teamIndexKey(teamID)], msg.ID)
+		}
+	}
+	for key, ids := range indexes {
+		if _, err := kv.Set(key, ids); err != nil {
+			return fmt.Errorf("kv.Set failed for index key %s: %w", key, err)
+		}
+	}
+	if _, err := kv.Set(constants.ScopeIndexVersionKey, constants.ScopeIndexVersion); err != nil {
+		return fmt.Errorf("kv.Set failed for key %s: %w", constants.ScopeIndexVersionKey, err)
+	}
+	logger.Info("Built channel and team message indexes", "messages", len(msgs), "indexes", len(indexes))
+	return nil
+}
+
+type kvPendingCounter struct {
+	logger ports.Logger
+	kv     ports.KVService
+}
+
+func NewPendingCounter(logger ports.Logger, kv ports.KVService) ports.PendingCounter {
+	logger.Debug("Creating new PendingCounter instance")
+	return &kvPendingCounter{logger: logger, kv: kv}
+}
+
+func (c *kvPendingCounter) CountChannelMessages(channelID string) (int, error) {
+	return c.count(channelIndexKey(channelID))
+}
+
+func (c *kvPendingCounter) CountTeamMessages(teamID string) (int, error) {
+	return c.count(teamIndexKey(teamID))
+}
+
+func (c *kvPendingCounter) count(key string) (int, error) {
+	var ids []string
+	if err := c.kv.Get(key, &ids); err != nil {
+		c.logger.Error("Failed to get message index from KV store", "key", key, "error", err)
+		return 0, fmt.Errorf("kv.Get failed for index key %s: %w", key, err)
+	}
+	c.logger.Debug("Counted pending messages", "key", key, "count", len(ids))
+	return len(ids), nil
+}
+
+// modifyScopeIndex applies fn to the index under key and writes it back with a
+// compare-and-set, so two messages saved or deleted at once in the same
+// channel or team cannot overwrite each other's change. A write that loses
+// the race is retried on the fresh index a bounded number of times. An index
+// left empty is deleted.
+func modifyScopeIndex(kv ports.KVService, key, msgID string, fn func([]string, string) ([]string, bool)) error {
+	for attempt := 0; attempt < constants.ScopeIndexRetries; attempt++ {
+		var raw []byte
+		if err := kv.Get(key, &raw); err != nil {
+			return fmt.Errorf("kv.Get failed for index key %s: %w", key, err)
+		}
+		var ids []string
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &ids); err != nil {
+				return fmt.Errorf("failed to decode index key %s: %w", key, err)
+			}
+		}
+		ids, modified := fn(ids, msgID)
+		if !modified {
+			return nil
+		}
+		var value any
+		if len(ids) > 0 {
+			value = ids
+		}
+		saved, err := kv.Set(key, value, pluginapi.SetAtomic(raw))
+		if err != nil {
+			return fmt.Errorf("kv.Set failed for index key %s: %w", key, err)
+		}
+		if saved {
+			return nil
+		}
+	}
+	return fmt.Errorf("index key %s kept changing, gave up after %d attempts", key, constants.ScopeIndexRetries)
+}
+
+func addScopeID(ids []string, msgID string) ([]string, bool) {
+	if slices.Contains(ids, msgID) {
+		return ids, false
+	}
+	return append(ids, msgID), true
+}
+
+func removeScopeID(ids []string, msgID string) ([]string, bool) {
+	i := slices.Index(ids, msgID)
+	if i == -1 {
+		return ids, false
+	}
+	return slices.Delete(ids, i, i+1), true
+}
+
+func channelIndexKey(channelID string) string {
+	return fmt.Sprintf("%s%s", constants.ChannelIndexPrefix, channelID)
+}
+
+func teamIndexKey(teamID string) string {
+	return fmt.Sprintf("%s%s", constants.TeamIndexPrefix, teamID)
+}
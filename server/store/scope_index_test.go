@@ -0,0 +1,287 @@
+package store
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/mattermost/mattermost/server/public/model"
+	"github.com/mattermost/mattermost/server/public/plugin/plugintest"
+	"github.com/mattermost/mattermost/server/public/pluginapi"
+	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// memKV backs the pluginapi KV service with data the way the server does:
+// values are stored as JSON, and an atomic write only goes through if the
+// stored value is still the one it expects. beforeSet, if not nil, runs before
+// every write so a test can slip a competing write in.
+func memKV(data map[string][]byte, beforeSet func(key string)) ports.KVService {
+	api := &plugintest.API{}
+	api.On("KVGet", testifymock.Anything).Return(func(key string) ([]byte, *model.AppError) {
+		return data[key], nil
+	})
+	api.On("KVSetWithOptions", testifymock.Anything, testifymock.Anything, testifymock.Anything).Return(func(key string, value []byte, opts model.PluginKVSetOptions) (bool, *model.AppError) {
+		if beforeSet != nil {
+			beforeSet(key)
+		}
+		if opts.Atomic && !bytes.Equal(data[key], opts.OldValue) {
+			return false, nil
+		}
+		if value == nil {
+			delete(data, key)
+		} else {
+			data[key] = value
+		}
+		return true, nil
+	})
+	api.On("KVDelete", testifymock.Anything).Return(func(key string) *model.AppError {
+		delete(data, key)
+		return nil
+	})
+	return &pluginapi.NewClient(api, nil).KV
+}
+
+func indexIDs(t *testing.T, data map[string][]byte, key string) []string {
+	t.Helper()
+	raw, ok := data[key]
+	if !ok {
+		return nil
+	}
+	var ids []string
+	require.NoError(t, json.Unmarshal(raw, &ids))
+	return ids
+}
+
+type scopeMocks struct {
+	inner     *mock.MockStore
+	channels  *mock.MockChannelDataService
+	data      map[string][]byte
+	beforeSet func(key string)
+}
+
+func setupScopeStore(t *testing.T) (ports.Store, *scopeMocks) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	m := &scopeMocks{
+		inner:    mock.NewMockStore(ctrl),
+		channels: mock.NewMockChannelDataService(ctrl),
+		data:     map[string][]byte{},
+	}
+	m.channels.EXPECT().Get("c1").Return(&model.Channel{Id: "c1", TeamId: "t1"}, nil).AnyTimes()
+	m.channels.EXPECT().Get("c2").Return(&model.Channel{Id: "c2", TeamId: "t2"}, nil).AnyTimes()
+	m.channels.EXPECT().Get("dm").Return(&model.Channel{Id: "dm"}, nil).AnyTimes()
+	kv := memKV(m.data, func(key string) {
+		if m.beforeSet != nil {
+			m.beforeSet(key)
+		}
+	})
+	return NewScopeIndexStore(testutil.FakeLogger{}, m.inner, kv, m.channels), m
+}
+
+func TestScopeIndexStore_SaveIndexesChannelAndTeam(t *testing.T) {
+	st, m := setupScopeStore(t)
+	for _, msg := range []*types.ScheduledMessage{{ID: "a", ChannelID: "c1"}, {ID: "b", ChannelID: "c1"}, {ID: "c", ChannelID: "dm"}} {
+		m.inner.EXPECT().GetScheduledMessage(msg.ID).Return(nil, types.ErrMessageNotFound)
+		m.inner.EXPECT().SaveScheduledMessage("u1", msg).Return(nil)
+		require.NoError(t, st.SaveScheduledMessage("u1", msg))
+	}
+
+	assert.Equal(t, []string{"a", "b"}, indexIDs(t, m.data, channelIndexKey("c1")))
+	assert.Equal(t, []string{"a", "b"}, indexIDs(t, m.data, teamIndexKey("t1")))
+	assert.Equal(t, []string{"c"}, indexIDs(t, m.data, channelIndexKey("dm")))
+	assert.NotContains(t, m.data, teamIndexKey(""))
+}
+
+func TestScopeIndexStore_ResaveIsIdempotent(t *testing.T) {
+	st, m := setupScopeStore(t)
+	msg := &types.ScheduledMessage{ID: "a", ChannelID: "c1"}
+	m.inner.EXPECT().GetScheduledMessage("a").Return(nil, types.ErrMessageNotFound)
+	m.inner.EXPECT().GetScheduledMessage("a").Return(msg, nil)
+	m.inner.EXPECT().SaveScheduledMessage("u1", msg).Return(nil).Times(2)
+
+	require.NoError(t, st.SaveScheduledMessage("u1", msg))
+	require.NoError(t, st.SaveScheduledMessage("u1", msg))
+
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, channelIndexKey("c1")))
+}
+
+func TestScopeIndexStore_SaveMovesChannel(t *testing.T) {
+	st, m := setupScopeStore(t)
+	m.data[channelIndexKey("c1")] = []byte(`["a","b"]`)
+	m.data[teamIndexKey("t1")] = []byte(`["a","b"]`)
+	moved := &types.ScheduledMessage{ID: "a", ChannelID: "c2"}
+	m.inner.EXPECT().GetScheduledMessage("a").Return(&types.ScheduledMessage{ID: "a", ChannelID: "c1"}, nil)
+	m.inner.EXPECT().SaveScheduledMessage("u1", moved).Return(nil)
+
+	require.NoError(t, st.SaveScheduledMessage("u1", moved))
+
+	assert.Equal(t, []string{"b"}, indexIDs(t, m.data, channelIndexKey("c1")))
+	assert.Equal(t, []string{"b"}, indexIDs(t, m.data, teamIndexKey("t1")))
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, channelIndexKey("c2")))
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, teamIndexKey("t2")))
+}
+
+func TestScopeIndexStore_UpdateMovesChannel(t *testing.T) {
+	st, m := setupScopeStore(t)
+	m.data[channelIndexKey("c1")] = []byte(`["a","b"]`)
+	m.data[teamIndexKey("t1")] = []byte(`["a","b"]`)
+	stored := &types.ScheduledMessage{ID: "a", ChannelID: "c1"}
+	m.inner.EXPECT().UpdateScheduledMessage("u1", "a", gomock.Any()).DoAndReturn(func(_, _ string, update func(*types.ScheduledMessage) error) (*types.ScheduledMessage, error) {
+		return stored, update(stored)
+	})
+
+	_, err := st.UpdateScheduledMessage("u1", "a", func(msg *types.ScheduledMessage) error {
+		msg.ChannelID = "c2"
+		return nil
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b"}, indexIDs(t, m.data, channelIndexKey("c1")))
+	assert.Equal(t, []string{"b"}, indexIDs(t, m.data, teamIndexKey("t1")))
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, channelIndexKey("c2")))
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, teamIndexKey("t2")))
+}
+
+func TestScopeIndexStore_UpdateErrorLeavesIndexes(t *testing.T) {
+	st, m := setupScopeStore(t)
+	m.data[channelIndexKey("c1")] = []byte(`["a"]`)
+	m.inner.EXPECT().UpdateScheduledMessage("u1", "a", gomock.Any()).Return(nil, types.ErrMessageNotFound)
+
+	_, err := st.UpdateScheduledMessage("u1", "a", func(*types.ScheduledMessage) error { return nil })
+	assert.ErrorIs(t, err, types.ErrMessageNotFound)
+
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, channelIndexKey("c1")))
+}
+
+func TestScopeIndexStore_ConcurrentSavesKeepBothEntries(t *testing.T) {
+	st, m := setupScopeStore(t)
+	a := &types.ScheduledMessage{ID: "a", ChannelID: "c1"}
+	b := &types.ScheduledMessage{ID: "b", ChannelID: "c1"}
+	for _, msg := range []*types.ScheduledMessage{a, b} {
+		m.inner.EXPECT().GetScheduledMessage(msg.ID).Return(nil, types.ErrMessageNotFound)
+		m.inner.EXPECT().SaveScheduledMessage(msg.UserID, msg).Return(nil)
+	}
+	// b is saved after a has read the channel index but before it writes it.
+	m.beforeSet = func(key string) {
+		if key == channelIndexKey("c1") {
+			m.beforeSet = nil
+			require.NoError(t, st.SaveScheduledMessage(b.UserID, b))
+		}
+	}
+
+	require.NoError(t, st.SaveScheduledMessage(a.UserID, a))
+
+	assert.ElementsMatch(t, []string{"a", "b"}, indexIDs(t, m.data, channelIndexKey("c1")))
+	assert.ElementsMatch(t, []string{"a", "b"}, indexIDs(t, m.data, teamIndexKey("t1")))
+}
+
+func TestModifyScopeIndex_GivesUpWhenIndexKeepsChanging(t *testing.T) {
+	data := map[string][]byte{}
+	key := channelIndexKey("c1")
+	writes := 0
+	kv := memKV(data, func(string) {
+		writes++
+		data[key] = []byte(fmt.Sprintf(`["other-%d"]`, writes))
+	})
+
+	err := modifyScopeIndex(kv, key, "a", addScopeID)
+
+	assert.ErrorContains(t, err, "gave up")
+	assert.Equal(t, constants.ScopeIndexRetries, writes)
+}
+
+func TestScopeIndexStore_SaveErrorLeavesIndexes(t *testing.T) {
+	st, m := setupScopeStore(t)
+	msg := &types.ScheduledMessage{ID: "a", ChannelID: "c1"}
+	m.inner.EXPECT().GetScheduledMessage("a").Return(nil, types.ErrMessageNotFound)
+	m.inner.EXPECT().SaveScheduledMessage("u1", msg).Return(ErrUserMessageLimit)
+
+	assert.ErrorIs(t, st.SaveScheduledMessage("u1", msg), ErrUserMessageLimit)
+	assert.Empty(t, m.data)
+}
+
+func TestScopeIndexStore_DeleteRemovesFromIndexes(t *testing.T) {
+	st, m := setupScopeStore(t)
+	m.data[channelIndexKey("c1")] = []byte(`["a"]`)
+	m.data[teamIndexKey("t1")] = []byte(`["a","b"]`)
+	m.inner.EXPECT().GetScheduledMessage("a").Return(&types.ScheduledMessage{ID: "a", ChannelID: "c1"}, nil)
+	m.inner.EXPECT().DeleteScheduledMessage("u1", "a").Return(nil)
+
+	require.NoError(t, st.DeleteScheduledMessage("u1", "a"))
+
+	assert.NotContains(t, m.data, channelIndexKey("c1"))
+	assert.Equal(t, []string{"b"}, indexIDs(t, m.data, teamIndexKey("t1")))
+}
+
+func TestScopeIndexStore_DeleteErrorLeavesIndexes(t *testing.T) {
+	st, m := setupScopeStore(t)
+	m.data[channelIndexKey("c1")] = []byte(`["a"]`)
+	m.inner.EXPECT().GetScheduledMessage("a").Return(&types.ScheduledMessage{ID: "a", ChannelID: "c1"}, nil)
+	m.inner.EXPECT().DeleteScheduledMessage("u1", "a").Return(errors.New("kv down"))
+
+	assert.Error(t, st.DeleteScheduledMessage("u1", "a"))
+	assert.Equal(t, []string{"a"}, indexIDs(t, m.data, channelIndexKey("c1")))
+}
+
+func TestBuildScopeIndexes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	data := map[string][]byte{}
+	kv := memKV(data, nil)
+	inner := mock.NewMockStore(ctrl)
+	channels := mock.NewMockChannelDataService(ctrl)
+	channels.EXPECT().Get("c1").Return(&model.Channel{Id: "c1", TeamId: "t1"}, nil)
+	channels.EXPECT().Get("c2").Return(&model.Channel{Id: "c2", TeamId: "t1"}, nil)
+	channels.EXPECT().Get("gone").Return(nil, errors.New("not found"))
+	inner.EXPECT().ListScheduledMessages().Return([]*types.ScheduledMessage{
+		{ID: "a", ChannelID: "c1"}, {ID: "b", ChannelID: "c2"}, {ID: "c", ChannelID: "c1"}, {ID: "d", ChannelID: "gone"},
+	}, nil)
+
+	require.NoError(t, BuildScopeIndexes(testutil.FakeLogger{}, kv, inner, channels))
+
+	assert.Equal(t, []string{"a", "c"}, indexIDs(t, data, channelIndexKey("c1")))
+	assert.Equal(t, []string{"d"}, indexIDs(t, data, channelIndexKey("gone")))
+	assert.Equal(t, []string{"a", "b", "c"}, indexIDs(t, data, teamIndexKey("t1")))
+	assert.Equal(t, "1", string(data[constants.ScopeIndexVersionKey]))
+
+	// A second start finds the indexes built and lists nothing.
+	require.NoError(t, BuildScopeIndexes(testutil.FakeLogger{}, kv, inner, channels))
+}
+
+func TestPendingCounter(t *testing.T) {
+	data := map[string][]byte{
+		channelIndexKey("c1"): []byte(`["a","b"]`),
+		teamIndexKey("t1"):    []byte(`["a","b","c"]`),
+	}
+	counter := NewPendingCounter(testutil.FakeLogger{}, memKV(data, nil))
+
+	count, err := counter.CountChannelMessages("c1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	count, err = counter.CountTeamMessages("t1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	count, err = counter.CountChannelMessages("empty")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestPendingCounter_GetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	kvMock := mock.NewMockKVService(ctrl)
+	kvMock.EXPECT().Get(channelIndexKey("c1"), gomock.Any()).Return(errors.New("kv down"))
+	counter := NewPendingCounter(testutil.FakeLogger{}, kvMock)
+
+	_, err := counter.CountChannelMessages("c1")
+	assert.ErrorContains(t, err, "kv down")
+}
@@ -0,0 +1,59 @@
+package store
+
+import (
+	"fmt"
+	"sync"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+type kvWebhookDeliveryStore struct {
+	logger  ports.Logger
+	kv      ports.KVService
+	maxSize int
+	mu      sync.Mutex
+}
+
+func NewWebhookDeliveryStore(logger ports.Logger, kv ports.KVService, maxSize int) ports.WebhookDeliveryStore {
+	logger.Debug("Creating new WebhookDeliveryStore instance")
+	return &kvWebhookDeliveryStore{logger: logger, kv: kv, maxSize: maxSize}
+}
+
+// SaveDelivery prepends the delivery to the log, dropping the oldest entries
+// beyond maxSize.
+func (s *kvWebhookDeliveryStore) SaveDelivery(delivery *types.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logger.Debug("Saving webhook delivery", "delivery_id", delivery.ID, "success", delivery.Success)
+	deliveries, err := s.list()
+	if err != nil {
+		return err
+	}
+	deliveries = append([]*types.WebhookDelivery{delivery}, deliveries...)
+	if len(deliveries) > s.maxSize {
+		deliveries = deliveries[:s.maxSize]
+	}
+	if _, err := s.kv.Set(constants.WebhookDeliveriesKey, deliveries); err != nil {
+		s.logger.Error("Failed to save webhook delivery log", "key", constants.WebhookDeliveriesKey, "error", err)
+		return fmt.Errorf("kv.Set failed for key %s: %w", constants.WebhookDeliveriesKey, err)
+	}
+	return nil
+}
+
+func (s *kvWebhookDeliveryStore) ListDeliveries() ([]*types.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list()
+}
+
+func (s *kvWebhookDeliveryStore) list() ([]*types.WebhookDelivery, error) {
+	var deliveries []*types.WebhookDelivery
+	if err := s.kv.Get(constants.WebhookDeliveriesKey, &deliveries); err != nil {
+		s.logger.Error("Failed to get webhook delivery log", "key", constants.WebhookDeliveriesKey, "error", err)
+		return nil, fmt.Errorf("kv.Get failed for key %s: %w", constants.WebhookDeliveriesKey, err)
+	}
+	return deliveries, nil
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+func TestWebhookDeliveryStore_SaveDelivery_PrependsAndCaps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewWebhookDeliveryStore(testutil.FakeLogger{}, kvMock, 2)
+
+	existing := []*types.WebhookDelivery{{ID: "b"}, {ID: "a"}}
+	kvMock.EXPECT().Get(constants.WebhookDeliveriesKey, gomock.Any()).SetArg(1, existing).Return(nil)
+	kvMock.EXPECT().Set(constants.WebhookDeliveriesKey, gomock.Any()).DoAndReturn(func(_ string, v any, _ ...any) (bool, error) {
+		saved := v.([]*types.WebhookDelivery)
+		require.Len(t, saved, 2)
+		assert.Equal(t, "c", saved[0].ID)
+		assert.Equal(t, "b", saved[1].ID)
+		return true, nil
+	})
+
+	require.NoError(t, st.SaveDelivery(&types.WebhookDelivery{ID: "c"}))
+}
+
+func TestWebhookDeliveryStore_SaveDelivery_SetError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewWebhookDeliveryStore(testutil.FakeLogger{}, kvMock, 2)
+
+	kvMock.EXPECT().Get(constants.WebhookDeliveriesKey, gomock.Any()).Return(nil)
+	kvMock.EXPECT().Set(constants.WebhookDeliveriesKey, gomock.Any()).Return(false, errors.New("boom"))
+
+	require.Error(t, st.SaveDelivery(&types.WebhookDelivery{ID: "c"}))
+}
+
+func TestWebhookDeliveryStore_ListDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	kvMock := mock.NewMockKVService(ctrl)
+	st := NewWebhookDeliveryStore(testutil.FakeLogger{}, kvMock, 2)
+
+	kvMock.EXPECT().Get(constants.WebhookDeliveriesKey, gomock.Any()).SetArg(1, []*types.WebhookDelivery{{ID: "a"}}).Return(nil)
+
+	got, err := st.ListDeliveries()
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "a", got[0].ID)
+}
@@ -0,0 +1,71 @@
+package types
+
+import "time"
+
+type AuditOutcome string
+
+const (
+	AuditSuccess AuditOutcome = "success"
+	AuditFailure AuditOutcome = "failure"
+)
+
+// AuditEntry is the persisted record of one lifecycle event. Like the event it
+// is built from, it carries no message text.
+type AuditEntry struct {
+	ID        string       `json:"id"`
+	Timestamp time.Time    `json:"timestamp"`
+	ActorID   string       `json:"actor_id"`
+	Action    EventType    `json:"action"`
+	MessageID string       `json:"message_id"`
+	UserID    string       `json:"user_id"`
+	ChannelID string       `json:"channel_id"`
+	PostAt    time.Time    `json:"post_at"`
+	PostID    string       `json:"post_id,omitempty"`
+	Outcome   AuditOutcome `json:"outcome"`
+	Error     string       `json:"error,omitempty"`
+}
+
+func NewAuditEntry(id string, event *LifecycleEvent) *AuditEntry {
+	outcome := AuditSuccess
+	if event.Type == EventFailed {
+		outcome = AuditFailure
+	}
+	return &AuditEntry{
+		ID:        id,
+		Timestamp: event.Timestamp.UTC(),
+		ActorID:   event.ActorID,
+		Action:    event.Type,
+		MessageID: event.MessageID,
+		UserID:    event.UserID,
+		ChannelID: event.ChannelID,
+		PostAt:    event.PostAt.UTC(),
+		PostID:    event.PostID,
+		Outcome:   outcome,
+		Error:     event.Error,
+	}
+}
+
+// AuditQuery selects audit entries. Zero-valued fields do not filter. From is
+// inclusive and To is exclusive.
+type AuditQuery struct {
+	From      time.Time
+	To        time.Time
+	ActorID   string
+	UserID    string
+	ChannelID string
+	MessageID string
+	Action    EventType
+	Outcome   AuditOutcome
+	Limit     int
+}
+
+// Matches reports whether the entry passes every filter except the time range,
+// which the store applies from the key.
+func (q *AuditQuery) Matches(entry *AuditEntry) bool {
+	return (q.ActorID == "" || entry.ActorID == q.ActorID) &&
+		(q.UserID == "" || entry.UserID == q.UserID) &&
+		(q.ChannelID == "" || entry.ChannelID == q.ChannelID) &&
+		(q.MessageID == "" || entry.MessageID == q.MessageID) &&
+		(q.Action == "" || entry.Action == q.Action) &&
+		(q.Outcome == "" || entry.Outcome == q.Outcome)
+}
@@ -0,0 +1,28 @@
+package types
+
+import "fmt"
+
+// DeactivationMode decides what happens to a user's pending messages when
+// their account is deactivated.
+type DeactivationMode string
+
+const (
+	// DeactivationHold sets the messages aside and restores them if the user
+	// is reactivated.
+	DeactivationHold DeactivationMode = "hold"
+	// DeactivationCancel deletes the messages.
+	DeactivationCancel DeactivationMode = "cancel"
+)
+
+// ParseDeactivationMode parses a deactivation mode, defaulting to
+// DeactivationHold when s is empty.
+func ParseDeactivationMode(s string) (DeactivationMode, error) {
+	switch DeactivationMode(s) {
+	case "", DeactivationHold:
+		return DeactivationHold, nil
+	case DeactivationCancel:
+		return DeactivationCancel, nil
+	default:
+		return "", fmt.Errorf("unknown deactivated user handling %q, use %s or %s", s, DeactivationHold, DeactivationCancel)
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import "time"
+
+type EventType string
+
+const (
+	EventScheduled EventType = "message.scheduled"
+	EventEdited    EventType = "message.edited"
+	EventCancelled EventType = "message.cancelled"
+	EventSent      EventType = "message.sent"
+	EventFailed    EventType = "message.failed"
+)
+
+// LifecycleEvent describes something that happened to a scheduled message. It
+// deliberately carries no message text so it can be shared with external systems.
+// Timestamp is left zero by NewLifecycleEvent and stamped by the notifier.
+type LifecycleEvent struct {
+	Type      EventType `json:"event"`
+	Timestamp time.Time `json:"timestamp"`
+	ActorID   string    `json:"actor_id"`
+	MessageID string    `json:"message_id"`
+	UserID    string    `json:"user_id"`
+	ChannelID string    `json:"channel_id"`
+	PostAt    time.Time `json:"post_at"`
+	Timezone  string    `json:"timezone"`
+	FileCount int       `json:"file_count"`
+	PostID    string    `json:"post_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+func NewLifecycleEvent(eventType EventType, msg *ScheduledMessage, actorID string) *LifecycleEvent {
+	return &LifecycleEvent{
+		Type:      eventType,
+		ActorID:   actorID,
+		MessageID: msg.ID,
+		UserID:    msg.UserID,
+		ChannelID: msg.ChannelID,
+		PostAt:    msg.PostAt.UTC(),
+		Timezone:  msg.Timezone,
+		FileCount: len(msg.FileIDs),
+	}
+}
+
+// WebhookDelivery records the outcome of sending one event to one webhook endpoint.
+type WebhookDelivery struct {
+	ID          string    `json:"id"`
+	Event       EventType `json:"event"`
+	MessageID   string    `json:"message_id"`
+	URL         string    `json:"url"`
+	Attempts    int       `json:"attempts"`
+	StatusCode  int       `json:"status_code"`
+	Success     bool      `json:"success"`
+	Error       string    `json:"error,omitempty"`
+	DeliveredAt time.Time `json:"delivered_at"`
+}
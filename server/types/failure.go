@@ -0,0 +1,24 @@
+package types
+
+import "time"
+
+// FailureReason is why a due message could not be posted, in terms its owner
+// can act on.
+type FailureReason string
+
+const (
+	FailureChannelArchived FailureReason = "channel_archived"
+	FailureNoPermission    FailureReason = "no_permission"
+	FailureFilesMissing    FailureReason = "files_missing"
+	FailureTooLong         FailureReason = "too_long"
+	FailureServerError     FailureReason = "server_error"
+)
+
+// FailedMessage is a message that could not be posted. It is kept for a while
+// so its owner can retry, reschedule or retarget it from the failure DM.
+type FailedMessage struct {
+	Message  *ScheduledMessage `json:"message"`
+	Reason   FailureReason     `json:"reason"`
+	Error    string            `json:"error"`
+	FailedAt time.Time         `json:"failed_at"`
+}
@@ -0,0 +1,23 @@
+package types
+
+import "time"
+
+type HistoryStatus string
+
+const (
+	HistorySent   HistoryStatus = "sent"
+	HistoryFailed HistoryStatus = "failed"
+)
+
+// HistoryEntry records the delivery of one scheduled message. PostID is set
+// for sent messages and Error for failed ones.
+type HistoryEntry struct {
+	MessageID string        `json:"message_id"`
+	ChannelID string        `json:"channel_id"`
+	PostID    string        `json:"post_id,omitempty"`
+	PostAt    time.Time     `json:"post_at"`
+	SentAt    time.Time     `json:"sent_at"`
+	Timezone  string        `json:"timezone"`
+	Status    HistoryStatus `json:"status"`
+	Error     string        `json:"error,omitempty"`
+}
@@ -0,0 +1,9 @@
+package types
+
+// IdempotencyRecord remembers a schedule request made with an idempotency key
+// so a retry can be answered with the original result. Message is nil while
+// the original request is still being processed.
+type IdempotencyRecord struct {
+	Fingerprint string            `json:"fingerprint"`
+	Message     *ScheduledMessage `json:"message,omitempty"`
+}
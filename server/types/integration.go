@@ -0,0 +1,23 @@
+package types
+
+import (
+	"slices"
+	"time"
+)
+
+// IntegrationToken lets an external system schedule messages through the REST
+// API without a Mattermost session. Only the SHA-256 hash of the secret is stored.
+type IntegrationToken struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	TokenHash  string    `json:"token_hash"`
+	ChannelIDs []string  `json:"channel_ids"`
+	BotUserID  string    `json:"bot_user_id"`
+	CreatedBy  string    `json:"created_by"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// AllowsChannel reports whether the token is scoped to channelID.
+func (t *IntegrationToken) AllowsChannel(channelID string) bool {
+	return channelID != "" && slices.Contains(t.ChannelIDs, channelID)
+}
@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// Limits are the admin-configurable bounds on scheduling. A zero MaxHorizon
+// lets messages be scheduled any distance ahead.
+type Limits struct {
+	MaxUserMessages int
+	MaxMessageBytes int
+	MaxFileCount    int
+	DefaultTimezone string
+	MaxHorizon      time.Duration
+}
@@ -0,0 +1,47 @@
+package types
+
+import "github.com/mattermost/mattermost/server/public/model"
+
+// Message priorities a scheduled message may be posted with.
+const (
+	PriorityImportant = "important"
+	PriorityUrgent    = model.PostPriorityUrgent
+)
+
+// PostMetadata is what a scheduled message carries into its post besides the
+// text and files: a priority, message attachments and custom props.
+type PostMetadata struct {
+	// Priority is "important" or "urgent", or empty for a standard post.
+	Priority string `json:"priority,omitempty"`
+	// RequestedAck asks readers to acknowledge the post.
+	RequestedAck bool                     `json:"requested_ack,omitempty"`
+	Attachments  []*model.SlackAttachment `json:"attachments,omitempty"`
+	Props        map[string]any           `json:"props,omitempty"`
+}
+
+// IsEmpty reports whether m would leave a post unchanged.
+func (m *PostMetadata) IsEmpty() bool {
+	return m == nil || (m.Priority == "" && !m.RequestedAck && len(m.Attachments) == 0 && len(m.Props) == 0)
+}
+
+// ApplyTo sets m's props, attachments and priority on post.
+func (m *PostMetadata) ApplyTo(post *model.Post) {
+	if m.IsEmpty() {
+		return
+	}
+	for key, value := range m.Props {
+		post.AddProp(key, value)
+	}
+	if len(m.Attachments) > 0 {
+		model.ParseSlackAttachment(post, m.Attachments)
+	}
+	if m.Priority != "" || m.RequestedAck {
+		if post.Metadata == nil {
+			post.Metadata = &model.PostMetadata{}
+		}
+		post.Metadata.Priority = &model.PostPriority{
+			Priority:     model.NewPointer(m.Priority),
+			RequestedAck: model.NewPointer(m.RequestedAck),
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/mattermost/mattermost/server/public/model"
+)
+
+func TestPostMetadataApplyTo(t *testing.T) {
+	meta := &PostMetadata{
+		Priority:     PriorityUrgent,
+		RequestedAck: true,
+		Attachments:  []*model.SlackAttachment{{Title: "Release"}},
+		Props:        map[string]any{"release": "2.0"},
+	}
+	post := &model.Post{Message: "hello"}
+
+	meta.ApplyTo(post)
+
+	if post.GetProp("release") != "2.0" {
+		t.Fatalf("expected custom prop to be set, got %v", post.GetProps())
+	}
+	if len(post.Attachments()) != 1 || post.Attachments()[0].Title != "Release" {
+		t.Fatalf("expected attachment to be set, got %+v", post.Attachments())
+	}
+	priority := post.GetPriority()
+	if priority == nil || *priority.Priority != PriorityUrgent || !*priority.RequestedAck {
+		t.Fatalf("expected urgent priority with acknowledgement, got %+v", priority)
+	}
+}
+
+func TestPostMetadataApplyTo_Empty(t *testing.T) {
+	post := &model.Post{Message: "hello"}
+
+	var meta *PostMetadata
+	meta.ApplyTo(post)
+	(&PostMetadata{}).ApplyTo(post)
+
+	if len(post.GetProps()) != 0 || post.Metadata != nil {
+		t.Fatalf("expected post to be unchanged, got props %v and metadata %+v", post.GetProps(), post.Metadata)
+	}
+}
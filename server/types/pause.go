@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrDeliveryNotPaused is returned when resuming delivery that is not paused.
+var ErrDeliveryNotPaused = errors.New("delivery is not paused")
+
+// ErrDeliveryPaused is returned when asked to send a message right away while
+// an admin has paused delivery.
+var ErrDeliveryPaused = errors.New("delivery of scheduled messages is paused by a System Admin")
+
+// ResumeMode is the overdue-message policy applied when paused delivery
+// resumes. It decides what happens to messages that came due while paused.
+type ResumeMode string
+
+const (
+	// ResumeSend posts overdue messages late, on the next scheduler tick.
+	ResumeSend ResumeMode = "send"
+	// ResumeSkip drops overdue messages and returns them to their owners by DM.
+	ResumeSkip ResumeMode = "skip"
+)
+
+// ParseResumeMode parses a resume mode, defaulting to ResumeSend when s is
+// empty.
+func ParseResumeMode(s string) (ResumeMode, error) {
+	switch ResumeMode(strings.ToLower(strings.TrimSpace(s))) {
+	case "", ResumeSend:
+		return ResumeSend, nil
+	case ResumeSkip:
+		return ResumeSkip, nil
+	default:
+		return "", fmt.Errorf("unknown resume mode %q, use %s or %s", s, ResumeSend, ResumeSkip)
+	}
+}
+
+// PauseState records that a System Admin paused delivery of every scheduled
+// message. ResumeAt is nil when delivery stays paused until resumed by hand;
+// ResumeMode is applied when it resumes on its own.
+type PauseState struct {
+	PausedBy   string     `json:"paused_by"`
+	PausedAt   time.Time  `json:"paused_at"`
+	ResumeAt   *time.Time `json:"resume_at,omitempty"`
+	ResumeMode ResumeMode `json:"resume_mode"`
+}
+
+// ResumeResult describes a resume: the pause that ended, the mode applied and
+// how many overdue messages were dropped.
+type ResumeResult struct {
+	Pause   *PauseState `json:"pause"`
+	Mode    ResumeMode  `json:"mode"`
+	Skipped int         `json:"skipped"`
+}
@@ -0,0 +1,44 @@
+package types
+
+import "errors"
+
+// ErrPolicyDenied is wrapped by every error returned when a scheduling policy
+// rejects a message, so callers can tell policy denials from other failures.
+var ErrPolicyDenied = errors.New("not allowed by policy")
+
+// Policy is the System Console scheduling policy. Empty allow lists allow
+// everything; zero caps are unlimited.
+type Policy struct {
+	AllowedChannelIDs  []string
+	DeniedChannelIDs   []string
+	AllowedTeamIDs     []string
+	DeniedTeamIDs      []string
+	MaxChannelMessages int
+	MaxTeamMessages    int
+	ChannelAdminRules  bool
+	// QuietHours maps a team or channel ID to its quiet hours. A channel's own
+	// entry wins over its team's.
+	QuietHours map[string]QuietHours
+	Holidays   []string
+	// BotDelivery lets users have their messages posted by the bot.
+	BotDelivery bool
+}
+
+// ChannelPolicy is a per-channel rule set by a channel admin or System Admin.
+// It can only tighten the System Console policy.
+type ChannelPolicy struct {
+	ChannelID  string `json:"channel_id"`
+	Disabled   bool   `json:"disabled"`
+	MaxPending int    `json:"max_pending"`
+	UpdatedBy  string `json:"updated_by"`
+}
+
+// PolicyDeniedError explains why a policy rejected a message. Its text is
+// meant for the person who tried to schedule it.
+type PolicyDeniedError struct {
+	Reason string
+}
+
+func (e *PolicyDeniedError) Error() string { return e.Reason }
+
+func (e *PolicyDeniedError) Unwrap() error { return ErrPolicyDenied }
@@ -0,0 +1,46 @@
+package types
+
+// DigestFrequency is how often a user is sent a digest of their upcoming
+// scheduled messages.
+type DigestFrequency string
+
+const (
+	DigestOff    DigestFrequency = ""
+	DigestDaily  DigestFrequency = "daily"
+	DigestWeekly DigestFrequency = "weekly"
+)
+
+// UserPreferences are a user's defaults for the messages they schedule.
+type UserPreferences struct {
+	// ReminderMinutes is how long before each new message is posted the user
+	// is sent a reminder, or 0 for none. It can be overridden per message.
+	ReminderMinutes int `json:"reminder_minutes,omitempty"`
+	// ConfirmDelivery sends the user a DM with a link to each message once it
+	// has been posted.
+	ConfirmDelivery bool `json:"confirm_delivery,omitempty"`
+	// Digest is how often the user is sent a list of their upcoming messages.
+	Digest DigestFrequency `json:"digest,omitempty"`
+	// DefaultTime is the time of day, as "15:04", messages scheduled with only
+	// a date are posted at. Empty means the server default.
+	DefaultTime string `json:"default_time,omitempty"`
+	// Use24Hour shows times on a 24-hour clock instead of AM/PM.
+	Use24Hour bool `json:"use_24_hour,omitempty"`
+	// Timezone overrides the timezone from the user's Mattermost profile for
+	// scheduling and display. Empty means use the profile.
+	Timezone string `json:"timezone,omitempty"`
+	// ListPageSize is how many messages a page of the list shows, or 0 for
+	// the server default.
+	ListPageSize int `json:"list_page_size,omitempty"`
+}
+
+// PreferenceError is returned when a preference is given an invalid value.
+// Field is the JSON name of the preference, so it can be shown next to the
+// right field of the settings dialog.
+type PreferenceError struct {
+	Field   string
+	Message string
+}
+
+func (e *PreferenceError) Error() string {
+	return e.Message
+}
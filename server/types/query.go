@@ -0,0 +1,39 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+type SortOrder string
+
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+// MessageQuery selects a user's scheduled messages. Zero-valued fields do not
+// filter. From is inclusive and To is exclusive.
+type MessageQuery struct {
+	UserID     string
+	ChannelIDs []string
+	From       time.Time
+	To         time.Time
+	HasFiles   *bool
+	Text       string
+	Sort       SortOrder
+	Cursor     string
+	Limit      int
+
+	// IntegrationTokenID, if set, keeps only messages scheduled with that token.
+	IntegrationTokenID string
+}
+
+// MessagePage is one page of query results. NextCursor is empty on the last page.
+type MessagePage struct {
+	Messages   []*ScheduledMessage `json:"messages"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
@@ -0,0 +1,25 @@
+package types
+
+type QuietHoursAction string
+
+const (
+	// QuietHoursReject refuses messages scheduled inside quiet hours and
+	// suggests the next allowed time instead.
+	QuietHoursReject QuietHoursAction = "reject"
+	// QuietHoursShift moves messages scheduled inside quiet hours to the next
+	// allowed time.
+	QuietHoursShift QuietHoursAction = "shift"
+)
+
+// QuietHours is a window in which scheduled messages must not be posted to a
+// team or channel. Start and End are "15:04" clock times; a window whose End is
+// before its Start runs overnight. An empty Timezone means the scheduling
+// user's timezone. Holidays are whole "2006-01-02" days that are also quiet.
+type QuietHours struct {
+	Start    string           `json:"start,omitempty"`
+	End      string           `json:"end,omitempty"`
+	Timezone string           `json:"timezone,omitempty"`
+	Weekends bool             `json:"weekends,omitempty"`
+	Action   QuietHoursAction `json:"action"`
+	Holidays []string         `json:"holidays,omitempty"`
+}
@@ -0,0 +1,44 @@
+package types
+
+import "time"
+
+// SchedulerError is a recent problem the scheduler hit while delivering.
+type SchedulerError struct {
+	At        time.Time `json:"at"`
+	MessageID string    `json:"message_id,omitempty"`
+	Error     string    `json:"error"`
+}
+
+// SchedulerHealth is what the scheduler knows about itself.
+type SchedulerHealth struct {
+	Running            bool             `json:"running"`
+	LastTickAt         time.Time        `json:"last_tick_at"`
+	LastTickDurationMS int64            `json:"last_tick_duration_ms"`
+	RecentErrors       []SchedulerError `json:"recent_errors"`
+}
+
+// OverdueMessage identifies a message that should already have been sent.
+type OverdueMessage struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	ChannelID string    `json:"channel_id"`
+	PostAt    time.Time `json:"post_at"`
+}
+
+// SchedulerStatus is the diagnostic report shown to System Admins. Due counts
+// messages whose time has come within the overdue grace period; Overdue counts
+// older ones, which the scheduler should already have picked up. Stalled is set
+// when the scheduler is running but has not ticked recently. Pause is set while
+// an admin has paused delivery.
+type SchedulerStatus struct {
+	SchedulerHealth
+	CheckedAt     time.Time       `json:"checked_at"`
+	Stalled       bool            `json:"stalled"`
+	Pending       int             `json:"pending"`
+	Due           int             `json:"due"`
+	Overdue       int             `json:"overdue"`
+	OldestOverdue *OverdueMessage `json:"oldest_overdue,omitempty"`
+	StoreKeys     map[string]int  `json:"store_keys"`
+	StoreError    string          `json:"store_error,omitempty"`
+	Pause         *PauseState     `json:"pause,omitempty"`
+}
@@ -1,6 +1,17 @@
 package types
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrMessageNotFound is returned when a scheduled message does not exist,
+// usually because it has already been sent or deleted.
+var ErrMessageNotFound = errors.New("message not found (possibly already sent)")
+
+// ErrMessageChanged is returned when a scheduled message changed while it was
+// being updated, so the update was not applied.
+var ErrMessageChanged = errors.New("message changed while being updated")
 
 type ScheduledMessage struct {
 	ID             string    `json:"id"`
@@ -10,4 +21,28 @@ type ScheduledMessage struct {
 	MessageContent string    `json:"message_content"`
 	Timezone       string    `json:"timezone"`
 	FileIDs        []string  `json:"file_ids"`
+	// ShiftedFrom is the time originally asked for when quiet hours moved the
+	// message to PostAt.
+	ShiftedFrom *time.Time `json:"shifted_from,omitempty"`
+	// ReminderMinutes is how long before PostAt the owner is sent a reminder,
+	// or 0 for none. RemindedAt is set once the reminder has gone out.
+	ReminderMinutes int        `json:"reminder_minutes,omitempty"`
+	RemindedAt      *time.Time `json:"reminded_at,omitempty"`
+	// AsBot posts the message as the plugin's bot, with a line naming the
+	// owner, instead of as the owner.
+	AsBot bool `json:"as_bot,omitempty"`
+	// Metadata is applied to the post when the message is sent.
+	Metadata *PostMetadata `json:"metadata,omitempty"`
+	// IntegrationTokenID is the integration token that scheduled the message,
+	// if any. Only that token may read or delete it.
+	IntegrationTokenID string `json:"integration_token_id,omitempty"`
+}
+
+// ReminderAt returns when the owner should be reminded about the message,
+// and false if they asked for no reminder.
+func (m *ScheduledMessage) ReminderAt() (time.Time, bool) {
+	if m.ReminderMinutes <= 0 {
+		return time.Time{}, false
+	}
+	return m.PostAt.Add(-time.Duration(m.ReminderMinutes) * time.Minute), true
 }
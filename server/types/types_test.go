@@ -35,3 +35,26 @@ func TestScheduledMessageJSONRoundTrip(t *testing.T) {
 		t.Fatalf("round‑trip mismatch: expected %+v got %+v", original, decoded)
 	}
 }
+
+func TestNewLifecycleEvent(t *testing.T) {
+	msg := &ScheduledMessage{
+		ID:        "id1",
+		UserID:    "user1",
+		ChannelID: "channel1",
+		PostAt:    time.Date(2025, 1, 2, 3, 4, 0, 0, time.FixedZone("X", 3600)),
+		Timezone:  "Europe/Paris",
+		FileIDs:   []string{"f1", "f2"},
+	}
+	ev := NewLifecycleEvent(EventScheduled, msg, "actor")
+
+	if ev.Type != EventScheduled || ev.ActorID != "actor" || ev.MessageID != "id1" || ev.UserID != "user1" ||
+		ev.ChannelID != "channel1" || ev.FileCount != 2 || ev.Timezone != "Europe/Paris" {
+		t.Fatalf("unexpected event: %+v", ev)
+	}
+	if ev.PostAt.Location() != time.UTC || !ev.PostAt.Equal(msg.PostAt) {
+		t.Fatalf("post_at not normalized to UTC: %v", ev.PostAt)
+	}
+	if !ev.Timestamp.IsZero() {
+		t.Fatalf("timestamp should be left for the notifier: %v", ev.Timestamp)
+	}
+}
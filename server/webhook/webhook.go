@@ -0,0 +1,187 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/ports"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+// Dispatcher sends lifecycle events to the admin-configured webhook URLs.
+// Deliveries run in the background so callers are never blocked by a slow
+// receiver.
+type Dispatcher struct {
+	logger     ports.Logger
+	client     *http.Client
+	deliveries ports.WebhookDeliveryStore
+	clock      ports.Clock
+	backoff    time.Duration
+	ctx        context.Context
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	mu         sync.RWMutex
+	urls       []string
+	secret     string
+}
+
+func New(
+	logger ports.Logger,
+	client *http.Client,
+	deliveries ports.WebhookDeliveryStore,
+	clk ports.Clock,
+	backoff time.Duration,
+) *Dispatcher {
+	logger.Debug("Creating new webhook Dispatcher")
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{
+		logger:     logger,
+		client:     client,
+		deliveries: deliveries,
+		clock:      clk,
+		backoff:    backoff,
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Configure replaces the target URLs and signing secret. It is safe to call
+// while deliveries are in flight; they keep the settings they started with.
+func (d *Dispatcher) Configure(urls []string, secret string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.urls = append([]string(nil), urls...)
+	d.secret = secret
+	d.logger.Debug("Webhook dispatcher configured", "url_count", len(urls))
+}
+
+func (d *Dispatcher) Notify(event *types.LifecycleEvent) {
+	d.mu.RLock()
+	urls, secret := d.urls, d.secret
+	d.mu.RUnlock()
+	if len(urls) == 0 {
+		return
+	}
+
+	if event.Timestamp.IsZero() {
+		event.Timestamp = d.clock.Now().UTC()
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		d.logger.Error("Failed to marshal webhook payload", "event", event.Type, "message_id", event.MessageID, "error", err)
+		return
+	}
+
+	d.logger.Debug("Dispatching webhook event", "event", event.Type, "message_id", event.MessageID, "url_count", len(urls))
+	for _, url := range urls {
+		d.wg.Add(1)
+		go func(url string) {
+			defer d.wg.Done()
+			d.deliver(url, secret, event, body)
+		}(url)
+	}
+}
+
+// Close abandons pending retries and waits for in-flight deliveries to finish.
+func (d *Dispatcher) Close() {
+	d.cancel()
+	d.wg.Wait()
+}
+
+func (d *Dispatcher) deliver(url, secret string, event *types.LifecycleEvent, body []byte) {
+	delivery := &types.WebhookDelivery{
+		ID:        uuid.NewString(),
+		Event:     event.Type,
+		MessageID: event.MessageID,
+		URL:       url,
+	}
+
+	wait := d.backoff
+	for attempt := 1; attempt <= constants.WebhookMaxAttempts; attempt++ {
+		delivery.Attempts = attempt
+		status, err := d.post(url, secret, delivery.ID, event.Type, body)
+		delivery.StatusCode = status
+		if err == nil {
+			delivery.Success = true
+			delivery.Error = ""
+			break
+		}
+		delivery.Error = err.Error()
+		d.logger.Warn("Webhook delivery attempt failed", "url", url, "event", event.Type, "message_id", event.MessageID, "attempt", attempt, "error", err)
+		if !retryable(status) || attempt == constants.WebhookMaxAttempts {
+			break
+		}
+		select {
+		case <-d.ctx.Done():
+			delivery.Error = fmt.Sprintf("%s (retries abandoned on shutdown)", delivery.Error)
+			attempt = constants.WebhookMaxAttempts
+		case <-time.After(wait):
+			wait *= 2
+		}
+	}
+
+	delivery.DeliveredAt = d.clock.Now().UTC()
+	if delivery.Success {
+		d.logger.Debug("Webhook delivered", "url", url, "event", event.Type, "message_id", event.MessageID, "attempts", delivery.Attempts)
+	} else {
+		d.logger.Error("Webhook delivery failed", "url", url, "event", event.Type, "message_id", event.MessageID, "attempts", delivery.Attempts, "error", delivery.Error)
+	}
+	if err := d.deliveries.SaveDelivery(delivery); err != nil {
+		d.logger.Error("Failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+func (d *Dispatcher) post(url, secret, deliveryID string, eventType types.EventType, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(d.ctx, constants.WebhookRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("invalid webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(constants.HTTPHeaderWebhookEvent, string(eventType))
+	req.Header.Set(constants.HTTPHeaderWebhookDelivery, deliveryID)
+	if secret != "" {
+		req.Header.Set(constants.HTTPHeaderWebhookSignature, Sign(secret, body))
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for body: the hex HMAC-SHA256 of the
+// raw request body keyed with secret, prefixed with "sha256=".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return constants.WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryable reports whether a failed attempt may succeed later. Network errors
+// (status 0), rate limiting and server errors are retried; other client errors
+// are not.
+func retryable(status int) bool {
+	return status == 0 || status == http.StatusTooManyRequests || status >= 500
+}
@@ -0,0 +1,182 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/adapters/mock"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/internal/testutil"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/constants"
+	"lab.ssafy.com/adjl1346/mattermost-plugin-schedule-message-gui/server/types"
+)
+
+var testNow = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+type recordedDeliveries struct {
+	mu    sync.Mutex
+	items []*types.WebhookDelivery
+}
+
+func setupDispatcher(t *testing.T) (*Dispatcher, *recordedDeliveries) {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	store := mock.NewMockWebhookDeliveryStore(ctrl)
+	rec := &recordedDeliveries{}
+	store.EXPECT().SaveDelivery(gomock.Any()).DoAndReturn(func(d *types.WebhookDelivery) error {
+		rec.mu.Lock()
+		defer rec.mu.Unlock()
+		rec.items = append(rec.items, d)
+		return nil
+	}).AnyTimes()
+	d := New(testutil.FakeLogger{}, http.DefaultClient, store, testutil.FakeClock{NowTime: testNow}, time.Millisecond)
+	t.Cleanup(d.Close)
+	return d, rec
+}
+
+func sampleEvent() *types.LifecycleEvent {
+	return types.NewLifecycleEvent(types.EventSent, &types.ScheduledMessage{
+		ID:        "msg1",
+		UserID:    "user1",
+		ChannelID: "chan1",
+		PostAt:    testNow,
+		Timezone:  "UTC",
+	}, "user1")
+}
+
+func TestNotify_SignedDelivery(t *testing.T) {
+	var gotBody []byte
+	var gotHeader http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	d, rec := setupDispatcher(t)
+	d.Configure([]string{srv.URL}, "s3cret")
+	d.Notify(sampleEvent())
+	d.wg.Wait()
+
+	var payload types.LifecycleEvent
+	require.NoError(t, json.Unmarshal(gotBody, &payload))
+	assert.Equal(t, types.EventSent, payload.Type)
+	assert.Equal(t, "msg1", payload.MessageID)
+	assert.True(t, payload.Timestamp.Equal(testNow))
+	assert.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+	assert.Equal(t, string(types.EventSent), gotHeader.Get(constants.HTTPHeaderWebhookEvent))
+	assert.Equal(t, Sign("s3cret", gotBody), gotHeader.Get(constants.HTTPHeaderWebhookSignature))
+	assert.NotEmpty(t, gotHeader.Get(constants.HTTPHeaderWebhookDelivery))
+
+	require.Len(t, rec.items, 1)
+	assert.True(t, rec.items[0].Success)
+	assert.Equal(t, 1, rec.items[0].Attempts)
+	assert.Equal(t, http.StatusNoContent, rec.items[0].StatusCode)
+}
+
+func TestNotify_RetriesServerErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	d, rec := setupDispatcher(t)
+	d.Configure([]string{srv.URL}, "")
+	d.Notify(sampleEvent())
+	d.wg.Wait()
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	require.Len(t, rec.items, 1)
+	assert.True(t, rec.items[0].Success)
+	assert.Equal(t, 3, rec.items[0].Attempts)
+}
+
+func TestNotify_GivesUpAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	d, rec := setupDispatcher(t)
+	d.Configure([]string{srv.URL}, "")
+	d.Notify(sampleEvent())
+	d.wg.Wait()
+
+	assert.Equal(t, int32(constants.WebhookMaxAttempts), atomic.LoadInt32(&calls))
+	require.Len(t, rec.items, 1)
+	assert.False(t, rec.items[0].Success)
+	assert.Contains(t, rec.items[0].Error, "unexpected status 500")
+}
+
+func TestNotify_DoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	d, rec := setupDispatcher(t)
+	d.Configure([]string{srv.URL}, "")
+	d.Notify(sampleEvent())
+	d.wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	require.Len(t, rec.items, 1)
+	assert.Equal(t, http.StatusBadRequest, rec.items[0].StatusCode)
+}
+
+func TestNotify_NoURLsConfigured(t *testing.T) {
+	d, rec := setupDispatcher(t)
+	d.Notify(sampleEvent())
+	d.wg.Wait()
+	assert.Empty(t, rec.items)
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=515aae133b435d4000956731f68ae5cf5eb85d4f0dc6a546d2bfcd3595ec1ae1", Sign("key", []byte("body")))
+	assert.NotEqual(t, Sign("key", []byte("body")), Sign("other", []byte("body")))
+}
+
+func TestClose_AbandonsRetries(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	d, rec := setupDispatcher(t)
+	d.backoff = time.Hour
+	d.Configure([]string{srv.URL}, "")
+	d.Notify(sampleEvent())
+
+	done := make(chan struct{})
+	go func() {
+		d.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not abandon the pending retry")
+	}
+	require.Len(t, rec.items, 1)
+	assert.False(t, rec.items[0].Success)
+	assert.Contains(t, rec.items[0].Error, "abandoned")
+}
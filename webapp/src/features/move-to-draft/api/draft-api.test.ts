@@ -0,0 +1,70 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+import {Client4} from 'mattermost-redux/client';
+
+import {DraftApiClient} from './draft-api';
+
+// Mock mattermost-redux
+jest.mock('mattermost-redux/client');
+
+describe('DraftApiClient', () => {
+    let apiClient: DraftApiClient;
+
+    // @ts-expect-error - doFetch is protected but we need to mock it for testing
+    const mockDoFetch = Client4.doFetch as jest.MockedFunction<typeof Client4.doFetch>;
+
+    const mockEvent = {
+        user_id: 'user123',
+        channel_id: 'channel123',
+        message: 'Test message',
+        file_ids: ['file1', 'file2'],
+    };
+
+    beforeEach(() => {
+        apiClient = new DraftApiClient();
+        mockDoFetch.mockClear();
+    });
+
+    describe('saveDraft', () => {
+        test('should POST to the drafts endpoint', async () => {
+            mockDoFetch.mockResolvedValue(undefined);
+
+            await apiClient.saveDraft(mockEvent);
+
+            expect(mockDoFetch).toHaveBeenCalledTimes(1);
+            const callArgs = mockDoFetch.mock.calls[0];
+            expect(callArgs[0]).toBe('/api/v4/drafts');
+            expect(callArgs[1].method).toBe('POST');
+        });
+
+        test('should send the message and files in the original channel', async () => {
+            mockDoFetch.mockResolvedValue(undefined);
+
+            await apiClient.saveDraft(mockEvent);
+
+            const body = JSON.parse(mockDoFetch.mock.calls[0][1].body);
+            expect(body.user_id).toBe('user123');
+            expect(body.channel_id).toBe('channel123');
+            expect(body.root_id).toBe('');
+            expect(body.message).toBe('Test message');
+            expect(body.file_ids).toEqual(['file1', 'file2']);
+        });
+
+        test('should send an empty file list when the event has none', async () => {
+            mockDoFetch.mockResolvedValue(undefined);
+
+            // @ts-expect-error - older servers may omit file_ids
+            await apiClient.saveDraft({...mockEvent, file_ids: undefined});
+
+            const body = JSON.parse(mockDoFetch.mock.calls[0][1].body);
+            expect(body.file_ids).toEqual([]);
+        });
+
+        test('should propagate API errors', async () => {
+            mockDoFetch.mockRejectedValue(new Error('Drafts are disabled'));
+
+            await expect(apiClient.saveDraft(mockEvent)).rejects.toThrow('Drafts are disabled');
+        });
+    });
+});
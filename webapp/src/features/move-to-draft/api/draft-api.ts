@@ -0,0 +1,39 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+import {Client4} from 'mattermost-redux/client';
+
+import type {MoveToDraftEvent} from '@/shared/types/api';
+
+/**
+ * Mattermost draft API 클라이언트
+ * 플러그인 서버 API로는 draft를 만들 수 없어서 사용자 세션으로 직접 저장한다
+ */
+export class DraftApiClient {
+    /**
+     * 예약이 취소된 메시지를 원래 채널의 draft로 저장
+     */
+    async saveDraft(event: MoveToDraftEvent): Promise<void> {
+        const now = Date.now();
+
+        // @ts-expect-error - doFetch is protected but commonly used in plugins
+        await Client4.doFetch<void>('/api/v4/drafts', {
+            method: 'POST',
+            body: JSON.stringify({
+                user_id: event.user_id,
+                channel_id: event.channel_id,
+                root_id: '',
+                message: event.message,
+                file_ids: event.file_ids || [],
+                props: {},
+                create_at: now,
+                update_at: now,
+            }),
+        });
+    }
+}
+
+/**
+ * 싱글톤 인스턴스
+ */
+export const draftApiClient = new DraftApiClient();
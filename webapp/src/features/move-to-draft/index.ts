@@ -0,0 +1,8 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+/**
+ * Move To Draft Feature - Public API
+ */
+
+export {handleMoveToDraft} from './model/handle-move-to-draft';
@@ -0,0 +1,20 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+import type {WebSocketMessage} from '@mattermost/client';
+
+import type {MoveToDraftEvent} from '@/shared/types/api';
+
+import {draftApiClient} from '../api/draft-api';
+
+/**
+ * 서버가 보낸 move_to_draft 이벤트를 draft로 저장
+ * 서버 설정에서 draft 동기화가 꺼져 있으면 저장에 실패하므로 무시한다
+ */
+export async function handleMoveToDraft(msg: WebSocketMessage<MoveToDraftEvent>): Promise<void> {
+    try {
+        await draftApiClient.saveDraft(msg.data);
+    } catch (error) {
+        // 에러 발생 시 무시
+    }
+}
@@ -50,6 +50,25 @@ describe('ScheduleApiClient', () => {
             expect(callArgs[1].body).toBe(JSON.stringify(mockRequest));
         });
 
+        test('should send Idempotency-Key header when key is given', async () => {
+            mockDoFetch.mockResolvedValue({id: 'schedule123'});
+
+            await apiClient.createScheduledMessage(mockRequest, 'key123');
+
+            const callArgs = mockDoFetch.mock.calls[0];
+            expect(callArgs[1].headers).toEqual({'Idempotency-Key': 'key123'});
+            expect(callArgs[1].body).toBe(JSON.stringify(mockRequest));
+        });
+
+        test('should not send Idempotency-Key header without key', async () => {
+            mockDoFetch.mockResolvedValue({id: 'schedule123'});
+
+            await apiClient.createScheduledMessage(mockRequest);
+
+            const callArgs = mockDoFetch.mock.calls[0];
+            expect(callArgs[1].headers).toBeUndefined();
+        });
+
         test('should return scheduled message from API', async () => {
             const mockResponse = {
                 id: 'schedule123',
@@ -13,14 +13,16 @@ import type {CreateScheduledMessageRequest, ScheduledMessage} from '@/shared/typ
 export class ScheduleApiClient {
     /**
      * 예약 메시지 생성
+     * @param idempotencyKey - 같은 키로 다시 보내면 서버가 새로 예약하지 않고 처음 결과를 돌려준다
      */
-    async createScheduledMessage(request: CreateScheduledMessageRequest): Promise<ScheduledMessage> {
+    async createScheduledMessage(request: CreateScheduledMessageRequest, idempotencyKey?: string): Promise<ScheduledMessage> {
         const url = `/plugins/${manifest.id}/api/v1/schedule`;
 
         // @ts-expect-error - doFetch is protected but commonly used in plugins
         const response = await Client4.doFetch<ScheduledMessage>(url, {
             method: 'POST',
             body: JSON.stringify(request),
+            ...(idempotencyKey ? {headers: {'Idempotency-Key': idempotencyKey}} : {}),
         });
 
         return response;
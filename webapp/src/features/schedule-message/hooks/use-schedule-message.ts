@@ -20,6 +20,7 @@ export function useScheduleMessage() {
         timestamp: number,
         message: string,
         fileInfos: FileInfo[],
+        idempotencyKey?: string,
     ): Promise<void> => {
         // 현재 채널 ID 가져오기
         const channelId = mattermostService.getCurrentChannelId();
@@ -40,7 +41,7 @@ export function useScheduleMessage() {
             post_at_time: time,
             post_at_date: date,
             message,
-        });
+        }, idempotencyKey);
     }, []);
 
     return {
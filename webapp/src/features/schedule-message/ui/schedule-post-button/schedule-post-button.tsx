@@ -13,6 +13,7 @@ import type {SchedulePostButtonProps, FileInfo} from '../../model/types';
 import ScheduleModal from '../schedule-modal';
 
 import {mattermostService} from '@/entities/mattermost';
+import {generateIdempotencyKey} from '@/shared/lib/idempotency';
 
 import './schedule-post-button.css';
 
@@ -37,6 +38,9 @@ const SchedulePostButton: React.FC<SchedulePostButtonProps> = (props) => {
 
     const buttonRef = React.useRef<HTMLButtonElement>(null);
 
+    // 모달을 열 때마다 새로 발급하여 중복 제출이 같은 키를 공유하도록 함
+    const idempotencyKeyRef = React.useRef('');
+
     const {getCurrentMessage, getCurrentFiles, clearDraft, hasUploadsInProgress} = useMessageData();
     const {scheduleMessage: scheduleMessageApi} = useScheduleMessage();
     const {isWide, isVisible} = useFormattingBarWidth(buttonRef);
@@ -79,6 +83,7 @@ const SchedulePostButton: React.FC<SchedulePostButtonProps> = (props) => {
         setMessage(currentMessage);
         setFileInfos(currentFiles);
         setHasUploads(uploadsInProgress);
+        idempotencyKeyRef.current = generateIdempotencyKey();
         setIsModalOpen(true);
 
         if (props.onClick) {
@@ -101,7 +106,7 @@ const SchedulePostButton: React.FC<SchedulePostButtonProps> = (props) => {
     const handleSchedule = async (timestamp: number) => {
         try {
             // API 호출
-            await scheduleMessageApi(timestamp, message, fileInfos);
+            await scheduleMessageApi(timestamp, message, fileInfos, idempotencyKeyRef.current);
 
             // Draft 초기화 (메시지 및 파일 삭제)
             clearDraft();
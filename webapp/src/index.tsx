@@ -7,6 +7,7 @@ import type {Store, Action} from 'redux';
 import type {GlobalState} from '@mattermost/types/store';
 
 import {mattermostService} from '@/entities/mattermost';
+import {handleMoveToDraft} from '@/features/move-to-draft';
 import {SchedulePostButton} from '@/features/schedule-message';
 import type {PluginRegistry} from '@/shared/types/mattermost-webapp';
 
@@ -19,6 +20,9 @@ export default class Plugin {
 
         // Register the schedule message button in the post editor formatting bar
         registry.registerPostEditorActionComponent(SchedulePostButton);
+
+        // Save scheduled messages moved back to drafts from the server
+        registry.registerWebSocketEventHandler(`custom_${manifest.id}_move_to_draft`, handleMoveToDraft);
     }
 }
 
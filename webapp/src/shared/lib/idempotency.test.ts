@@ -0,0 +1,14 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+import {generateIdempotencyKey} from './idempotency';
+
+describe('generateIdempotencyKey', () => {
+    test('should return a 32 character hex string', () => {
+        expect(generateIdempotencyKey()).toMatch(/^[0-9a-f]{32}$/);
+    });
+
+    test('should return a different key on each call', () => {
+        expect(generateIdempotencyKey()).not.toBe(generateIdempotencyKey());
+    });
+});
@@ -0,0 +1,13 @@
+// Copyright (c) 2015-present Mattermost, Inc. All Rights Reserved.
+// See LICENSE.txt for license information.
+
+/**
+ * 멱등성 키 생성
+ * 같은 예약 요청이 중복 전송되어도 서버가 한 번만 처리하도록 요청마다 고유한 키를 만든다.
+ * @returns 32자리 16진수 문자열
+ */
+export function generateIdempotencyKey(): string {
+    const bytes = new Uint8Array(16);
+    window.crypto.getRandomValues(bytes);
+    return Array.from(bytes, (b) => b.toString(16).padStart(2, '0')).join('');
+}
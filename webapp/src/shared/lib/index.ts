@@ -3,3 +3,4 @@
 
 export * from './datetime';
 export * from './validation';
+export * from './idempotency';
@@ -27,3 +27,13 @@ export interface ScheduledMessage {
     scheduled_at: string;
     created_at: string;
 }
+
+/**
+ * 예약 메시지를 draft로 되돌릴 때 서버가 WebSocket으로 보내는 이벤트
+ */
+export interface MoveToDraftEvent {
+    user_id: string;
+    channel_id: string;
+    message: string;
+    file_ids: string[];
+}